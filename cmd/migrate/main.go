@@ -1,15 +1,20 @@
 package main
 
 import (
+	"context"
 	"errors"
 
 	"github.com/andrewshostak/result-service/config"
+	"github.com/andrewshostak/result-service/internal/adapters/repository"
+	"github.com/andrewshostak/result-service/internal/app/secret"
 	loggerinternal "github.com/andrewshostak/result-service/internal/infra/logger"
 	"github.com/andrewshostak/result-service/internal/infra/postgres"
+	"github.com/andrewshostak/result-service/pkg/secrets"
 	"github.com/golang-migrate/migrate/v4"
 	migratepg "github.com/golang-migrate/migrate/v4/database/postgres"
 	"github.com/rs/zerolog"
 	"github.com/spf13/cobra"
+	"gorm.io/gorm"
 )
 
 func main() {
@@ -43,25 +48,37 @@ func main() {
 }
 
 func up() error {
-	m, l := run()
+	m, db, cfg, l := run()
 
 	err := m.Up()
 	if errors.Is(err, migrate.ErrNoChange) {
 		l.Info().Msg("database is up to date")
-		return nil
+	} else if err != nil {
+		return err
+	} else {
+		l.Info().Msg("migration up done")
 	}
 
+	// secrets stored before the encryption at rest are encrypted here, as sql migrations have no access to the keys
+	return encryptSecrets(db, cfg.Secrets, l)
+}
+
+func encryptSecrets(db *gorm.DB, cfg config.Secrets, l *zerolog.Logger) error {
+	keyring, err := secrets.NewKeyring(cfg.CurrentKeyID, cfg.EncryptionKeys, cfg.HashKey)
 	if err != nil {
 		return err
 	}
 
-	l.Info().Msg("migration up done")
+	subscriptionRepository := repository.NewSubscriptionRepository(db, keyring)
+	standingSubscriptionRepository := repository.NewStandingSubscriptionRepository(db, keyring)
 
-	return nil
+	keyRotationService := secret.NewKeyRotationService(subscriptionRepository, standingSubscriptionRepository, l)
+
+	return keyRotationService.Rotate(context.Background())
 }
 
 func down() error {
-	m, l := run()
+	m, _, _, l := run()
 
 	err := m.Down()
 	if errors.Is(err, migrate.ErrNoChange) {
@@ -78,7 +95,7 @@ func down() error {
 	return nil
 }
 
-func run() (*migrate.Migrate, *zerolog.Logger) {
+func run() (*migrate.Migrate, *gorm.DB, config.Migrate, *zerolog.Logger) {
 	cfg := config.Parse[config.Migrate]()
 
 	logger := loggerinternal.SetupLogger()
@@ -100,5 +117,5 @@ func run() (*migrate.Migrate, *zerolog.Logger) {
 		panic(err)
 	}
 
-	return m, logger
+	return m, db, cfg, logger
 }
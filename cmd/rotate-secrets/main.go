@@ -0,0 +1,47 @@
+package main
+
+import (
+	"context"
+
+	"github.com/andrewshostak/result-service/config"
+	"github.com/andrewshostak/result-service/internal/adapters/repository"
+	"github.com/andrewshostak/result-service/internal/app/secret"
+	loggerinternal "github.com/andrewshostak/result-service/internal/infra/logger"
+	"github.com/andrewshostak/result-service/internal/infra/postgres"
+	"github.com/andrewshostak/result-service/pkg/secrets"
+	"github.com/spf13/cobra"
+)
+
+func main() {
+	rootCmd := &cobra.Command{
+		Use:   "run",
+		Short: "Re-encrypts subscriber secrets with the current encryption key",
+		Run:   run,
+	}
+
+	if err := rootCmd.Execute(); err != nil {
+		panic(err)
+	}
+}
+
+func run(_ *cobra.Command, _ []string) {
+	cfg := config.Parse[config.RotateSecrets]()
+
+	logger := loggerinternal.SetupLogger()
+
+	keyring, err := secrets.NewKeyring(cfg.Secrets.CurrentKeyID, cfg.Secrets.EncryptionKeys, cfg.Secrets.HashKey)
+	if err != nil {
+		panic(err)
+	}
+
+	db := postgres.EstablishDatabaseConnection(cfg.PG)
+
+	subscriptionRepository := repository.NewSubscriptionRepository(db, keyring)
+	standingSubscriptionRepository := repository.NewStandingSubscriptionRepository(db, keyring)
+
+	keyRotationService := secret.NewKeyRotationService(subscriptionRepository, standingSubscriptionRepository, logger)
+
+	if err := keyRotationService.Rotate(context.Background()); err != nil {
+		panic(err)
+	}
+}
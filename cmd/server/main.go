@@ -14,11 +14,14 @@ import (
 	"github.com/andrewshostak/result-service/internal/adapters/repository"
 	"github.com/andrewshostak/result-service/internal/app/alias"
 	"github.com/andrewshostak/result-service/internal/app/match"
+	"github.com/andrewshostak/result-service/internal/app/models"
+	"github.com/andrewshostak/result-service/internal/app/planner"
 	"github.com/andrewshostak/result-service/internal/app/subscription"
 	"github.com/andrewshostak/result-service/internal/infra/cloudtasks"
 	"github.com/andrewshostak/result-service/internal/infra/http/server"
 	loggerinternal "github.com/andrewshostak/result-service/internal/infra/logger"
 	"github.com/andrewshostak/result-service/internal/infra/postgres"
+	"github.com/andrewshostak/result-service/pkg/secrets"
 	"github.com/gin-gonic/gin"
 	_ "github.com/golang-migrate/migrate/v4/source/file"
 	"github.com/spf13/cobra"
@@ -41,6 +44,11 @@ func startServer(_ *cobra.Command, _ []string) {
 
 	logger := loggerinternal.SetupLogger()
 
+	keyring, err := secrets.NewKeyring(cfg.Secrets.CurrentKeyID, cfg.Secrets.EncryptionKeys, cfg.Secrets.HashKey)
+	if err != nil {
+		panic(err)
+	}
+
 	db := postgres.EstablishDatabaseConnection(cfg.PG)
 	httpClient := http.Client{Timeout: cfg.App.TriggersTimeout - (2 * time.Second)}
 
@@ -53,44 +61,157 @@ func startServer(_ *cobra.Command, _ []string) {
 	defer cloudTasksClient.Close()
 
 	fotmobClient := fotmob.NewFotmobClient(&httpClient, logger, cfg.ExternalAPI)
-	notifierClient := notifier.NewNotifierClient(&httpClient, logger)
+	addressGuard, err := notifier.NewAddressGuard(cfg.Notification)
+	if err != nil {
+		panic(err)
+	}
+
+	// subscriber urls are requested through the guard, so they can't reach internal services
+	subscriberHTTPClient := http.Client{Timeout: httpClient.Timeout, Transport: addressGuard.Transport()}
+	notificationChannels := map[models.NotificationChannel]notifier.Channel{
+		models.ChannelWebhook: notifier.NewWebhookChannel(&subscriberHTTPClient, logger),
+		models.ChannelSlack:   notifier.NewSlackChannel(&subscriberHTTPClient, logger),
+		models.ChannelDiscord: notifier.NewDiscordChannel(&subscriberHTTPClient, logger),
+	}
+	if cfg.Telegram.BotToken != "" {
+		notificationChannels[models.ChannelTelegram] = notifier.NewTelegramChannel(&httpClient, logger, cfg.Telegram)
+	}
+	if cfg.SMTP.Host != "" {
+		notificationChannels[models.ChannelEmail] = notifier.NewEmailChannel(cfg.SMTP)
+	}
+	notifierClient := notifier.NewNotifierClient(notificationChannels)
 	taskClient := task.NewClient(cfg.GoogleCloud, cfg.App.TriggersTimeout+(2*time.Second), cloudTasksClient)
 
 	aliasRepository := repository.NewAliasRepository(db)
 	matchRepository := repository.NewMatchRepository(db)
 	externalMatchRepository := repository.NewExternalMatchRepository(db)
-	subscriptionRepository := repository.NewSubscriptionRepository(db)
+	subscriptionRepository := repository.NewSubscriptionRepository(db, keyring)
 	checkResultTaskRepository := repository.NewCheckResultTaskRepository(db)
+	resultCheckAttemptRepository := repository.NewResultCheckAttemptRepository(db)
+	notificationAttemptRepository := repository.NewNotificationAttemptRepository(db)
+	outboxTaskRepository := repository.NewOutboxTaskRepository(db)
+	matchEventRepository := repository.NewMatchEventRepository(db)
+	resultEventRepository := repository.NewResultEventRepository(db)
+	matchUpdateRepository := repository.NewMatchUpdateRepository(db)
+	eventDeliveryRepository := repository.NewEventDeliveryRepository(db)
+	standingSubscriptionRepository := repository.NewStandingSubscriptionRepository(db, keyring)
+	subscriberHostRepository := repository.NewSubscriberHostRepository(db)
+	notificationBatchRepository := repository.NewNotificationBatchRepository(db)
+	unitOfWork := repository.NewUnitOfWork(db)
+
+	outboxDispatcherService := match.NewOutboxDispatcherService(
+		cfg.Outbox,
+		unitOfWork,
+		outboxTaskRepository,
+		matchRepository,
+		checkResultTaskRepository,
+		taskClient,
+		logger,
+	)
 
 	matchService := match.NewMatchService(
 		cfg.Result,
+		unitOfWork,
 		aliasRepository,
 		matchRepository,
 		externalMatchRepository,
-		checkResultTaskRepository,
+		outboxTaskRepository,
+		resultCheckAttemptRepository,
 		fotmobClient,
+		outboxDispatcherService,
+		logger,
+	)
+	subscriptionService := subscription.NewSubscriptionService(
+		cfg.Subscription,
+		subscriptionRepository,
+		notificationAttemptRepository,
+		matchRepository,
+		outboxTaskRepository,
+		aliasRepository,
 		taskClient,
+		notifierClient,
 		logger,
 	)
-	subscriptionService := subscription.NewSubscriptionService(subscriptionRepository, matchRepository, aliasRepository, taskClient, logger)
 	aliasService := alias.NewAliasService(aliasRepository, logger)
+	eventPublisherService := match.NewEventPublisherService(
+		unitOfWork,
+		matchEventRepository,
+		eventDeliveryRepository,
+		subscriptionRepository,
+		taskClient,
+		logger,
+	)
 	resultCheckerService := match.NewResultCheckerService(
 		cfg.Result,
+		unitOfWork,
 		matchRepository,
 		externalMatchRepository,
 		subscriptionRepository,
-		checkResultTaskRepository,
+		outboxTaskRepository,
+		resultCheckAttemptRepository,
+		resultEventRepository,
+		matchUpdateRepository,
+		taskClient,
+		fotmobClient,
+		outboxDispatcherService,
+		eventPublisherService,
+		logger,
+	)
+	subscriberNotifierService := subscription.NewSubscriberNotifierService(
+		cfg.Notification,
+		subscriptionRepository,
+		matchRepository,
+		notificationAttemptRepository,
+		eventDeliveryRepository,
+		subscriberHostRepository,
+		notificationBatchRepository,
+		notifierClient,
+		taskClient,
+		logger,
+	)
+	subscriberHostService := subscription.NewSubscriberHostService(unitOfWork, subscriberHostRepository, subscriptionRepository, taskClient, logger)
+	kickoffReminderService := subscription.NewKickoffReminderService(
+		unitOfWork,
+		subscriptionRepository,
+		matchRepository,
+		matchEventRepository,
+		eventDeliveryRepository,
+		fotmobClient,
+		taskClient,
+		logger,
+	)
+	reconcilerService := match.NewReconcilerService(
+		cfg.Reconciliation,
+		matchRepository,
+		subscriptionRepository,
+		outboxTaskRepository,
+		eventDeliveryRepository,
 		taskClient,
+		outboxDispatcherService,
+		logger,
+	)
+	resultFeedService := match.NewResultFeedService(cfg.ResultFeed, resultEventRepository)
+	liveUpdateService := match.NewLiveUpdateService(cfg.LiveUpdates, matchUpdateRepository)
+	standingSubscriptionService := planner.NewStandingSubscriptionService(standingSubscriptionRepository, aliasRepository, logger)
+	plannerService := planner.NewPlannerService(
+		cfg.Planner,
+		standingSubscriptionRepository,
+		aliasRepository,
 		fotmobClient,
+		matchService,
+		subscriptionService,
 		logger,
 	)
-	subscriberNotifierService := subscription.NewSubscriberNotifierService(subscriptionRepository, matchRepository, notifierClient, logger)
 
 	r, err := server.NewServer(cfg, server.Handlers{
-		MatchHandler:        handler.NewMatchHandler(matchService),
-		SubscriptionHandler: handler.NewSubscriptionHandler(subscriptionService),
-		AliasHandler:        handler.NewAliasHandler(aliasService),
-		TriggerHandler:      handler.NewTriggerHandler(resultCheckerService, subscriberNotifierService),
+		MatchHandler:                handler.NewMatchHandler(matchService),
+		SubscriptionHandler:         handler.NewSubscriptionHandler(subscriptionService),
+		StandingSubscriptionHandler: handler.NewStandingSubscriptionHandler(standingSubscriptionService),
+		SubscriberHostHandler:       handler.NewSubscriberHostHandler(subscriberHostService),
+		AliasHandler:                handler.NewAliasHandler(aliasService),
+		ResultHandler:               handler.NewResultHandler(resultFeedService),
+		MatchUpdateHandler:          handler.NewMatchUpdateHandler(liveUpdateService, logger),
+		TriggerHandler:              handler.NewTriggerHandler(resultCheckerService, subscriberNotifierService, kickoffReminderService, reconcilerService, outboxDispatcherService, plannerService),
 	})
 	if err != nil {
 		panic(fmt.Errorf("failed to configure server: %w", err))
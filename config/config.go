@@ -7,11 +7,21 @@ import (
 )
 
 type Server struct {
-	App         App
-	ExternalAPI ExternalAPI
-	Result      ResultCheck
-	PG          PG
-	GoogleCloud GoogleCloud
+	App            App
+	ExternalAPI    ExternalAPI
+	Result         ResultCheck
+	Reconciliation Reconciliation
+	Outbox         Outbox
+	ResultFeed     ResultFeed
+	LiveUpdates    LiveUpdates
+	Subscription   Subscription
+	Notification   Notification
+	Planner        Planner
+	Telegram       Telegram
+	SMTP           SMTP
+	Secrets        Secrets
+	PG             PG
+	GoogleCloud    GoogleCloud
 }
 
 type BackfillAliases struct {
@@ -20,7 +30,13 @@ type BackfillAliases struct {
 }
 
 type Migrate struct {
-	PG PG
+	PG      PG
+	Secrets Secrets
+}
+
+type RotateSecrets struct {
+	PG      PG
+	Secrets Secrets
 }
 
 type App struct {
@@ -40,6 +56,67 @@ type ResultCheck struct {
 	MaxRetries        uint          `env:"MAX_RETRIES" envDefault:"10"`
 	Interval          time.Duration `env:"INTERVAL" envDefault:"5m"`
 	FirstAttemptDelay time.Duration `env:"FIRST_ATTEMPT_DELAY" envDefault:"115m"`
+	LiveInterval      time.Duration `env:"LIVE_INTERVAL" envDefault:"2m"`           // interval of live checks of a match which has subscriptions to in-play events
+	LiveMaxChecks     uint          `env:"LIVE_MAX_CHECKS" envDefault:"90"`         // live checks of a match after which its live tracking stops
+	CorrectionDelay   time.Duration `env:"CORRECTION_CHECK_DELAY" envDefault:"24h"` // how long after the result is received the match is checked for a corrected score, 0 disables the check
+}
+
+type Reconciliation struct {
+	StuckThreshold time.Duration `env:"RECONCILIATION_STUCK_THRESHOLD" envDefault:"30m"` // how long after execute_at a result-check task is considered lost
+}
+
+type Outbox struct {
+	DispatchDelay time.Duration `env:"OUTBOX_DISPATCH_DELAY" envDefault:"1m"` // how long a pending outbox task is left to the immediate dispatch before the dispatch job picks it up
+}
+
+type ResultFeed struct {
+	MaxWait      time.Duration `env:"RESULT_FEED_MAX_WAIT" envDefault:"30s"`     // the longest a pull of the result feed waits for new events
+	PollInterval time.Duration `env:"RESULT_FEED_POLL_INTERVAL" envDefault:"1s"` // how often a waiting pull checks for new events
+}
+
+type LiveUpdates struct {
+	PollInterval      time.Duration `env:"LIVE_UPDATES_POLL_INTERVAL" envDefault:"1s"`       // how often a stream checks for new match updates
+	HeartbeatInterval time.Duration `env:"LIVE_UPDATES_HEARTBEAT_INTERVAL" envDefault:"15s"` // how often an idle stream sends a keep-alive
+	BatchSize         uint          `env:"LIVE_UPDATES_BATCH_SIZE" envDefault:"100"`         // the max number of updates read by one check
+}
+
+type Subscription struct {
+	SecretRotationWindow time.Duration `env:"SECRET_ROTATION_WINDOW" envDefault:"72h"`             // how long deliveries are signed with the previous secret key after its rotation
+	VerificationTimeout  time.Duration `env:"SUBSCRIPTION_VERIFICATION_TIMEOUT" envDefault:"10s"`  // how long the subscription creation waits for the endpoint to echo the challenge
+	TestDeliveryTimeout  time.Duration `env:"SUBSCRIPTION_TEST_DELIVERY_TIMEOUT" envDefault:"10s"` // how long a test delivery waits for the subscriber response
+}
+
+type Notification struct {
+	MaxAttempts          uint          `env:"NOTIFICATION_MAX_ATTEMPTS" envDefault:"5"`       // attempts to notify a subscriber before the subscription is dead-lettered
+	RetryBaseDelay       time.Duration `env:"NOTIFICATION_RETRY_BASE_DELAY" envDefault:"30s"` // delay before the first retry, doubled for every next one
+	RetryMaxDelay        time.Duration `env:"NOTIFICATION_RETRY_MAX_DELAY" envDefault:"1h"`
+	AllowedHosts         []string      `env:"NOTIFICATION_ALLOWED_HOSTS" envSeparator:","`         // host names and CIDR networks which are notified even though they resolve to private addresses
+	HostFailureThreshold uint          `env:"NOTIFICATION_HOST_FAILURE_THRESHOLD" envDefault:"20"` // consecutive transient failures of a host after which deliveries to it are suspended
+	BatchWindow          time.Duration `env:"NOTIFICATION_BATCH_WINDOW" envDefault:"10s"`          // how long results are collected into a batch before it is delivered
+}
+
+type Planner struct {
+	DaysAhead uint `env:"PLANNER_DAYS_AHEAD" envDefault:"7"` // how many days after today are searched for fixtures of standing subscriptions
+}
+
+type Telegram struct {
+	BotToken   string `env:"TELEGRAM_BOT_TOKEN"` // telegram channel is disabled when empty
+	APIBaseURL string `env:"TELEGRAM_API_BASE_URL" envDefault:"https://api.telegram.org"`
+}
+
+type SMTP struct {
+	Host     string        `env:"SMTP_HOST"` // email channel is disabled when empty
+	Port     string        `env:"SMTP_PORT" envDefault:"587"`
+	Username string        `env:"SMTP_USERNAME"` // authentication is skipped when empty
+	Password string        `env:"SMTP_PASSWORD"`
+	From     string        `env:"SMTP_FROM" envDefault:"result-service@localhost"`
+	Timeout  time.Duration `env:"SMTP_TIMEOUT" envDefault:"10s"` // how long connecting to the server and sending an email may take
+}
+
+type Secrets struct {
+	EncryptionKeys map[string]string `env:"SECRETS_ENCRYPTION_KEYS,required" envSeparator:"," envKeyValSeparator:":"` // key id to base64 encoded 32-byte key, e.g. "k2:<key>,k1:<key>"
+	CurrentKeyID   string            `env:"SECRETS_CURRENT_KEY_ID,required"`                                          // id of the key which encrypts new secrets
+	HashKey        string            `env:"SECRETS_HASH_KEY,required"`                                                // base64 encoded 32-byte key of the hash used to look up secrets
 }
 
 type PG struct {
@@ -0,0 +1,6 @@
+begin;
+
+alter type subscription_status add value if not exists 'match_cancelled';
+alter type subscription_status add value if not exists 'match_failed';
+
+commit;
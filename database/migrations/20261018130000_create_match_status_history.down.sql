@@ -0,0 +1,5 @@
+begin;
+
+drop table if exists match_status_history;
+
+commit;
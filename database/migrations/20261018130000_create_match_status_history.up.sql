@@ -0,0 +1,17 @@
+begin;
+
+create table if not exists match_status_history
+(
+    id bigserial primary key,
+    match_id bigint not null,
+    from_status result_status not null,
+    to_status result_status not null,
+    reason text not null,
+    attempt_number integer,
+    created_at timestamptz not null default now(),
+    foreign key (match_id) references matches (id) on update cascade on delete cascade
+);
+
+create index if not exists match_status_history_match_id_idx on match_status_history (match_id);
+
+commit;
@@ -0,0 +1,6 @@
+begin;
+
+drop table if exists result_check_attempts;
+drop type result_check_outcome;
+
+commit;
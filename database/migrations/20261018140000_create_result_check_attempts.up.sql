@@ -0,0 +1,24 @@
+begin;
+
+create type result_check_outcome as enum ('rescheduled', 'finished', 'cancelled', 'error');
+
+create table if not exists result_check_attempts
+(
+    id bigserial primary key,
+    match_id bigint not null,
+    attempt_number integer not null,
+    scheduled_at timestamptz,
+    executed_at timestamptz not null,
+    provider_status external_match_status,
+    home_score smallint,
+    away_score smallint,
+    outcome result_check_outcome not null,
+    error_message text,
+    snapshot bytea,
+    created_at timestamptz not null default now(),
+    foreign key (match_id) references matches (id) on update cascade on delete cascade
+);
+
+create index if not exists result_check_attempts_match_id_idx on result_check_attempts (match_id);
+
+commit;
@@ -0,0 +1,8 @@
+begin;
+
+drop table if exists outbox_tasks;
+
+drop type outbox_task_status;
+drop type outbox_task_kind;
+
+commit;
@@ -0,0 +1,25 @@
+begin;
+
+create type outbox_task_kind as enum ('result_check');
+create type outbox_task_status as enum ('pending', 'dispatched');
+
+create table if not exists outbox_tasks
+(
+    id bigserial primary key,
+    kind outbox_task_kind not null,
+    match_id bigint not null,
+    attempt_number integer not null,
+    execute_at timestamptz not null,
+    status outbox_task_status not null default 'pending',
+    error text,
+    created_at timestamptz not null default now(),
+    dispatched_at timestamptz,
+    foreign key (match_id) references matches (id) on update cascade on delete cascade
+);
+
+create index if not exists outbox_tasks_pending_idx on outbox_tasks (created_at) where status = 'pending';
+
+-- a match has at most one pending outbox task of a kind, a repeated match creation reuses it
+create unique index if not exists outbox_tasks_match_id_kind_pending_key on outbox_tasks (match_id, kind) where status = 'pending';
+
+commit;
@@ -0,0 +1,6 @@
+begin;
+
+alter table subscriptions drop column if exists previous_key_till;
+alter table subscriptions drop column if exists previous_key;
+
+commit;
@@ -0,0 +1,6 @@
+begin;
+
+alter table subscriptions add column if not exists previous_key text;
+alter table subscriptions add column if not exists previous_key_till timestamptz;
+
+commit;
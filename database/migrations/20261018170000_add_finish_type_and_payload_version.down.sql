@@ -0,0 +1,11 @@
+begin;
+
+alter table subscriptions drop column if exists payload_version;
+
+drop type payload_version;
+
+alter table external_matches drop column if exists finish_type;
+
+drop type finish_type;
+
+commit;
@@ -0,0 +1,11 @@
+begin;
+
+create type finish_type as enum ('regular_time', 'extra_time', 'penalties');
+
+alter table external_matches add column if not exists finish_type finish_type;
+
+create type payload_version as enum ('v1', 'v2');
+
+alter table subscriptions add column if not exists payload_version payload_version not null default 'v1';
+
+commit;
@@ -0,0 +1,7 @@
+begin;
+
+alter table subscriptions drop column if exists delivery_format;
+
+drop type delivery_format;
+
+commit;
@@ -0,0 +1,7 @@
+begin;
+
+create type delivery_format as enum ('webhook', 'cloudevents_structured', 'cloudevents_binary');
+
+alter table subscriptions add column if not exists delivery_format delivery_format not null default 'webhook';
+
+commit;
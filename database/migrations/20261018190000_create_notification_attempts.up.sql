@@ -0,0 +1,21 @@
+begin;
+
+create table if not exists notification_attempts
+(
+    id bigserial primary key,
+    subscription_id bigint not null,
+    delivery_id varchar(36) not null,
+    event_type varchar(64) not null,
+    executed_at timestamptz not null,
+    request_payload text,
+    response_status smallint,
+    response_body text,
+    latency_ms integer not null,
+    error_message text,
+    created_at timestamptz not null default now(),
+    foreign key (subscription_id) references subscriptions (id) on update cascade on delete cascade
+);
+
+create index if not exists notification_attempts_subscription_id_idx on notification_attempts (subscription_id);
+
+commit;
@@ -0,0 +1,16 @@
+begin;
+
+alter table subscriptions drop column if exists delivery_attempts;
+
+update subscriptions set status = 'subscriber_error' where status = 'dead_letter';
+
+alter type subscription_status rename to subscription_status_old;
+create type subscription_status as enum ('pending', 'scheduling_error', 'successful', 'subscriber_error', 'match_cancelled', 'match_failed');
+
+alter table subscriptions alter column status drop default;
+alter table subscriptions alter column status type subscription_status using status::text::subscription_status;
+alter table subscriptions alter column status set default 'pending';
+
+drop type subscription_status_old;
+
+commit;
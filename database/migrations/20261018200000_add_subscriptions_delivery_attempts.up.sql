@@ -0,0 +1,7 @@
+begin;
+
+alter type subscription_status add value if not exists 'dead_letter';
+
+alter table subscriptions add column if not exists delivery_attempts integer not null default 0;
+
+commit;
@@ -0,0 +1,7 @@
+begin;
+
+drop table if exists event_deliveries;
+drop table if exists match_events;
+drop table if exists subscription_event_types;
+
+commit;
@@ -0,0 +1,47 @@
+begin;
+
+create table if not exists subscription_event_types
+(
+    subscription_id bigint not null,
+    event_type varchar(64) not null,
+    primary key (subscription_id, event_type),
+    foreign key (subscription_id) references subscriptions (id) on update cascade on delete cascade
+);
+
+insert into subscription_event_types (subscription_id, event_type)
+select id, 'result.finished' from subscriptions
+on conflict do nothing;
+
+create table if not exists match_events
+(
+    id bigserial primary key,
+    match_id bigint not null,
+    event_type varchar(64) not null,
+    key varchar(64) not null,
+    home_score integer not null,
+    away_score integer not null,
+    starts_at timestamptz not null,
+    created_at timestamptz not null default now(),
+    unique (match_id, key),
+    foreign key (match_id) references matches (id) on update cascade on delete cascade
+);
+
+create table if not exists event_deliveries
+(
+    id bigserial primary key,
+    match_event_id bigint not null,
+    subscription_id bigint not null,
+    delivery_id varchar(36) not null,
+    status subscription_status not null default 'pending',
+    delivery_attempts integer not null default 0,
+    subscriber_error text,
+    notified_at timestamptz,
+    created_at timestamptz not null default now(),
+    unique (match_event_id, subscription_id),
+    foreign key (match_event_id) references match_events (id) on update cascade on delete cascade,
+    foreign key (subscription_id) references subscriptions (id) on update cascade on delete cascade
+);
+
+create index if not exists event_deliveries_subscription_id_idx on event_deliveries (subscription_id);
+
+commit;
@@ -0,0 +1,5 @@
+begin;
+
+drop table if exists standing_subscriptions;
+
+commit;
@@ -0,0 +1,21 @@
+begin;
+
+create table if not exists standing_subscriptions
+(
+    id bigserial primary key,
+    team_id bigint,
+    league_name varchar(255),
+    country_code varchar(16),
+    url_template text not null unique,
+    key text not null,
+    payload_version payload_version not null default 'v1',
+    delivery_format delivery_format not null default 'webhook',
+    created_at timestamptz not null default now(),
+    check (
+        (team_id is not null and league_name is null and country_code is null) or
+        (team_id is null and league_name is not null and country_code is not null)
+    ),
+    foreign key (team_id) references teams (id) on update cascade on delete cascade
+);
+
+commit;
@@ -0,0 +1,26 @@
+begin;
+
+-- subscriptions of chat channels and urls subscribed to several matches can't be represented without the channel
+-- and with the restored url constraint, so the rollback is refused instead of deleting them
+do $$
+begin
+    if exists (select 1 from subscriptions where channel <> 'webhook') then
+        raise exception 'subscriptions of channels other than webhook exist, remove them before the rollback';
+    end if;
+
+    if exists (select 1 from subscriptions s join subscriptions other on other.url = s.url and other.id > s.id) then
+        raise exception 'urls subscribed to several matches exist, remove their subscriptions before the rollback';
+    end if;
+end $$;
+
+drop table if exists subscription_headers;
+
+alter table subscriptions drop constraint if exists subscriptions_match_id_url_key;
+alter table subscriptions add constraint subscriptions_url_key unique (url);
+
+alter table subscriptions drop column if exists http_method;
+alter table subscriptions drop column if exists channel;
+
+drop type notification_channel;
+
+commit;
@@ -0,0 +1,21 @@
+begin;
+
+create type notification_channel as enum ('webhook', 'telegram', 'slack', 'discord', 'email');
+
+alter table subscriptions add column if not exists channel notification_channel not null default 'webhook';
+alter table subscriptions add column if not exists http_method varchar(8) not null default 'PATCH';
+
+-- chat ids and email addresses are reused by subscriptions of different matches
+alter table subscriptions drop constraint if exists subscriptions_url_key;
+alter table subscriptions add constraint subscriptions_match_id_url_key unique (match_id, url);
+
+create table if not exists subscription_headers
+(
+    subscription_id bigint not null,
+    name varchar(256) not null,
+    value text not null,
+    primary key (subscription_id, name),
+    foreign key (subscription_id) references subscriptions (id) on update cascade on delete cascade
+);
+
+commit;
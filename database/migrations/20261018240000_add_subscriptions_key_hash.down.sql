@@ -0,0 +1,7 @@
+begin;
+
+drop index if exists subscriptions_key_hash_idx;
+
+alter table subscriptions drop column if exists key_hash;
+
+commit;
@@ -0,0 +1,8 @@
+begin;
+
+-- keys are encrypted by `migrate up` after the schema migrations, so subscriptions are looked up by the keyed hash of the key
+alter table subscriptions add column if not exists key_hash varchar(64);
+
+create index if not exists subscriptions_key_hash_idx on subscriptions (key_hash);
+
+commit;
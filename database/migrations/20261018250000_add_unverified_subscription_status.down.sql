@@ -0,0 +1,19 @@
+begin;
+
+-- endpoints which weren't verified are treated as verified ones, as it was before the verification
+update subscriptions set status = 'pending' where status = 'unverified';
+
+alter type subscription_status rename to subscription_status_old;
+create type subscription_status as enum ('pending', 'scheduling_error', 'successful', 'subscriber_error', 'match_cancelled', 'match_failed', 'dead_letter');
+
+alter table subscriptions alter column status drop default;
+alter table subscriptions alter column status type subscription_status using status::text::subscription_status;
+alter table subscriptions alter column status set default 'pending';
+
+alter table event_deliveries alter column status drop default;
+alter table event_deliveries alter column status type subscription_status using status::text::subscription_status;
+alter table event_deliveries alter column status set default 'pending';
+
+drop type subscription_status_old;
+
+commit;
@@ -0,0 +1,5 @@
+begin;
+
+alter type subscription_status add value if not exists 'unverified';
+
+commit;
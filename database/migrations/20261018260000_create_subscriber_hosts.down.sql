@@ -0,0 +1,24 @@
+begin;
+
+drop table if exists subscriber_hosts;
+
+-- suspended subscriptions are delivered again, unsubscribed ones are kept as permanently failed
+update subscriptions set status = 'pending' where status = 'suspended';
+update subscriptions set status = 'subscriber_error' where status = 'unsubscribed';
+update event_deliveries set status = 'pending' where status = 'suspended';
+update event_deliveries set status = 'subscriber_error' where status = 'unsubscribed';
+
+alter type subscription_status rename to subscription_status_old;
+create type subscription_status as enum ('pending', 'scheduling_error', 'successful', 'subscriber_error', 'match_cancelled', 'match_failed', 'dead_letter', 'unverified');
+
+alter table subscriptions alter column status drop default;
+alter table subscriptions alter column status type subscription_status using status::text::subscription_status;
+alter table subscriptions alter column status set default 'pending';
+
+alter table event_deliveries alter column status drop default;
+alter table event_deliveries alter column status type subscription_status using status::text::subscription_status;
+alter table event_deliveries alter column status set default 'pending';
+
+drop type subscription_status_old;
+
+commit;
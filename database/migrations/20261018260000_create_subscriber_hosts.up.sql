@@ -0,0 +1,18 @@
+begin;
+
+alter type subscription_status add value if not exists 'suspended';
+alter type subscription_status add value if not exists 'unsubscribed';
+
+create table if not exists subscriber_hosts
+(
+    host varchar(255) primary key,
+    consecutive_failures integer not null default 0,
+    last_error text,
+    last_failure_at timestamptz,
+    last_success_at timestamptz,
+    suspended_at timestamptz,
+    created_at timestamptz not null default now(),
+    updated_at timestamptz not null default now()
+);
+
+commit;
@@ -0,0 +1,10 @@
+begin;
+
+alter table subscriptions drop column if exists notification_batch_id;
+alter table subscriptions drop column if exists batch_url;
+
+drop table if exists notification_batches;
+
+drop type if exists notification_batch_status;
+
+commit;
@@ -0,0 +1,22 @@
+begin;
+
+create type notification_batch_status as enum ('open', 'closed');
+
+create table if not exists notification_batches
+(
+    id serial primary key,
+    batch_url text not null,
+    key_hash varchar(64),
+    status notification_batch_status not null default 'open',
+    deliver_at timestamptz not null,
+    created_at timestamptz not null default now(),
+    updated_at timestamptz not null default now()
+);
+
+-- subscriptions sharing the batch url and the secret key join the same open batch until it is delivered
+create unique index if not exists notification_batches_open_idx on notification_batches (batch_url, key_hash) where status = 'open';
+
+alter table subscriptions add column if not exists batch_url text;
+alter table subscriptions add column if not exists notification_batch_id integer references notification_batches (id) on delete set null;
+
+commit;
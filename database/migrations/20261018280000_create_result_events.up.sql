@@ -0,0 +1,20 @@
+begin;
+
+-- the feed of results pulled by consumers, the id is the cursor. Events keep the match data,
+-- as they outlive the match which is deleted together with its last subscription
+create table if not exists result_events
+(
+    id bigserial primary key,
+    match_id bigint not null,
+    event_type varchar(64) not null,
+    home_team_id bigint not null,
+    away_team_id bigint not null,
+    starts_at timestamptz not null,
+    home_score integer not null,
+    away_score integer not null,
+    finish_type finish_type,
+    created_at timestamptz not null default now(),
+    unique (match_id, event_type)
+);
+
+commit;
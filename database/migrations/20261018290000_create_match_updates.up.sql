@@ -0,0 +1,17 @@
+begin;
+
+-- changes of the status and the score observed by result checks, streamed to live viewers
+create table if not exists match_updates
+(
+    id bigserial primary key,
+    match_id bigint not null,
+    status external_match_status not null,
+    home_score integer not null,
+    away_score integer not null,
+    observed_at timestamptz not null default now(),
+    foreign key (match_id) references matches (id) on update cascade on delete cascade
+);
+
+create index if not exists match_updates_match_id_idx on match_updates (match_id, id);
+
+commit;
@@ -0,0 +1,6 @@
+begin;
+
+-- minutes before the kickoff the subscriber is reminded about it, no reminder when null
+alter table subscriptions add column if not exists remind_before integer;
+
+commit;
@@ -0,0 +1,9 @@
+begin;
+
+alter table standing_subscriptions drop column if exists auth_scheme;
+alter table standing_subscriptions drop column if exists auth_header;
+
+alter table subscriptions drop column if exists auth_scheme;
+alter table subscriptions drop column if exists auth_header;
+
+commit;
@@ -0,0 +1,14 @@
+begin;
+
+-- header the secret key is sent in and the scheme preceding it, the key is only used for signing when the header is null
+alter table subscriptions add column if not exists auth_header varchar(256);
+alter table subscriptions add column if not exists auth_scheme varchar(64);
+
+alter table standing_subscriptions add column if not exists auth_header varchar(256);
+alter table standing_subscriptions add column if not exists auth_scheme varchar(64);
+
+-- webhooks created before the signature received the key in Authorization header and their consumers still authenticate with it
+update subscriptions set auth_header = 'Authorization' where channel = 'webhook';
+update standing_subscriptions set auth_header = 'Authorization';
+
+commit;
@@ -0,0 +1,5 @@
+begin;
+
+alter table subscriptions drop column if exists delivery_id;
+
+commit;
@@ -0,0 +1,6 @@
+begin;
+
+-- every attempt of the result notification carries the same delivery id, so the subscriber can ignore repeated deliveries
+alter table subscriptions add column if not exists delivery_id varchar(36) not null default gen_random_uuid()::varchar;
+
+commit;
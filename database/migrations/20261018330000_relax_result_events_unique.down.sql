@@ -0,0 +1,8 @@
+begin;
+
+-- only the last corrected event of a match is kept to restore the unique key
+delete from result_events e using result_events later where e.match_id = later.match_id and e.event_type = later.event_type and e.id < later.id;
+drop index if exists result_events_match_id_event_type_idx;
+alter table result_events add constraint result_events_match_id_event_type_key unique (match_id, event_type);
+
+commit;
@@ -0,0 +1,7 @@
+begin;
+
+-- the score of a match can be corrected several times, so only finished and cancelled events stay unique per match
+alter table result_events drop constraint if exists result_events_match_id_event_type_key;
+create unique index if not exists result_events_match_id_event_type_idx on result_events (match_id, event_type) where event_type <> 'result.corrected';
+
+commit;
@@ -0,0 +1,5 @@
+begin;
+
+-- the unique pending index belongs to the outbox_tasks table since its creation, so it is dropped together with the table
+
+commit;
@@ -0,0 +1,10 @@
+begin;
+
+-- databases which created outbox_tasks before the unique pending index was added to its migration get it here,
+-- only the latest pending task of a match and kind is kept
+delete from outbox_tasks t using outbox_tasks later
+where t.match_id = later.match_id and t.kind = later.kind and t.status = 'pending' and later.status = 'pending' and t.id < later.id;
+
+create unique index if not exists outbox_tasks_match_id_kind_pending_key on outbox_tasks (match_id, kind) where status = 'pending';
+
+commit;
@@ -0,0 +1,13 @@
+begin;
+
+-- a correction check is a check of the finished match
+update result_check_attempts set outcome = 'finished' where outcome = 'corrected';
+
+alter type result_check_outcome rename to result_check_outcome_old;
+create type result_check_outcome as enum ('rescheduled', 'finished', 'cancelled', 'error');
+
+alter table result_check_attempts alter column outcome type result_check_outcome using outcome::text::result_check_outcome;
+
+drop type result_check_outcome_old;
+
+commit;
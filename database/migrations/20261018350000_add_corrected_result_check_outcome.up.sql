@@ -0,0 +1,5 @@
+begin;
+
+alter type result_check_outcome add value if not exists 'corrected';
+
+commit;
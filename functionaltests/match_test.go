@@ -103,6 +103,13 @@ func (s *FunctionalTestSuite) TestCreateMatch_Success() {
 			CreatedAt:     checkResultTasks[0].CreatedAt,
 		},
 	}, checkResultTasks)
+
+	outboxTasks := testutils.ListOutboxTasks(s.T(), s.db)
+	s.Require().Len(outboxTasks, 1)
+	s.Equal(string(models.OutboxKindResultCheck), outboxTasks[0].Kind)
+	s.Equal(response.MatchID, outboxTasks[0].MatchID)
+	s.Equal(string(models.OutboxDispatched), outboxTasks[0].Status)
+	s.NotNil(outboxTasks[0].DispatchedAt)
 }
 
 func (s *FunctionalTestSuite) TestCreateMatch_InvalidPayload() {
@@ -0,0 +1,90 @@
+//go:build functional
+
+package functionaltests
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/andrewshostak/result-service/internal/adapters/http/server/handler"
+	"github.com/andrewshostak/result-service/internal/adapters/repository"
+	"github.com/andrewshostak/result-service/internal/app/models"
+	"github.com/andrewshostak/result-service/testutils"
+)
+
+func (s *FunctionalTestSuite) TestStreamMatchUpdates_Success() {
+	teamSeeds := testutils.SetupTeamsWithRelations(s.T(), s.db)
+
+	watched := testutils.CreateMatch(s.T(), s.db, repository.Match{
+		StartsAt:     testutils.RandomFutureDate(s.T()),
+		HomeTeamID:   uint(teamSeeds[0].TeamID),
+		AwayTeamID:   uint(teamSeeds[1].TeamID),
+		ResultStatus: string(models.Scheduled),
+	})
+	other := testutils.CreateMatch(s.T(), s.db, repository.Match{
+		StartsAt:     testutils.RandomFutureDate(s.T()),
+		HomeTeamID:   uint(teamSeeds[1].TeamID),
+		AwayTeamID:   uint(teamSeeds[0].TeamID),
+		ResultStatus: string(models.Scheduled),
+	})
+
+	kickoff := testutils.CreateMatchUpdate(s.T(), s.db, repository.MatchUpdate{MatchID: watched.ID, Status: string(models.StatusMatchInProgress)})
+	_ = testutils.CreateMatchUpdate(s.T(), s.db, repository.MatchUpdate{MatchID: other.ID, Status: string(models.StatusMatchInProgress)})
+	goal := testutils.CreateMatchUpdate(s.T(), s.db, repository.MatchUpdate{MatchID: watched.ID, Status: string(models.StatusMatchInProgress), HomeScore: 1})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, fmt.Sprintf("%s/v1/match_updates/stream?match_id=%d", s.apiBaseURL, watched.ID), nil)
+	s.Require().NoError(err)
+	req.Header.Add("Authorization", secretKey)
+	req.Header.Add("Last-Event-ID", "0")
+
+	resp, err := s.httpClient.Do(req)
+	s.Require().NoError(err)
+	defer resp.Body.Close()
+
+	s.Require().Equal(http.StatusOK, resp.StatusCode)
+	s.Equal("text/event-stream", resp.Header.Get("Content-Type"))
+
+	var ids []string
+	var updates []handler.MatchUpdateResponse
+
+	scanner := bufio.NewScanner(resp.Body)
+	for len(updates) < 2 && scanner.Scan() {
+		line := scanner.Text()
+		switch {
+		case strings.HasPrefix(line, "id: "):
+			ids = append(ids, strings.TrimPrefix(line, "id: "))
+		case strings.HasPrefix(line, "data: "):
+			var update handler.MatchUpdateResponse
+			s.Require().NoError(json.Unmarshal([]byte(strings.TrimPrefix(line, "data: ")), &update))
+			updates = append(updates, update)
+		}
+	}
+	s.Require().NoError(scanner.Err())
+
+	s.Require().Len(updates, 2)
+	s.Equal([]string{fmt.Sprint(kickoff.ID), fmt.Sprint(goal.ID)}, ids)
+	s.Equal(watched.ID, updates[0].MatchID)
+	s.Equal(string(models.StatusMatchInProgress), updates[0].Status)
+	s.Equal(handler.ScoreResponse{Home: 1, Away: 0}, updates[1].Score)
+}
+
+func (s *FunctionalTestSuite) TestStreamMatchUpdates_InvalidLastEventID() {
+	req, err := http.NewRequest(http.MethodGet, fmt.Sprintf("%s/v1/match_updates/stream", s.apiBaseURL), nil)
+	s.Require().NoError(err)
+	req.Header.Add("Authorization", secretKey)
+	req.Header.Add("Last-Event-ID", "abc")
+
+	resp, err := s.httpClient.Do(req)
+	s.Require().NoError(err)
+	defer resp.Body.Close()
+
+	s.Equal(http.StatusBadRequest, resp.StatusCode)
+}
@@ -0,0 +1,98 @@
+//go:build functional
+
+package functionaltests
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+
+	"github.com/andrewshostak/result-service/internal/adapters/http/server/handler"
+	"github.com/andrewshostak/result-service/internal/adapters/repository"
+	"github.com/andrewshostak/result-service/internal/app/models"
+	"github.com/andrewshostak/result-service/testutils"
+	"github.com/brianvoe/gofakeit/v6"
+)
+
+func (s *FunctionalTestSuite) TestListResults_Success() {
+	finishType := string(models.FinishPenalties)
+	finished := testutils.CreateResultEvent(s.T(), s.db, repository.ResultEvent{
+		MatchID:    uint(gofakeit.Uint16()),
+		EventType:  string(models.EventResultFinished),
+		HomeTeamID: uint(gofakeit.Uint8()),
+		AwayTeamID: uint(gofakeit.Uint8()),
+		StartsAt:   testutils.RandomFutureDate(s.T()),
+		HomeScore:  2,
+		AwayScore:  1,
+		FinishType: &finishType,
+	})
+	cancelled := testutils.CreateResultEvent(s.T(), s.db, repository.ResultEvent{
+		MatchID:    uint(gofakeit.Uint16()),
+		EventType:  string(models.EventMatchCancelled),
+		HomeTeamID: uint(gofakeit.Uint8()),
+		AwayTeamID: uint(gofakeit.Uint8()),
+		StartsAt:   testutils.RandomFutureDate(s.T()),
+	})
+
+	resp, body := s.listResults(fmt.Sprintf("%s/v1/results?since=0", s.apiBaseURL), "")
+	s.Require().Equal(http.StatusOK, resp.StatusCode)
+
+	var response handler.ResultEventsResponse
+	err := json.Unmarshal(body, &response)
+	s.Require().NoError(err)
+	s.Require().Len(response.Events, 2)
+	s.Equal(finished.ID, response.Events[0].ID)
+	s.Equal(string(models.EventResultFinished), response.Events[0].EventType)
+	s.Equal(handler.ScoreResponse{Home: 2, Away: 1}, response.Events[0].Score)
+	s.Equal(&finishType, response.Events[0].FinishType)
+	s.Equal(cancelled.ID, response.Events[1].ID)
+	s.Equal(string(models.EventMatchCancelled), response.Events[1].EventType)
+	s.Equal(strconv.Itoa(int(cancelled.ID)), response.NextCursor)
+	s.Equal(fmt.Sprintf(`"%d"`, cancelled.ID), resp.Header.Get("ETag"))
+
+	resp, body = s.listResults(fmt.Sprintf("%s/v1/results?since=%d", s.apiBaseURL, finished.ID), "")
+	s.Require().Equal(http.StatusOK, resp.StatusCode)
+
+	err = json.Unmarshal(body, &response)
+	s.Require().NoError(err)
+	s.Require().Len(response.Events, 1)
+	s.Equal(cancelled.ID, response.Events[0].ID)
+}
+
+func (s *FunctionalTestSuite) TestListResults_NotModified() {
+	event := testutils.CreateResultEvent(s.T(), s.db, repository.ResultEvent{
+		MatchID:    uint(gofakeit.Uint16()),
+		EventType:  string(models.EventResultFinished),
+		HomeTeamID: uint(gofakeit.Uint8()),
+		AwayTeamID: uint(gofakeit.Uint8()),
+		StartsAt:   testutils.RandomFutureDate(s.T()),
+	})
+
+	url := fmt.Sprintf("%s/v1/results?since=%d&wait=1", s.apiBaseURL, event.ID)
+	resp, body := s.listResults(url, fmt.Sprintf(`"%d"`, event.ID))
+	s.Require().Equal(http.StatusNotModified, resp.StatusCode)
+	s.Empty(body)
+}
+
+func (s *FunctionalTestSuite) listResults(url string, ifNoneMatch string) (*http.Response, []byte) {
+	req, err := http.NewRequest(http.MethodGet, url, nil)
+	s.Require().NoError(err)
+	req.Header.Add("Authorization", secretKey)
+	if ifNoneMatch != "" {
+		req.Header.Add("If-None-Match", ifNoneMatch)
+	}
+
+	resp, err := s.httpClient.Do(req)
+	s.Require().NoError(err)
+
+	defer func(Body io.ReadCloser) {
+		_ = Body.Close()
+	}(resp.Body)
+
+	body, err := io.ReadAll(resp.Body)
+	s.Require().NoError(err)
+
+	return resp, body
+}
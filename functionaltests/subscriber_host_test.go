@@ -0,0 +1,143 @@
+//go:build functional
+
+package functionaltests
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/andrewshostak/result-service/internal/adapters/http/server/handler"
+	"github.com/andrewshostak/result-service/internal/adapters/repository"
+	"github.com/andrewshostak/result-service/internal/app/models"
+	"github.com/andrewshostak/result-service/testutils"
+	"github.com/brianvoe/gofakeit/v6"
+)
+
+func (s *FunctionalTestSuite) TestListSubscriberHosts_Success() {
+	suspendedAt := time.Now().UTC().Truncate(time.Second)
+	lastError := "service unavailable"
+	_ = testutils.CreateSubscriberHost(s.T(), s.db, repository.SubscriberHost{
+		Host:                "hooks.example.com",
+		ConsecutiveFailures: 20,
+		LastError:           &lastError,
+		LastFailureAt:       &suspendedAt,
+		SuspendedAt:         &suspendedAt,
+	})
+
+	req, err := http.NewRequest(http.MethodGet, s.apiBaseURL+"/v1/subscriber_hosts", nil)
+	s.Require().NoError(err)
+	req.Header.Add("Authorization", secretKey)
+
+	resp, err := s.httpClient.Do(req)
+	s.Require().NoError(err)
+
+	defer func(Body io.ReadCloser) {
+		_ = Body.Close()
+	}(resp.Body)
+
+	s.Require().Equal(http.StatusOK, resp.StatusCode)
+
+	body, err := io.ReadAll(resp.Body)
+	s.Require().NoError(err)
+
+	var response struct {
+		SubscriberHosts []handler.SubscriberHostResponse `json:"subscriber_hosts"`
+	}
+	err = json.Unmarshal(body, &response)
+	s.Require().NoError(err)
+	s.Require().Len(response.SubscriberHosts, 1)
+	s.Equal("hooks.example.com", response.SubscriberHosts[0].Host)
+	s.Equal(uint(20), response.SubscriberHosts[0].ConsecutiveFailures)
+	s.Equal(&lastError, response.SubscriberHosts[0].LastError)
+	s.Require().NotNil(response.SubscriberHosts[0].SuspendedAt)
+	s.True(suspendedAt.Equal(*response.SubscriberHosts[0].SuspendedAt))
+}
+
+func (s *FunctionalTestSuite) TestEnableSubscriberHost_Success() {
+	teamSeeds := testutils.SetupTeamsWithRelations(s.T(), s.db)
+
+	match := testutils.CreateMatch(s.T(), s.db, repository.Match{
+		StartsAt:     testutils.RandomFutureDate(s.T()),
+		HomeTeamID:   uint(teamSeeds[0].TeamID),
+		AwayTeamID:   uint(teamSeeds[1].TeamID),
+		ResultStatus: string(models.Received),
+	})
+
+	baseURL, err := url.Parse(s.smockerBaseURL)
+	s.Require().NoError(err)
+
+	suspendedAt := time.Now()
+	_ = testutils.CreateSubscriberHost(s.T(), s.db, repository.SubscriberHost{
+		Host:                baseURL.Hostname(),
+		ConsecutiveFailures: 20,
+		SuspendedAt:         &suspendedAt,
+	})
+
+	subscription := testutils.CreateSubscription(s.T(), s.db, testutils.FakeRepositorySubscription(func(sub *repository.Subscription) {
+		sub.MatchID = match.ID
+		sub.Url = fmt.Sprintf("%s/matches/%d", s.smockerBaseURL, match.ID)
+		sub.Key = gofakeit.UUID()
+		sub.Status = string(models.SuspendedSub)
+	}))
+
+	url := fmt.Sprintf("%s/v1/subscriber_hosts/%s/enable", s.apiBaseURL, baseURL.Hostname())
+	req, err := http.NewRequest(http.MethodPost, url, nil)
+	s.Require().NoError(err)
+	req.Header.Add("Authorization", secretKey)
+
+	resp, err := s.httpClient.Do(req)
+	s.Require().NoError(err)
+
+	defer func(Body io.ReadCloser) {
+		_ = Body.Close()
+	}(resp.Body)
+
+	s.Require().Equal(http.StatusOK, resp.StatusCode)
+
+	body, err := io.ReadAll(resp.Body)
+	s.Require().NoError(err)
+
+	var response struct {
+		EnabledSubscriptions uint `json:"enabled_subscriptions"`
+	}
+	err = json.Unmarshal(body, &response)
+	s.Require().NoError(err)
+	s.Equal(uint(1), response.EnabledSubscriptions)
+
+	host := testutils.GetSubscriberHost(s.T(), s.db, baseURL.Hostname())
+	s.Equal(uint(0), host.ConsecutiveFailures)
+	s.Nil(host.SuspendedAt)
+
+	subscriptions := testutils.ListSubscriptionsByMatch(s.T(), s.db, match.ID)
+	s.Require().Len(subscriptions, 1)
+	s.Equal(subscription.ID, subscriptions[0].ID)
+	s.NotEqual(string(models.SuspendedSub), subscriptions[0].Status)
+}
+
+func (s *FunctionalTestSuite) TestEnableSubscriberHost_NotFound() {
+	url := fmt.Sprintf("%s/v1/subscriber_hosts/%s/enable", s.apiBaseURL, gofakeit.DomainName())
+	req, err := http.NewRequest(http.MethodPost, url, nil)
+	s.Require().NoError(err)
+	req.Header.Add("Authorization", secretKey)
+
+	resp, err := s.httpClient.Do(req)
+	s.Require().NoError(err)
+
+	defer func(Body io.ReadCloser) {
+		_ = Body.Close()
+	}(resp.Body)
+
+	s.Require().Equal(http.StatusNotFound, resp.StatusCode)
+
+	body, err := io.ReadAll(resp.Body)
+	s.Require().NoError(err)
+
+	var response handler.ErrorResponse
+	err = json.Unmarshal(body, &response)
+	s.Require().NoError(err)
+	s.Equal(string(models.CodeResourceNotFound), response.Code)
+}
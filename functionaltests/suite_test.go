@@ -10,6 +10,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/andrewshostak/result-service/testutils"
 	"github.com/gin-gonic/gin"
 	"github.com/golang-migrate/migrate/v4"
 	migratepg "github.com/golang-migrate/migrate/v4/database/postgres"
@@ -150,6 +151,10 @@ func (s *FunctionalTestSuite) SetupSuite() {
 			"SECRET_KEY":                         "i_am_a_secret_key",
 			"HASHED_API_KEYS":                    "a87a39c7ddb9682faa412e209834b92d96470cc21878f391c719b3357a8126387b3817628dca009b5e5a66a9e576bbf9361d8b60a7f85f5cfd3f17c15cfed6b5",
 			"FOTMOB_API_BASE_URL":                s.smockerBaseURL,
+			"NOTIFICATION_ALLOWED_HOSTS":         smockerAlias,
+			"SECRETS_ENCRYPTION_KEYS":            testutils.EncryptionKeyID + ":" + testutils.EncryptionKey,
+			"SECRETS_CURRENT_KEY_ID":             testutils.EncryptionKeyID,
+			"SECRETS_HASH_KEY":                   testutils.HashKey,
 			"GOOGLE_CLOUD_PROJECT_ID":            "test-project",
 			"GOOGLE_CLOUD_REGION":                "europe-west3",
 			"GOOGLE_CLOUD_TARGET_URL":            "localhost:8080",
@@ -257,6 +262,17 @@ func (s *FunctionalTestSuite) cleanDatabase() {
 		"subscriptions",
 		"external_matches",
 		"check_result_tasks",
+		"outbox_tasks",
+		"notification_attempts",
+		"subscription_event_types",
+		"subscription_headers",
+		"match_events",
+		"event_deliveries",
+		"standing_subscriptions",
+		"subscriber_hosts",
+		"notification_batches",
+		"result_events",
+		"match_updates",
 	}
 	for _, table := range tables {
 		_, err := s.db.Exec(fmt.Sprintf("TRUNCATE TABLE %s RESTART IDENTITY CASCADE", table))
@@ -0,0 +1,77 @@
+//go:build functional
+
+package functionaltests
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"net/http"
+
+	"github.com/andrewshostak/result-service/internal/adapters/http/server/handler"
+	"github.com/andrewshostak/result-service/internal/adapters/repository"
+	"github.com/andrewshostak/result-service/internal/app/models"
+	"github.com/andrewshostak/result-service/testutils"
+	"github.com/brianvoe/gofakeit/v6"
+)
+
+func (s *FunctionalTestSuite) TestTriggerKickoffReminder_SubscriptionNotFound() {
+	resp, body := s.triggerKickoffReminder(handler.TriggerKickoffReminderRequest{
+		SubscriptionID: uint(gofakeit.Uint16()) + 1,
+		Kickoff:        testutils.RandomFutureDate(s.T()).Unix(),
+	})
+	s.Require().Equal(http.StatusBadRequest, resp.StatusCode)
+
+	var response handler.ErrorResponse
+	err := json.Unmarshal(body, &response)
+	s.Require().NoError(err)
+	s.Equal(string(models.CodeResourceNotFound), response.Code)
+}
+
+func (s *FunctionalTestSuite) TestTriggerKickoffReminder_MatchCancelled() {
+	teamSeeds := testutils.SetupTeamsWithRelations(s.T(), s.db)
+
+	startsAt := testutils.RandomFutureDate(s.T())
+	match := testutils.CreateMatch(s.T(), s.db, repository.Match{
+		StartsAt:     startsAt,
+		HomeTeamID:   uint(teamSeeds[0].TeamID),
+		AwayTeamID:   uint(teamSeeds[1].TeamID),
+		ResultStatus: string(models.Cancelled),
+	})
+
+	remindBefore := uint(30)
+	subscription := testutils.CreateSubscription(s.T(), s.db, testutils.FakeRepositorySubscription(func(sub *repository.Subscription) {
+		sub.MatchID = match.ID
+		sub.Status = string(models.PendingSub)
+		sub.RemindBefore = &remindBefore
+	}))
+
+	resp, _ := s.triggerKickoffReminder(handler.TriggerKickoffReminderRequest{SubscriptionID: subscription.ID, Kickoff: startsAt.Unix()})
+	s.Require().Equal(http.StatusNoContent, resp.StatusCode)
+
+	var events int
+	err := s.db.Get(&events, "SELECT count(*) FROM match_events WHERE match_id = $1", match.ID)
+	s.Require().NoError(err)
+	s.Zero(events)
+}
+
+func (s *FunctionalTestSuite) triggerKickoffReminder(request handler.TriggerKickoffReminderRequest) (*http.Response, []byte) {
+	requestBody, err := json.Marshal(&request)
+	s.Require().NoError(err)
+
+	req, err := http.NewRequest(http.MethodPost, s.apiBaseURL+"/v1/triggers/kickoff_reminder", bytes.NewBuffer(requestBody))
+	s.Require().NoError(err)
+	req.Header.Add("Authorization", "Bearer anything")
+
+	resp, err := s.httpClient.Do(req)
+	s.Require().NoError(err)
+
+	defer func(Body io.ReadCloser) {
+		_ = Body.Close()
+	}(resp.Body)
+
+	body, err := io.ReadAll(resp.Body)
+	s.Require().NoError(err)
+
+	return resp, body
+}
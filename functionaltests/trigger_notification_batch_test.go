@@ -0,0 +1,109 @@
+//go:build functional
+
+package functionaltests
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/andrewshostak/result-service/internal/adapters/http/server/handler"
+	"github.com/andrewshostak/result-service/internal/adapters/repository"
+	"github.com/andrewshostak/result-service/internal/app/models"
+	"github.com/andrewshostak/result-service/testutils"
+	"github.com/brianvoe/gofakeit/v6"
+)
+
+func (s *FunctionalTestSuite) TestTriggerNotificationBatch_NotificationBatchNotFound() {
+	requestBody, err := json.Marshal(&handler.TriggerNotificationBatchRequest{NotificationBatchID: uint(gofakeit.Uint16()) + 1})
+	s.Require().NoError(err)
+
+	req, err := http.NewRequest(http.MethodPost, s.apiBaseURL+"/v1/triggers/notification_batch", bytes.NewBuffer(requestBody))
+	s.Require().NoError(err)
+	req.Header.Add("Authorization", "Bearer anything")
+
+	resp, err := s.httpClient.Do(req)
+	s.Require().NoError(err)
+
+	defer func(Body io.ReadCloser) {
+		_ = Body.Close()
+	}(resp.Body)
+
+	s.Require().Equal(http.StatusBadRequest, resp.StatusCode)
+
+	body, err := io.ReadAll(resp.Body)
+	s.Require().NoError(err)
+
+	var response handler.ErrorResponse
+	err = json.Unmarshal(body, &response)
+	s.Require().NoError(err)
+	s.Equal(string(models.CodeResourceNotFound), response.Code)
+}
+
+func (s *FunctionalTestSuite) TestTriggerNotificationBatch_SubscriberAcknowledgesItems() {
+	teamSeeds := testutils.SetupTeamsWithRelations(s.T(), s.db)
+
+	match := testutils.CreateMatch(s.T(), s.db, repository.Match{
+		StartsAt:     testutils.RandomFutureDate(s.T()),
+		HomeTeamID:   uint(teamSeeds[0].TeamID),
+		AwayTeamID:   uint(teamSeeds[1].TeamID),
+		ResultStatus: string(models.Received),
+	})
+
+	_ = testutils.CreateExternalMatch(s.T(), s.db, testutils.FakeExternalMatchRepository(func(m *repository.ExternalMatch) {
+		m.MatchID = match.ID
+		m.Status = string(models.StatusMatchFinished)
+	}))
+
+	batchPath := "/batch"
+	batchURL := s.smockerBaseURL + batchPath
+	batch := testutils.CreateNotificationBatch(s.T(), s.db, repository.NotificationBatch{
+		BatchURL:  batchURL,
+		DeliverAt: time.Now(),
+	})
+
+	key := gofakeit.UUID()
+	for i := 0; i < 2; i++ {
+		_ = testutils.CreateSubscription(s.T(), s.db, testutils.FakeRepositorySubscription(func(sub *repository.Subscription) {
+			sub.MatchID = match.ID
+			sub.Url = fmt.Sprintf("%s/matches/%d/%d", s.smockerBaseURL, match.ID, i)
+			sub.Key = key
+			sub.Status = string(models.PendingSub)
+			sub.BatchURL = &batchURL
+			sub.NotificationBatchID = &batch.ID
+		}))
+	}
+
+	testutils.MockHTTPRequest(s.T(), s.smockerAdminURL, batchPath,
+		testutils.WithMethod(http.MethodPost),
+		testutils.WithAcknowledgedBatchItems(),
+	)
+
+	requestBody, err := json.Marshal(&handler.TriggerNotificationBatchRequest{NotificationBatchID: batch.ID})
+	s.Require().NoError(err)
+
+	req, err := http.NewRequest(http.MethodPost, s.apiBaseURL+"/v1/triggers/notification_batch", bytes.NewBuffer(requestBody))
+	s.Require().NoError(err)
+	req.Header.Add("Authorization", "Bearer anything")
+
+	resp, err := s.httpClient.Do(req)
+	s.Require().NoError(err)
+
+	defer func(Body io.ReadCloser) {
+		_ = Body.Close()
+	}(resp.Body)
+
+	s.Require().Equal(http.StatusNoContent, resp.StatusCode)
+
+	subscriptions := testutils.ListSubscriptionsByMatch(s.T(), s.db, match.ID)
+	s.Require().Len(subscriptions, 2)
+	for _, subscription := range subscriptions {
+		s.Equal(string(models.SuccessfulSub), subscription.Status)
+		s.Require().NotNil(subscription.NotifiedAt)
+		s.WithinDuration(time.Now(), *subscription.NotifiedAt, 10*time.Second)
+		s.Len(testutils.ListNotificationAttempts(s.T(), s.db, subscription.ID), 1)
+	}
+}
@@ -601,6 +601,12 @@ func (s *FunctionalTestSuite) TestTriggerResultCheck_MatchNotFinished() {
 			CreatedAt:     checkResultTask.CreatedAt,
 		},
 	}, checkResultTasks)
+
+	outboxTasks := testutils.ListOutboxTasks(s.T(), s.db)
+	s.Require().Len(outboxTasks, 1)
+	s.Equal(match.ID, outboxTasks[0].MatchID)
+	s.Equal(checkResultTask.AttemptNumber+1, outboxTasks[0].AttemptNumber)
+	s.Equal(string(models.OutboxDispatched), outboxTasks[0].Status)
 }
 
 func (s *FunctionalTestSuite) TestTriggerResultCheck_MatchNotFinishedAndCheckResultTaskNotFound() {
@@ -173,7 +173,7 @@ func (s *FunctionalTestSuite) TestTriggerSubscriberNotification_ExternalMatchRel
 	s.Equal(string(models.CodeInternalServerError), response.Code)
 }
 
-func (s *FunctionalTestSuite) TestTriggerSubscriberNotification_SubscriberReturnsNonSuccessfulStatusCode() {
+func (s *FunctionalTestSuite) TestTriggerSubscriberNotification_SubscriberReturnsServerError() {
 	teamSeeds := testutils.SetupTeamsWithRelations(s.T(), s.db)
 
 	matchToCreate := repository.Match{
@@ -219,16 +219,74 @@ func (s *FunctionalTestSuite) TestTriggerSubscriberNotification_SubscriberReturn
 		_ = Body.Close()
 	}(resp.Body)
 
-	s.Require().Equal(http.StatusInternalServerError, resp.StatusCode)
+	s.Require().Equal(http.StatusNoContent, resp.StatusCode)
 
-	body, err := io.ReadAll(resp.Body)
+	subscriptions := testutils.ListSubscriptionsByMatch(s.T(), s.db, match.ID)
+	s.Equal(subscription.ID, subscriptions[0].ID)
+	s.Equal(match.ID, subscriptions[0].MatchID)
+	s.Equal(subscription.Url, subscriptions[0].Url)
+	s.Equal(subscription.Key, subscriptions[0].Key)
+	s.Equal(subscription.CreatedAt, subscriptions[0].CreatedAt)
+	s.Equal(string(models.PendingSub), subscriptions[0].Status)
+	s.Equal(uint(1), subscriptions[0].DeliveryAttempts)
+	s.Equal(fmt.Sprintf("failed to notify subscribers, status code %d", http.StatusInternalServerError), *subscriptions[0].SubscriberError)
+	s.Nil(subscriptions[0].NotifiedAt)
+
+	attempts := testutils.ListNotificationAttempts(s.T(), s.db, subscription.ID)
+	s.Require().Len(attempts, 1)
+	s.Equal(string(models.EventResultFinished), attempts[0].EventType)
+	s.Equal(http.StatusInternalServerError, *attempts[0].ResponseStatus)
+	s.NotNil(attempts[0].RequestPayload)
+	s.Equal(fmt.Sprintf("failed to notify subscribers, status code %d", http.StatusInternalServerError), *attempts[0].ErrorMessage)
+}
+
+func (s *FunctionalTestSuite) TestTriggerSubscriberNotification_SubscriberReturnsClientError() {
+	teamSeeds := testutils.SetupTeamsWithRelations(s.T(), s.db)
+
+	matchToCreate := repository.Match{
+		StartsAt:     testutils.RandomFutureDate(s.T()),
+		HomeTeamID:   uint(teamSeeds[0].TeamID),
+		AwayTeamID:   uint(teamSeeds[1].TeamID),
+		ResultStatus: string(models.Received),
+	}
+	match := testutils.CreateMatch(s.T(), s.db, matchToCreate)
+
+	_ = testutils.CreateExternalMatch(s.T(), s.db, testutils.FakeExternalMatchRepository(func(m *repository.ExternalMatch) {
+		m.MatchID = match.ID
+		m.Status = string(models.StatusMatchFinished)
+	}))
+
+	path := fmt.Sprintf("/matches/%d", match.ID)
+	subscription := testutils.CreateSubscription(s.T(), s.db, testutils.FakeRepositorySubscription(func(sub *repository.Subscription) {
+		sub.MatchID = match.ID
+		sub.Url = fmt.Sprintf("%s%s", s.smockerBaseURL, path)
+		sub.Key = gofakeit.UUID()
+		sub.Status = string(models.PendingSub)
+	}))
+
+	testutils.MockHTTPRequest(s.T(), s.smockerAdminURL, path,
+		testutils.WithMethod(http.MethodPatch),
+		testutils.WithStatusCode(http.StatusBadRequest),
+	)
+
+	requestPayload := handler.TriggerSubscriptionNotificationRequest{SubscriptionID: subscription.ID}
+
+	requestBody, err := json.Marshal(&requestPayload)
 	s.Require().NoError(err)
 
-	var response handler.ErrorResponse
-	err = json.Unmarshal(body, &response)
+	url := s.apiBaseURL + "/v1/triggers/subscriber_notification"
+	req, err := http.NewRequest(http.MethodPost, url, bytes.NewBuffer(requestBody))
 	s.Require().NoError(err)
-	s.Equal(fmt.Sprintf("failed to notify subscriber: failed to notify subscribers, status code %d", http.StatusInternalServerError), response.Error)
-	s.Equal(string(models.CodeInternalServerError), response.Code)
+	req.Header.Add("Authorization", "Bearer anything")
+
+	resp, err := s.httpClient.Do(req)
+	s.Require().NoError(err)
+
+	defer func(Body io.ReadCloser) {
+		_ = Body.Close()
+	}(resp.Body)
+
+	s.Require().Equal(http.StatusNoContent, resp.StatusCode)
 
 	subscriptions := testutils.ListSubscriptionsByMatch(s.T(), s.db, match.ID)
 	s.Equal(subscription.ID, subscriptions[0].ID)
@@ -237,8 +295,10 @@ func (s *FunctionalTestSuite) TestTriggerSubscriberNotification_SubscriberReturn
 	s.Equal(subscription.Key, subscriptions[0].Key)
 	s.Equal(subscription.CreatedAt, subscriptions[0].CreatedAt)
 	s.Equal(string(models.SubscriberErrorSub), subscriptions[0].Status)
-	s.Equal(fmt.Sprintf("failed to notify subscribers, status code %d", http.StatusInternalServerError), *subscriptions[0].SubscriberError)
+	s.Equal(uint(1), subscriptions[0].DeliveryAttempts)
+	s.Equal(fmt.Sprintf("failed to notify subscribers, status code %d", http.StatusBadRequest), *subscriptions[0].SubscriberError)
 	s.Nil(subscriptions[0].NotifiedAt)
+
 }
 
 func (s *FunctionalTestSuite) TestTriggerSubscriberNotification_SubscriberReturnsSuccessfulStatusCode() {
@@ -87,8 +87,8 @@ func TestFotmobClient_GetMatches(t *testing.T) {
 				return httpManager
 			},
 			result: []models.ExternalAPIMatch{
-				expectedExternalAPIMatch(t, response.Leagues[0].Matches[0]),
-				expectedExternalAPIMatch(t, response.Leagues[0].Matches[1]),
+				expectedExternalAPIMatch(t, response.Leagues[0], response.Leagues[0].Matches[0]),
+				expectedExternalAPIMatch(t, response.Leagues[0], response.Leagues[0].Matches[1]),
 			},
 		},
 		{
@@ -285,19 +285,27 @@ func expectedExternalAPITeams(response fotmob.MatchesResponse) []models.External
 	return teams
 }
 
-func expectedExternalAPIMatch(t *testing.T, match fotmob.Match) models.ExternalAPIMatch {
+func expectedExternalAPIMatch(t *testing.T, league fotmob.League, match fotmob.Match) models.ExternalAPIMatch {
 	t.Helper()
 
 	expectedTime, err := time.Parse(time.RFC3339, match.Status.UTCTime)
 	require.NoError(t, err, "failed to parse match starting time")
 
+	snapshot, err := json.Marshal(match)
+	require.NoError(t, err, "failed to marshal match snapshot")
+
 	return models.ExternalAPIMatch{
-		ID:        match.ID,
-		HomeID:    match.Home.ID,
-		AwayID:    match.Away.ID,
-		HomeScore: match.Home.Score,
-		AwayScore: match.Away.Score,
-		Time:      expectedTime,
-		Status:    fotmob.ToDomainExternalAPIMatchStatus(match.ID, match.StatusID),
+		ID:          match.ID,
+		HomeID:      match.Home.ID,
+		AwayID:      match.Away.ID,
+		HomeScore:   match.Home.Score,
+		AwayScore:   match.Away.Score,
+		Time:        expectedTime,
+		Status:      fotmob.ToDomainExternalAPIMatchStatus(match.ID, match.StatusID),
+		HalfTime:    match.StatusID == 10, // half-time status of fotmob
+		FinishType:  fotmob.ToDomainFinishType(match.StatusID),
+		LeagueNames: []string{league.Name, league.ParentLeagueName},
+		CountryCode: league.Ccode,
+		Snapshot:    snapshot,
 	}
 }
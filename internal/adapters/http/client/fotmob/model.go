@@ -1,6 +1,7 @@
 package fotmob
 
 import (
+	"encoding/json"
 	"fmt"
 	"slices"
 	"time"
@@ -25,6 +26,22 @@ type Match struct {
 	Away     Team              `json:"away"`
 	StatusID fotmobMatchStatus `json:"statusId"`
 	Status   Status            `json:"status"`
+
+	Raw json.RawMessage `json:"-"` // match entry as it was received, kept for result check attempts history
+}
+
+func (m *Match) UnmarshalJSON(data []byte) error {
+	type match Match
+
+	var decoded match
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		return err
+	}
+
+	*m = Match(decoded)
+	m.Raw = append(json.RawMessage(nil), data...)
+
+	return nil
 }
 
 type Status struct {
@@ -104,13 +121,18 @@ func toDomainExternalAPIMatches(response MatchesResponse) ([]models.ExternalAPIM
 			}
 
 			leagueMatches = append(leagueMatches, models.ExternalAPIMatch{
-				ID:        match.ID,
-				HomeID:    match.Home.ID,
-				AwayID:    match.Away.ID,
-				HomeScore: match.Home.Score,
-				AwayScore: match.Away.Score,
-				Time:      startsAt,
-				Status:    ToDomainExternalAPIMatchStatus(match.ID, match.StatusID),
+				ID:          match.ID,
+				HomeID:      match.Home.ID,
+				AwayID:      match.Away.ID,
+				HomeScore:   match.Home.Score,
+				AwayScore:   match.Away.Score,
+				Time:        startsAt,
+				Status:      ToDomainExternalAPIMatchStatus(match.ID, match.StatusID),
+				HalfTime:    match.StatusID == halfTime,
+				FinishType:  ToDomainFinishType(match.StatusID),
+				LeagueNames: []string{league.Name, league.ParentLeagueName},
+				CountryCode: league.Ccode,
+				Snapshot:    match.Raw,
 			})
 
 			if isUnknownStatus(match.StatusID) && match.Status.Reason != nil {
@@ -152,6 +174,23 @@ func ToDomainExternalAPIMatchStatus(matchID uint, statusID fotmobMatchStatus) mo
 	}
 }
 
+func ToDomainFinishType(statusID fotmobMatchStatus) *models.FinishType {
+	var finishType models.FinishType
+
+	switch statusID {
+	case fullTime:
+		finishType = models.FinishRegularTime
+	case afterExtraTime:
+		finishType = models.FinishExtraTime
+	case afterPenalties:
+		finishType = models.FinishPenalties
+	default:
+		return nil
+	}
+
+	return &finishType
+}
+
 func isUnknownStatus(statusID fotmobMatchStatus) bool {
 	return !slices.Contains([]fotmobMatchStatus{
 		notStarted,
@@ -0,0 +1,71 @@
+package notifier
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/andrewshostak/result-service/internal/app/models"
+)
+
+var (
+	slackEscaper   = strings.NewReplacer("&", "&amp;", "<", "&lt;", ">", "&gt;")
+	discordEscaper = strings.NewReplacer(`\`, `\\`, "*", `\*`, "_", `\_`, "~", `\~`, "`", "\\`", "|", `\|`, ">", `\>`)
+)
+
+// SlackChannel posts a message to a slack incoming webhook.
+type SlackChannel struct {
+	httpClient HTTPManager
+	logger     Logger
+}
+
+func NewSlackChannel(httpClient HTTPManager, logger Logger) *SlackChannel {
+	return &SlackChannel{httpClient: httpClient, logger: logger}
+}
+
+// Notify posts the message formatted with slack mrkdwn.
+func (c *SlackChannel) Notify(ctx context.Context, notification models.SubscriberNotification) (*models.NotificationResponse, error) {
+	m := toMessage(notification)
+
+	return postJSON(ctx, c.httpClient, c.logger, notification.Url, SlackMessage{
+		Text: fmt.Sprintf("*%s*\n%s", slackEscaper.Replace(m.Title), slackEscaper.Replace(m.Summary)),
+	})
+}
+
+// DiscordChannel posts a message to a discord incoming webhook.
+type DiscordChannel struct {
+	httpClient HTTPManager
+	logger     Logger
+}
+
+func NewDiscordChannel(httpClient HTTPManager, logger Logger) *DiscordChannel {
+	return &DiscordChannel{httpClient: httpClient, logger: logger}
+}
+
+// Notify posts the message formatted with discord markdown.
+func (c *DiscordChannel) Notify(ctx context.Context, notification models.SubscriberNotification) (*models.NotificationResponse, error) {
+	m := toMessage(notification)
+
+	return postJSON(ctx, c.httpClient, c.logger, notification.Url, DiscordMessage{
+		Content: fmt.Sprintf("**%s**\n%s", discordEscaper.Replace(m.Title), discordEscaper.Replace(m.Summary)),
+	})
+}
+
+func postJSON(ctx context.Context, httpClient HTTPManager, logger Logger, url string, body any) (*models.NotificationResponse, error) {
+	payload, err := json.Marshal(body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal notify subscriber request body: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(payload))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request to notify subscriber: %w", err)
+	}
+
+	req.Header.Set("Content-Type", contentTypeJSON)
+
+	return send(httpClient, logger, req, payload, responseBodyLimit)
+}
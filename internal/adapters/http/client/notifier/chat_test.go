@@ -0,0 +1,154 @@
+package notifier_test
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/andrewshostak/result-service/config"
+	"github.com/andrewshostak/result-service/internal/adapters/http/client/notifier"
+	"github.com/andrewshostak/result-service/internal/adapters/http/client/notifier/mocks"
+	"github.com/andrewshostak/result-service/internal/app/models"
+	loggerinternal "github.com/andrewshostak/result-service/internal/infra/logger"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func chatNotification(channel models.NotificationChannel, url string) models.SubscriberNotification {
+	return models.SubscriberNotification{
+		EventType: models.EventMatchGoal,
+		Channel:   channel,
+		Url:       url,
+		HomeTeam:  models.Team{ID: 1, Aliases: []string{"Brighton & Hove Albion"}},
+		AwayTeam:  models.Team{ID: 2},
+		Home:      1,
+		Away:      0,
+	}
+}
+
+func isExpectedChatRequest(t *testing.T, actual *http.Request, url string, expectedBody string) bool {
+	t.Helper()
+
+	// body is read from a copy, because the matcher is run again when expectations are asserted
+	reader, err := actual.GetBody()
+	require.NoError(t, err)
+
+	body, err := io.ReadAll(reader)
+	require.NoError(t, err)
+
+	return actual.Method == http.MethodPost &&
+		actual.URL.String() == url &&
+		actual.Header.Get("Content-Type") == "application/json" &&
+		assert.JSONEq(t, expectedBody, string(body))
+}
+
+func TestSlackChannel_Notify(t *testing.T) {
+	url := "https://hooks.slack.com/services/T000/B000/XXXX"
+
+	httpManager := mocks.NewHTTPManager(t)
+	httpManager.
+		On("Do", mock.MatchedBy(func(actual *http.Request) bool {
+			return isExpectedChatRequest(t, actual, url, `{"text":"*Goal*\nBrighton &amp; Hove Albion 1 - 0 Team 2"}`)
+		})).
+		Return(&http.Response{StatusCode: http.StatusOK, Body: http.NoBody}, nil).
+		Once()
+
+	client := notifier.NewSlackChannel(httpManager, loggerinternal.SetupLogger())
+
+	_, err := client.Notify(context.Background(), chatNotification(models.ChannelSlack, url))
+	assert.NoError(t, err)
+}
+
+func TestSlackChannel_Notify_Test(t *testing.T) {
+	url := "https://hooks.slack.com/services/T000/B000/XXXX"
+
+	httpManager := mocks.NewHTTPManager(t)
+	httpManager.
+		On("Do", mock.MatchedBy(func(actual *http.Request) bool {
+			return isExpectedChatRequest(t, actual, url, `{"text":"*[Test] Goal*\nBrighton &amp; Hove Albion 1 - 0 Team 2"}`)
+		})).
+		Return(&http.Response{StatusCode: http.StatusOK, Body: http.NoBody}, nil).
+		Once()
+
+	client := notifier.NewSlackChannel(httpManager, loggerinternal.SetupLogger())
+
+	notification := chatNotification(models.ChannelSlack, url)
+	notification.Test = true
+
+	_, err := client.Notify(context.Background(), notification)
+	assert.NoError(t, err)
+}
+
+func TestDiscordChannel_Notify(t *testing.T) {
+	url := "https://discord.com/api/webhooks/1/token"
+
+	httpManager := mocks.NewHTTPManager(t)
+	httpManager.
+		On("Do", mock.MatchedBy(func(actual *http.Request) bool {
+			return isExpectedChatRequest(t, actual, url, `{"content":"**Goal**\nBrighton & Hove Albion 1 - 0 Team 2"}`)
+		})).
+		Return(&http.Response{StatusCode: http.StatusNoContent, Body: http.NoBody}, nil).
+		Once()
+
+	client := notifier.NewDiscordChannel(httpManager, loggerinternal.SetupLogger())
+
+	_, err := client.Notify(context.Background(), chatNotification(models.ChannelDiscord, url))
+	assert.NoError(t, err)
+}
+
+func TestTelegramChannel_Notify(t *testing.T) {
+	cfg := config.Telegram{BotToken: "123:secret", APIBaseURL: "https://api.telegram.org"}
+	url := "https://api.telegram.org/bot123:secret/sendMessage"
+	body := `{"chat_id":"-100200","text":"<b>Goal</b>\nBrighton &amp; Hove Albion 1 - 0 Team 2","parse_mode":"HTML"}`
+
+	tests := []struct {
+		name        string
+		httpManager func(t *testing.T) notifier.HTTPManager
+		expectedErr error
+	}{
+		{
+			name: "success - it sends the message to the chat",
+			httpManager: func(t *testing.T) notifier.HTTPManager {
+				t.Helper()
+				httpManager := mocks.NewHTTPManager(t)
+				httpManager.
+					On("Do", mock.MatchedBy(func(actual *http.Request) bool {
+						return isExpectedChatRequest(t, actual, url, body)
+					})).
+					Return(&http.Response{StatusCode: http.StatusOK, Body: http.NoBody}, nil).
+					Once()
+				return httpManager
+			},
+		},
+		{
+			name: "it removes the bot token from the error",
+			httpManager: func(t *testing.T) notifier.HTTPManager {
+				t.Helper()
+				httpManager := mocks.NewHTTPManager(t)
+				httpManager.
+					On("Do", mock.Anything).
+					Return(nil, errors.New(`Post "`+url+`": dial tcp: i/o timeout`)).
+					Once()
+				return httpManager
+			},
+			expectedErr: errors.New(`failed to send request to notify subscribers: Post "https://api.telegram.org/bot<bot_token>/sendMessage": dial tcp: i/o timeout`),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := notifier.NewTelegramChannel(tt.httpManager(t), loggerinternal.SetupLogger(), cfg)
+
+			_, err := client.Notify(context.Background(), chatNotification(models.ChannelTelegram, "telegram:-100200"))
+
+			if tt.expectedErr != nil {
+				assert.EqualError(t, err, tt.expectedErr.Error())
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
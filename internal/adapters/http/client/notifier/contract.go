@@ -1,8 +1,10 @@
 package notifier
 
 import (
+	"context"
 	"net/http"
 
+	"github.com/andrewshostak/result-service/internal/app/models"
 	"github.com/rs/zerolog"
 )
 
@@ -13,3 +15,18 @@ type Logger interface {
 type HTTPManager interface {
 	Do(req *http.Request) (*http.Response, error)
 }
+
+// Channel delivers a notification to a destination of a single notification channel.
+type Channel interface {
+	Notify(ctx context.Context, notification models.SubscriberNotification) (*models.NotificationResponse, error)
+}
+
+// Verifier proves that the subscriber controls the destination. Only channels which can echo a challenge implement it.
+type Verifier interface {
+	Verify(ctx context.Context, verification models.EndpointVerification) error
+}
+
+// BatchNotifier delivers notifications of several subscriptions in a single request. Only webhooks implement it.
+type BatchNotifier interface {
+	NotifyBatch(ctx context.Context, batch models.BatchNotification) (*models.NotificationResponse, error)
+}
@@ -0,0 +1,133 @@
+package notifier
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"fmt"
+	"mime"
+	"net"
+	"net/smtp"
+	"strings"
+	"time"
+
+	"github.com/andrewshostak/result-service/config"
+	"github.com/andrewshostak/result-service/internal/app/models"
+	"github.com/andrewshostak/result-service/pkg/webhook"
+)
+
+const mailtoScheme = "mailto:"
+
+// EmailChannel sends a plain text email through the configured SMTP server.
+type EmailChannel struct {
+	config config.SMTP
+}
+
+func NewEmailChannel(config config.SMTP) *EmailChannel {
+	return &EmailChannel{config: config}
+}
+
+// Notify sends the email to the address given as mailto:<address>. SMTP exchange has no status code, so only latency is described.
+func (c *EmailChannel) Notify(ctx context.Context, notification models.SubscriberNotification) (*models.NotificationResponse, error) {
+	to := strings.TrimPrefix(notification.Url, mailtoScheme)
+	payload := c.email(to, notification)
+
+	response := models.NotificationResponse{RequestPayload: payload}
+
+	startedAt := time.Now()
+	err := c.send(ctx, to, payload)
+	response.Latency = time.Since(startedAt)
+	if err != nil {
+		return &response, fmt.Errorf("failed to send email to notify subscriber: %w", err)
+	}
+
+	return &response, nil
+}
+
+// send delivers the message the way smtp.SendMail does, but the exchange is bound to the context and the configured timeout,
+// so a hung server doesn't block the delivery.
+func (c *EmailChannel) send(ctx context.Context, to string, payload []byte) error {
+	if c.config.Timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, c.config.Timeout)
+		defer cancel()
+	}
+
+	var dialer net.Dialer
+	conn, err := dialer.DialContext(ctx, "tcp", net.JoinHostPort(c.config.Host, c.config.Port))
+	if err != nil {
+		return fmt.Errorf("failed to connect to smtp server: %w", err)
+	}
+
+	if deadline, ok := ctx.Deadline(); ok {
+		if err := conn.SetDeadline(deadline); err != nil {
+			_ = conn.Close()
+			return fmt.Errorf("failed to set smtp connection deadline: %w", err)
+		}
+	}
+
+	// cancellation without a deadline interrupts the exchange by closing the connection
+	stop := context.AfterFunc(ctx, func() { _ = conn.Close() })
+	defer stop()
+
+	client, err := smtp.NewClient(conn, c.config.Host)
+	if err != nil {
+		_ = conn.Close()
+		return fmt.Errorf("failed to start smtp session: %w", err)
+	}
+	defer client.Close()
+
+	if ok, _ := client.Extension("STARTTLS"); ok {
+		if err := client.StartTLS(&tls.Config{ServerName: c.config.Host}); err != nil {
+			return fmt.Errorf("failed to start tls: %w", err)
+		}
+	}
+
+	if c.config.Username != "" {
+		if err := client.Auth(smtp.PlainAuth("", c.config.Username, c.config.Password, c.config.Host)); err != nil {
+			return fmt.Errorf("failed to authenticate: %w", err)
+		}
+	}
+
+	if err := client.Mail(c.config.From); err != nil {
+		return fmt.Errorf("failed to set sender: %w", err)
+	}
+
+	if err := client.Rcpt(to); err != nil {
+		return fmt.Errorf("failed to set recipient: %w", err)
+	}
+
+	writer, err := client.Data()
+	if err != nil {
+		return fmt.Errorf("failed to start message data: %w", err)
+	}
+
+	if _, err := writer.Write(payload); err != nil {
+		return fmt.Errorf("failed to write message data: %w", err)
+	}
+
+	if err := writer.Close(); err != nil {
+		return fmt.Errorf("failed to finish message data: %w", err)
+	}
+
+	return client.Quit()
+}
+
+func (c *EmailChannel) email(to string, notification models.SubscriberNotification) []byte {
+	m := toMessage(notification)
+
+	var b bytes.Buffer
+	fmt.Fprintf(&b, "From: %s\r\n", c.config.From)
+	fmt.Fprintf(&b, "To: %s\r\n", to)
+	fmt.Fprintf(&b, "Subject: %s\r\n", mime.QEncoding.Encode("utf-8", fmt.Sprintf("%s: %s", m.Title, m.Summary)))
+	fmt.Fprintf(&b, "Date: %s\r\n", time.Now().Format(time.RFC1123Z))
+	fmt.Fprintf(&b, "%s: %s\r\n", webhook.HeaderDeliveryID, notification.DeliveryID)
+	b.WriteString("MIME-Version: 1.0\r\n")
+	b.WriteString("Content-Type: text/plain; charset=utf-8\r\n")
+	b.WriteString("\r\n")
+	fmt.Fprintf(&b, "%s\r\n\r\n", m.Summary)
+	fmt.Fprintf(&b, "Kick-off: %s\r\n", notification.StartsAt.UTC().Format(kickoffLayout))
+	fmt.Fprintf(&b, "Match id: %d\r\n", notification.MatchID)
+
+	return b.Bytes()
+}
@@ -0,0 +1,163 @@
+package notifier_test
+
+import (
+	"bufio"
+	"context"
+	"net"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/andrewshostak/result-service/config"
+	"github.com/andrewshostak/result-service/internal/adapters/http/client/notifier"
+	"github.com/andrewshostak/result-service/internal/app/models"
+	"github.com/brianvoe/gofakeit/v6"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// startSMTPStandIn starts a local SMTP server which accepts messages without authentication and passes their data to the channel.
+func startSMTPStandIn(t *testing.T) (string, <-chan string) {
+	t.Helper()
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = listener.Close() })
+
+	messages := make(chan string, 1)
+	go func() {
+		for {
+			conn, err := listener.Accept()
+			if err != nil {
+				return
+			}
+
+			serveSMTP(conn, messages)
+		}
+	}()
+
+	return listener.Addr().String(), messages
+}
+
+func serveSMTP(conn net.Conn, messages chan<- string) {
+	defer conn.Close()
+
+	reader := bufio.NewReader(conn)
+	reply := func(line string) { _, _ = conn.Write([]byte(line + "\r\n")) }
+
+	reply("220 localhost ESMTP")
+	for {
+		line, err := reader.ReadString('\n')
+		if err != nil {
+			return
+		}
+
+		switch command := strings.ToUpper(strings.TrimSpace(line)); {
+		case strings.HasPrefix(command, "EHLO"), strings.HasPrefix(command, "HELO"):
+			reply("250 localhost")
+		case strings.HasPrefix(command, "DATA"):
+			reply("354 end data with <CR><LF>.<CR><LF>")
+
+			var data strings.Builder
+			for {
+				line, err := reader.ReadString('\n')
+				if err != nil {
+					return
+				}
+				if line == ".\r\n" {
+					break
+				}
+				data.WriteString(line)
+			}
+
+			messages <- data.String()
+			reply("250 queued")
+		case strings.HasPrefix(command, "QUIT"):
+			reply("221 bye")
+			return
+		default:
+			reply("250 ok")
+		}
+	}
+}
+
+func TestEmailChannel_Notify(t *testing.T) {
+	addr, messages := startSMTPStandIn(t)
+	host, port, err := net.SplitHostPort(addr)
+	require.NoError(t, err)
+
+	finishType := models.FinishExtraTime
+	notification := models.SubscriberNotification{
+		DeliveryID: gofakeit.UUID(),
+		EventType:  models.EventResultFinished,
+		Channel:    models.ChannelEmail,
+		Url:        "mailto:fan@example.com",
+		MatchID:    7,
+		StartsAt:   time.Date(2026, 10, 18, 19, 0, 0, 0, time.UTC),
+		HomeTeam:   models.Team{ID: 1, Aliases: []string{"Arsenal"}},
+		AwayTeam:   models.Team{ID: 2, Aliases: []string{"Chelsea"}},
+		FinishType: &finishType,
+		Home:       2,
+		Away:       1,
+	}
+
+	client := notifier.NewEmailChannel(config.SMTP{Host: host, Port: port, From: "results@example.com"})
+
+	response, err := client.Notify(context.Background(), notification)
+	require.NoError(t, err)
+	require.NotNil(t, response)
+	assert.Nil(t, response.StatusCode)
+
+	message := <-messages
+	assert.Equal(t, string(response.RequestPayload), message)
+	assert.Contains(t, message, "From: results@example.com\r\n")
+	assert.Contains(t, message, "To: fan@example.com\r\n")
+	assert.Contains(t, message, "Subject: Full time (after extra time): Arsenal 2 - 1 Chelsea\r\n")
+	assert.Contains(t, message, "X-Result-Delivery-Id: "+notification.DeliveryID+"\r\n")
+	assert.Contains(t, message, "\r\n\r\nArsenal 2 - 1 Chelsea\r\n\r\nKick-off: 18 Oct 2026 19:00 UTC\r\nMatch id: 7\r\n")
+}
+
+func TestEmailChannel_Notify_ServerUnavailable(t *testing.T) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	host, port, err := net.SplitHostPort(listener.Addr().String())
+	require.NoError(t, err)
+	require.NoError(t, listener.Close())
+
+	client := notifier.NewEmailChannel(config.SMTP{Host: host, Port: port, From: "results@example.com"})
+
+	response, err := client.Notify(context.Background(), models.SubscriberNotification{Url: "mailto:fan@example.com"})
+	assert.ErrorContains(t, err, "failed to send email to notify subscriber")
+	require.NotNil(t, response)
+	assert.NotEmpty(t, response.RequestPayload)
+}
+
+func TestEmailChannel_Notify_ServerHangs(t *testing.T) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = listener.Close() })
+
+	// the server accepts the connection but never greets the client
+	done := make(chan struct{})
+	t.Cleanup(func() { close(done) })
+	go func() {
+		conn, err := listener.Accept()
+		if err != nil {
+			return
+		}
+
+		<-done
+		_ = conn.Close()
+	}()
+
+	host, port, err := net.SplitHostPort(listener.Addr().String())
+	require.NoError(t, err)
+
+	client := notifier.NewEmailChannel(config.SMTP{Host: host, Port: port, From: "results@example.com", Timeout: 100 * time.Millisecond})
+
+	startedAt := time.Now()
+	response, err := client.Notify(context.Background(), models.SubscriberNotification{Url: "mailto:fan@example.com"})
+	assert.ErrorContains(t, err, "failed to send email to notify subscriber")
+	assert.Less(t, time.Since(startedAt), 5*time.Second)
+	require.NotNil(t, response)
+}
@@ -0,0 +1,118 @@
+package notifier
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/http"
+	"net/netip"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/andrewshostak/result-service/config"
+	"github.com/andrewshostak/result-service/internal/app/models"
+)
+
+// AddressGuard keeps subscriber urls from reaching internal services. The address is checked after the dns resolution,
+// right before the connection, so a host resolving to a public address on creation and to a private one later is blocked as well.
+type AddressGuard struct {
+	allowedHosts    map[string]struct{}
+	allowedNetworks []netip.Prefix
+	dialer          *net.Dialer
+	guardedDialer   *net.Dialer
+}
+
+// NewAddressGuard creates a guard which blocks private, loopback and link-local addresses.
+// Allowed hosts are either host names or networks in CIDR notation, they are reachable even though their addresses are blocked.
+func NewAddressGuard(cfg config.Notification) (*AddressGuard, error) {
+	guard := AddressGuard{
+		allowedHosts:  map[string]struct{}{},
+		dialer:        &net.Dialer{Timeout: 30 * time.Second, KeepAlive: 30 * time.Second},
+		guardedDialer: &net.Dialer{Timeout: 30 * time.Second, KeepAlive: 30 * time.Second},
+	}
+
+	for _, host := range cfg.AllowedHosts {
+		host = strings.ToLower(strings.TrimSpace(host))
+		if host == "" {
+			continue
+		}
+
+		if !strings.Contains(host, "/") {
+			guard.allowedHosts[host] = struct{}{}
+			continue
+		}
+
+		network, err := netip.ParsePrefix(host)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse allowed network %s: %w", host, err)
+		}
+
+		guard.allowedNetworks = append(guard.allowedNetworks, network.Masked())
+	}
+
+	guard.guardedDialer.Control = guard.control
+
+	return &guard, nil
+}
+
+// Transport returns an http transport which connects to the allowed addresses only.
+func (g *AddressGuard) Transport() *http.Transport {
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.Proxy = nil // a proxy would make the connection on behalf of the service, bypassing the guard
+	transport.DialContext = g.DialContext
+
+	return transport
+}
+
+// DialContext connects to the address unless it resolves to a blocked one. Allowed host names are connected without the check.
+func (g *AddressGuard) DialContext(ctx context.Context, network, address string) (net.Conn, error) {
+	host, _, err := net.SplitHostPort(address)
+	if err != nil {
+		return nil, fmt.Errorf("failed to split address %s: %w", address, err)
+	}
+
+	if _, ok := g.allowedHosts[strings.ToLower(host)]; ok {
+		return g.dialer.DialContext(ctx, network, address)
+	}
+
+	return g.guardedDialer.DialContext(ctx, network, address)
+}
+
+// control is called for every resolved address of the host before connecting to it.
+func (g *AddressGuard) control(_, address string, _ syscall.RawConn) error {
+	addrPort, err := netip.ParseAddrPort(address)
+	if err != nil {
+		return fmt.Errorf("failed to parse address %s: %w", address, err)
+	}
+
+	addr := addrPort.Addr().Unmap()
+	if !g.IsBlocked(addr) {
+		return nil
+	}
+
+	return fmt.Errorf("%w: %s", models.ErrDestinationNotAllowed, addr)
+}
+
+// IsBlocked reports whether the address is private, loopback, link-local or unspecified and isn't in the allowed networks.
+func (g *AddressGuard) IsBlocked(addr netip.Addr) bool {
+	addr = addr.Unmap()
+
+	blocked := addr.IsPrivate() ||
+		addr.IsLoopback() ||
+		addr.IsLinkLocalUnicast() ||
+		addr.IsLinkLocalMulticast() ||
+		addr.IsInterfaceLocalMulticast() ||
+		addr.IsUnspecified()
+	if !blocked {
+		return false
+	}
+
+	for _, network := range g.allowedNetworks {
+		if network.Contains(addr) {
+			return false
+		}
+	}
+
+	return true
+}
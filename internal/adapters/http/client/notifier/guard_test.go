@@ -0,0 +1,120 @@
+package notifier_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"net/netip"
+	"testing"
+
+	"github.com/andrewshostak/result-service/config"
+	"github.com/andrewshostak/result-service/internal/adapters/http/client/notifier"
+	"github.com/andrewshostak/result-service/internal/app/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewAddressGuard(t *testing.T) {
+	tests := []struct {
+		name        string
+		cfg         config.Notification
+		expectedErr string
+	}{
+		{
+			name:        "it returns an error when allowed network is not valid",
+			cfg:         config.Notification{AllowedHosts: []string{"10.0.0.0/33"}},
+			expectedErr: `failed to parse allowed network 10.0.0.0/33: netip.ParsePrefix("10.0.0.0/33"): prefix length out of range`,
+		},
+		{
+			name: "success - it accepts host names and networks",
+			cfg:  config.Notification{AllowedHosts: []string{"prognoz-api.internal", " 10.0.0.0/8", ""}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			guard, err := notifier.NewAddressGuard(tt.cfg)
+			if tt.expectedErr != "" {
+				assert.EqualError(t, err, tt.expectedErr)
+				assert.Nil(t, guard)
+			} else {
+				assert.NoError(t, err)
+				assert.NotNil(t, guard)
+			}
+		})
+	}
+}
+
+func TestAddressGuard_IsBlocked(t *testing.T) {
+	guard, err := notifier.NewAddressGuard(config.Notification{AllowedHosts: []string{"10.1.0.0/16"}})
+	require.NoError(t, err)
+
+	tests := []struct {
+		address string
+		blocked bool
+	}{
+		{address: "93.184.215.14", blocked: false},
+		{address: "2606:2800:21f:cb07:6820:80da:af6b:8b2c", blocked: false},
+		{address: "10.0.0.1", blocked: true},
+		{address: "10.1.2.3", blocked: false},
+		{address: "172.16.0.1", blocked: true},
+		{address: "192.168.1.1", blocked: true},
+		{address: "127.0.0.1", blocked: true},
+		{address: "169.254.169.254", blocked: true},
+		{address: "0.0.0.0", blocked: true},
+		{address: "::1", blocked: true},
+		{address: "fe80::1", blocked: true},
+		{address: "fd00::1", blocked: true},
+		{address: "::ffff:127.0.0.1", blocked: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.address, func(t *testing.T) {
+			assert.Equal(t, tt.blocked, guard.IsBlocked(netip.MustParseAddr(tt.address)))
+		})
+	}
+}
+
+func TestAddressGuard_Transport(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	tests := []struct {
+		name         string
+		allowedHosts []string
+		blocked      bool
+	}{
+		{
+			name:    "it doesn't connect to a loopback address",
+			blocked: true,
+		},
+		{
+			name:         "success - it connects to an address of allowed network",
+			allowedHosts: []string{"127.0.0.0/8"},
+		},
+		{
+			name:         "success - it connects to an allowed host",
+			allowedHosts: []string{"127.0.0.1"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			guard, err := notifier.NewAddressGuard(config.Notification{AllowedHosts: tt.allowedHosts})
+			require.NoError(t, err)
+
+			client := http.Client{Transport: guard.Transport()}
+
+			res, err := client.Get(server.URL)
+			if tt.blocked {
+				assert.ErrorIs(t, err, models.ErrDestinationNotAllowed)
+				return
+			}
+
+			require.NoError(t, err)
+			_ = res.Body.Close()
+			assert.Equal(t, http.StatusNoContent, res.StatusCode)
+		})
+	}
+}
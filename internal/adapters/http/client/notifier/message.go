@@ -0,0 +1,79 @@
+package notifier
+
+import (
+	"fmt"
+
+	"github.com/andrewshostak/result-service/internal/app/models"
+)
+
+const kickoffLayout = "2 Jan 2006 15:04 MST"
+
+// message is a human-readable description of a notification, every channel renders it in its own markup.
+type message struct {
+	Title   string // describes the event, e.g. "Full time"
+	Summary string // teams and score, e.g. "Arsenal 2 - 1 Chelsea"
+}
+
+const testTitlePrefix = "[Test] "
+
+func toMessage(notification models.SubscriberNotification) message {
+	m := eventMessage(notification)
+	if notification.Test {
+		m.Title = testTitlePrefix + m.Title
+	}
+
+	return m
+}
+
+func eventMessage(notification models.SubscriberNotification) message {
+	home, away := teamName(notification.HomeTeam), teamName(notification.AwayTeam)
+	fixture := fmt.Sprintf("%s - %s", home, away)
+	score := fmt.Sprintf("%s %d - %d %s", home, notification.Home, notification.Away, away)
+
+	switch notification.EventType {
+	case models.EventMatchKickoff:
+		return message{Title: "Kick-off", Summary: fixture}
+	case models.EventMatchHalfTime:
+		return message{Title: "Half time", Summary: score}
+	case models.EventMatchGoal:
+		return message{Title: "Goal", Summary: score}
+	case models.EventMatchCancelled:
+		return message{Title: "Match cancelled", Summary: fixture}
+	case models.EventMatchReminder:
+		return message{
+			Title:   "Kick-off soon",
+			Summary: fmt.Sprintf("%s, kick-off %s", fixture, notification.StartsAt.UTC().Format(kickoffLayout)),
+		}
+	case models.EventMatchRescheduled:
+		return message{
+			Title:   "Match rescheduled",
+			Summary: fmt.Sprintf("%s, new kick-off %s", fixture, notification.StartsAt.UTC().Format(kickoffLayout)),
+		}
+	default:
+		return message{Title: fullTimeTitle(notification.FinishType), Summary: score}
+	}
+}
+
+func fullTimeTitle(finishType *models.FinishType) string {
+	if finishType == nil {
+		return "Full time"
+	}
+
+	switch *finishType {
+	case models.FinishExtraTime:
+		return "Full time (after extra time)"
+	case models.FinishPenalties:
+		return "Full time (after penalties)"
+	default:
+		return "Full time"
+	}
+}
+
+// teamName returns the first alias of the team, teams without loaded aliases are named by their id.
+func teamName(team models.Team) string {
+	if len(team.Aliases) > 0 {
+		return team.Aliases[0]
+	}
+
+	return fmt.Sprintf("Team %d", team.ID)
+}
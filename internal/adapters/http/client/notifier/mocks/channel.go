@@ -0,0 +1,59 @@
+// Code generated by mockery v2.53.3. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	models "github.com/andrewshostak/result-service/internal/app/models"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// Channel is an autogenerated mock type for the Channel type
+type Channel struct {
+	mock.Mock
+}
+
+// Notify provides a mock function with given fields: ctx, notification
+func (_m *Channel) Notify(ctx context.Context, notification models.SubscriberNotification) (*models.NotificationResponse, error) {
+	ret := _m.Called(ctx, notification)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Notify")
+	}
+
+	var r0 *models.NotificationResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, models.SubscriberNotification) (*models.NotificationResponse, error)); ok {
+		return rf(ctx, notification)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, models.SubscriberNotification) *models.NotificationResponse); ok {
+		r0 = rf(ctx, notification)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.NotificationResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, models.SubscriberNotification) error); ok {
+		r1 = rf(ctx, notification)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NewChannel creates a new instance of Channel. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewChannel(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *Channel {
+	mock := &Channel{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -1,6 +1,176 @@
 package notifier
 
+import (
+	"strconv"
+	"time"
+
+	"github.com/andrewshostak/result-service/internal/app/models"
+)
+
+const (
+	cloudEventsSpecVersion = "1.0"
+	cloudEventsSource      = "result-service"
+
+	contentTypeJSON            = "application/json"
+	contentTypeCloudEventsJSON = "application/cloudevents+json"
+)
+
+// CloudEvent is a CloudEvents 1.0 envelope, the data is a notification body of the subscription payload version.
+type CloudEvent struct {
+	SpecVersion     string    `json:"specversion"`
+	ID              string    `json:"id"`
+	Source          string    `json:"source"`
+	Type            string    `json:"type"`
+	Subject         string    `json:"subject"`
+	Time            time.Time `json:"time"`
+	DataContentType string    `json:"datacontenttype"`
+	Data            any       `json:"data,omitempty"`
+}
+
+const verificationType = "verification"
+
+// VerificationBody is sent to an endpoint to verify it. The endpoint has to respond with the challenge.
+type VerificationBody struct {
+	Type           string `json:"type"`
+	Challenge      string `json:"challenge"`
+	SubscriptionID uint   `json:"subscription_id"`
+}
+
+// VerificationResponse is the echo of the challenge, a plain text challenge is accepted as well.
+type VerificationResponse struct {
+	Challenge string `json:"challenge"`
+}
+
+const batchType = "batch"
+
+// BatchBody carries notifications of several subscriptions sharing the batch url.
+type BatchBody struct {
+	Type       string      `json:"type"`
+	DeliveryID string      `json:"delivery_id"`
+	Items      []BatchItem `json:"items"`
+}
+
+// BatchItem is a notification of a single subscription, the payload is of the subscription payload version.
+type BatchItem struct {
+	DeliveryID     string `json:"delivery_id"`
+	SubscriptionID uint   `json:"subscription_id"`
+	URL            string `json:"url"`
+	Payload        any    `json:"payload"`
+}
+
+// BatchResponse acknowledges the processed batch items by their delivery ids. Other items are delivered individually.
+type BatchResponse struct {
+	Acknowledged []string `json:"acknowledged"`
+}
+
+// NotificationBody is the v1 payload, kept for subscribers relying on the original shape.
 type NotificationBody struct {
 	Home uint `json:"home"`
 	Away uint `json:"away"`
 }
+
+type NotificationBodyV2 struct {
+	Version    string       `json:"version"`
+	EventType  string       `json:"event_type"`
+	DeliveryID string       `json:"delivery_id"`
+	Test       bool         `json:"test,omitempty"`
+	Match      MatchPayload `json:"match"`
+}
+
+type MatchPayload struct {
+	ID         uint         `json:"id"`
+	ExternalID uint         `json:"external_id"`
+	Kickoff    time.Time    `json:"kickoff"`
+	Home       TeamPayload  `json:"home"`
+	Away       TeamPayload  `json:"away"`
+	FinishType *string      `json:"finish_type"`
+	Score      ScorePayload `json:"score"`
+}
+
+type TeamPayload struct {
+	TeamID  uint     `json:"team_id"`
+	Aliases []string `json:"aliases"`
+}
+
+type ScorePayload struct {
+	Home uint `json:"home"`
+	Away uint `json:"away"`
+}
+
+func toNotificationBody(notification models.SubscriberNotification) any {
+	switch notification.PayloadVersion {
+	case models.PayloadV2:
+		return toNotificationBodyV2(notification)
+	default:
+		return NotificationBody{
+			Home: notification.Home,
+			Away: notification.Away,
+		}
+	}
+}
+
+func toCloudEvent(notification models.SubscriberNotification, timestamp time.Time, data any) CloudEvent {
+	return CloudEvent{
+		SpecVersion:     cloudEventsSpecVersion,
+		ID:              notification.DeliveryID,
+		Source:          cloudEventsSource,
+		Type:            string(notification.EventType),
+		Subject:         strconv.FormatUint(uint64(notification.MatchID), 10),
+		Time:            timestamp.UTC(),
+		DataContentType: contentTypeJSON,
+		Data:            data,
+	}
+}
+
+func toNotificationBodyV2(notification models.SubscriberNotification) NotificationBodyV2 {
+	var finishType *string
+	if notification.FinishType != nil {
+		mapped := string(*notification.FinishType)
+		finishType = &mapped
+	}
+
+	return NotificationBodyV2{
+		Version:    string(models.PayloadV2),
+		EventType:  string(notification.EventType),
+		DeliveryID: notification.DeliveryID,
+		Test:       notification.Test,
+		Match: MatchPayload{
+			ID:         notification.MatchID,
+			ExternalID: notification.ExternalMatchID,
+			Kickoff:    notification.StartsAt,
+			Home:       toTeamPayload(notification.HomeTeam),
+			Away:       toTeamPayload(notification.AwayTeam),
+			FinishType: finishType,
+			Score: ScorePayload{
+				Home: notification.Home,
+				Away: notification.Away,
+			},
+		},
+	}
+}
+
+func toTeamPayload(team models.Team) TeamPayload {
+	aliases := team.Aliases
+	if aliases == nil {
+		aliases = []string{}
+	}
+
+	return TeamPayload{
+		TeamID:  team.ID,
+		Aliases: aliases,
+	}
+}
+
+type TelegramMessage struct {
+	ChatID    string `json:"chat_id"`
+	Text      string `json:"text"`
+	ParseMode string `json:"parse_mode"`
+}
+
+type SlackMessage struct {
+	Text string `json:"text"`
+}
+
+type DiscordMessage struct {
+	Content string `json:"content"`
+}
@@ -1,60 +1,125 @@
 package notifier
 
 import (
-	"bytes"
 	"context"
-	"encoding/json"
 	"errors"
 	"fmt"
+	"io"
 	"net/http"
+	"strconv"
+	"time"
 
 	"github.com/andrewshostak/result-service/internal/app/models"
 )
 
-const notificationAuthHeader = "Authorization"
+// responseBodyLimit is a max number of bytes of a subscriber response body kept for debugging.
+const responseBodyLimit = 1024
 
+// batchResponseBodyLimit is a max number of bytes of a batch response read to find the acknowledged items.
+const batchResponseBodyLimit = 64 * 1024
+
+// NotifierClient routes a notification to the channel chosen by the subscription.
 type NotifierClient struct {
-	httpClient HTTPManager
-	logger     Logger
+	channels map[models.NotificationChannel]Channel
 }
 
-func NewNotifierClient(httpClient HTTPManager, logger Logger) *NotifierClient {
-	return &NotifierClient{httpClient: httpClient, logger: logger}
+func NewNotifierClient(channels map[models.NotificationChannel]Channel) *NotifierClient {
+	return &NotifierClient{channels: channels}
 }
 
-func (c *NotifierClient) Notify(ctx context.Context, notification models.SubscriberNotification) error {
-	body := NotificationBody{
-		Home: notification.Home,
-		Away: notification.Away,
+// Notify sends the notification through the channel of the subscription, notifications without a channel are sent as webhooks.
+// The response describes the exchange and is returned also when the subscriber responded with an error.
+func (c *NotifierClient) Notify(ctx context.Context, notification models.SubscriberNotification) (*models.NotificationResponse, error) {
+	channel := notification.Channel
+	if channel == "" {
+		channel = models.ChannelWebhook
 	}
 
-	payload, err := json.Marshal(body)
-	if err != nil {
-		return fmt.Errorf("failed to marshal notify subscriber request body: %w", err)
+	notifier, ok := c.channels[channel]
+	if !ok {
+		return nil, fmt.Errorf("notification channel %s is not configured", channel)
 	}
 
-	req, err := http.NewRequestWithContext(ctx, http.MethodPatch, notification.Url, bytes.NewReader(payload))
-	if err != nil {
-		return fmt.Errorf("failed to create request to notify subscriber: %w", err)
+	return notifier.Notify(ctx, notification)
+}
+
+// Verify sends the verification handshake to the webhook endpoint.
+func (c *NotifierClient) Verify(ctx context.Context, verification models.EndpointVerification) error {
+	verifier, ok := c.channels[models.ChannelWebhook].(Verifier)
+	if !ok {
+		return fmt.Errorf("notification channel %s doesn't support verification", models.ChannelWebhook)
+	}
+
+	return verifier.Verify(ctx, verification)
+}
+
+// NotifyBatch sends notifications of several subscriptions to the batch url in a single webhook request.
+func (c *NotifierClient) NotifyBatch(ctx context.Context, batch models.BatchNotification) (*models.NotificationResponse, error) {
+	batchNotifier, ok := c.channels[models.ChannelWebhook].(BatchNotifier)
+	if !ok {
+		return nil, fmt.Errorf("notification channel %s doesn't support batches", models.ChannelWebhook)
 	}
 
-	req.Header.Set(notificationAuthHeader, notification.Key)
-	req.Header.Set("Content-Type", "application/json")
-	res, err := c.httpClient.Do(req)
+	return batchNotifier.NotifyBatch(ctx, batch)
+}
+
+// send makes the request and describes the exchange, reading at most bodyLimit bytes of the response body.
+// Response codes other than 2xx are reported as errors.
+func send(httpClient HTTPManager, logger Logger, req *http.Request, payload []byte, bodyLimit int64) (*models.NotificationResponse, error) {
+	response := models.NotificationResponse{RequestPayload: payload}
+
+	startedAt := time.Now()
+	res, err := httpClient.Do(req)
+	response.Latency = time.Since(startedAt)
 	if err != nil {
-		return fmt.Errorf("failed to send request to notify subscribers: %w", err)
+		return &response, fmt.Errorf("failed to send request to notify subscribers: %w", err)
 	}
 
 	defer func() {
 		err := res.Body.Close()
 		if err != nil {
-			c.logger.Error().Err(err).Msg("couldn't close response body")
+			logger.Error().Err(err).Msg("couldn't close response body")
 		}
 	}()
 
+	response.StatusCode = &res.StatusCode
+	response.RetryAfter = parseRetryAfter(res.Header.Get("Retry-After"), time.Now())
+
+	resBody, err := io.ReadAll(io.LimitReader(res.Body, bodyLimit))
+	if err != nil {
+		logger.Error().Err(err).Msg("couldn't read response body")
+	}
+
+	if len(resBody) > 0 {
+		excerpt := string(resBody)
+		response.Body = &excerpt
+	}
+
 	if res.StatusCode >= http.StatusOK && res.StatusCode <= http.StatusNoContent {
+		return &response, nil
+	}
+
+	return &response, errors.New(fmt.Sprintf("failed to notify subscribers, status code %d", res.StatusCode))
+}
+
+// parseRetryAfter parses Retry-After header given either in seconds or as an HTTP date.
+func parseRetryAfter(value string, now time.Time) *time.Duration {
+	if value == "" {
+		return nil
+	}
+
+	var retryAfter time.Duration
+	if seconds, err := strconv.Atoi(value); err == nil {
+		retryAfter = time.Duration(seconds) * time.Second
+	} else if date, err := http.ParseTime(value); err == nil {
+		retryAfter = date.Sub(now)
+	} else {
 		return nil
 	}
 
-	return errors.New(fmt.Sprintf("failed to notify subscribers, status code %d", res.StatusCode))
+	if retryAfter < 0 {
+		retryAfter = 0
+	}
+
+	return &retryAfter
 }
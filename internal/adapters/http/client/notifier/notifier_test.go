@@ -1,108 +1,137 @@
 package notifier_test
 
 import (
-	"bytes"
 	"context"
-	"encoding/json"
 	"errors"
-	"fmt"
+	"io"
 	"net/http"
+	"strings"
 	"testing"
 
-	"github.com/andrewshostak/result-service/internal/adapters/http/client/fotmob"
 	"github.com/andrewshostak/result-service/internal/adapters/http/client/notifier"
 	"github.com/andrewshostak/result-service/internal/adapters/http/client/notifier/mocks"
 	"github.com/andrewshostak/result-service/internal/app/models"
 	loggerinternal "github.com/andrewshostak/result-service/internal/infra/logger"
-	"github.com/andrewshostak/result-service/testutils"
 	"github.com/brianvoe/gofakeit/v6"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
-	"github.com/stretchr/testify/require"
 )
 
 func TestNotifierClient_Notify(t *testing.T) {
 	ctx := context.Background()
 
-	subscriberNotification := models.SubscriberNotification{
-		Url:  gofakeit.URL(),
-		Key:  gofakeit.Password(true, true, true, false, false, 10),
-		Home: uint(gofakeit.Uint8()),
-		Away: uint(gofakeit.Uint8()),
+	statusCode := 200
+	response := &models.NotificationResponse{StatusCode: &statusCode}
+
+	tests := []struct {
+		name             string
+		channel          models.NotificationChannel
+		channels         func(t *testing.T, notification models.SubscriberNotification) map[models.NotificationChannel]notifier.Channel
+		expectedResponse *models.NotificationResponse
+		expectedErr      error
+	}{
+		{
+			name:    "it sends the notification through the channel of the subscription",
+			channel: models.ChannelTelegram,
+			channels: func(t *testing.T, notification models.SubscriberNotification) map[models.NotificationChannel]notifier.Channel {
+				t.Helper()
+				telegram := mocks.NewChannel(t)
+				telegram.On("Notify", ctx, notification).Return(response, nil).Once()
+				return map[models.NotificationChannel]notifier.Channel{
+					models.ChannelWebhook:  mocks.NewChannel(t),
+					models.ChannelTelegram: telegram,
+				}
+			},
+			expectedResponse: response,
+		},
+		{
+			name: "it sends the notification without a channel as a webhook",
+			channels: func(t *testing.T, notification models.SubscriberNotification) map[models.NotificationChannel]notifier.Channel {
+				t.Helper()
+				webhook := mocks.NewChannel(t)
+				webhook.On("Notify", ctx, notification).Return(response, errors.New("some error")).Once()
+				return map[models.NotificationChannel]notifier.Channel{models.ChannelWebhook: webhook}
+			},
+			expectedResponse: response,
+			expectedErr:      errors.New("some error"),
+		},
+		{
+			name:    "it returns an error when the channel is not configured",
+			channel: models.ChannelEmail,
+			channels: func(t *testing.T, notification models.SubscriberNotification) map[models.NotificationChannel]notifier.Channel {
+				t.Helper()
+				return map[models.NotificationChannel]notifier.Channel{models.ChannelWebhook: mocks.NewChannel(t)}
+			},
+			expectedErr: errors.New("notification channel email is not configured"),
+		},
 	}
 
-	body := notifier.NotificationBody{
-		Home: subscriberNotification.Home,
-		Away: subscriberNotification.Away,
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			notification := models.SubscriberNotification{
+				DeliveryID: gofakeit.UUID(),
+				Channel:    tt.channel,
+				Url:        gofakeit.URL(),
+			}
+
+			client := notifier.NewNotifierClient(tt.channels(t, notification))
+
+			actual, err := client.Notify(ctx, notification)
+
+			if tt.expectedErr != nil {
+				assert.EqualError(t, err, tt.expectedErr.Error())
+			} else {
+				assert.NoError(t, err)
+			}
+
+			assert.Equal(t, tt.expectedResponse, actual)
+		})
 	}
+}
 
-	requestBody, err := json.Marshal(body)
-	require.NoError(t, err)
+func TestNotifierClient_Verify(t *testing.T) {
+	ctx := context.Background()
 
-	req, err := http.NewRequestWithContext(ctx, http.MethodPatch, subscriberNotification.Url, bytes.NewReader(requestBody))
-	require.NoError(t, err)
-	req.Header.Set("Authorization", subscriberNotification.Key)
-	req.Header.Set("Content-Type", "application/json")
+	verification := models.EndpointVerification{
+		DeliveryID: gofakeit.UUID(),
+		Challenge:  gofakeit.UUID(),
+		Url:        gofakeit.URL(),
+	}
 
 	tests := []struct {
 		name        string
-		httpManager func(t *testing.T) fotmob.HTTPManager
+		channels    func(t *testing.T) map[models.NotificationChannel]notifier.Channel
 		expectedErr error
 	}{
 		{
-			name: "success - it returns no error if response code is 2xx",
-			httpManager: func(t *testing.T) fotmob.HTTPManager {
+			name: "it returns an error when the webhook channel doesn't support verification",
+			channels: func(t *testing.T) map[models.NotificationChannel]notifier.Channel {
 				t.Helper()
-				httpManager := mocks.NewHTTPManager(t)
-				httpManager.
-					On("Do", mock.MatchedBy(func(actual *http.Request) bool {
-						return testutils.CompareRequest(t, req, actual)
-					})).
-					Return(&http.Response{StatusCode: http.StatusOK, Body: http.NoBody}, nil).
-					Once()
-				return httpManager
+				return map[models.NotificationChannel]notifier.Channel{models.ChannelWebhook: mocks.NewChannel(t)}
 			},
+			expectedErr: errors.New("notification channel webhook doesn't support verification"),
 		},
 		{
-			name: "it returns an error when fails to make a request",
-			httpManager: func(t *testing.T) fotmob.HTTPManager {
+			name: "success - it verifies the endpoint through the webhook channel",
+			channels: func(t *testing.T) map[models.NotificationChannel]notifier.Channel {
 				t.Helper()
 				httpManager := mocks.NewHTTPManager(t)
-				httpManager.
-					On("Do", mock.MatchedBy(func(actual *http.Request) bool {
-						return testutils.CompareRequest(t, req, actual)
-					})).
-					Return(nil, errors.New("some error")).
-					Once()
-				return httpManager
+				httpManager.On("Do", mock.Anything).Return(&http.Response{
+					StatusCode: http.StatusOK,
+					Body:       io.NopCloser(strings.NewReader(verification.Challenge)),
+				}, nil).Once()
+				return map[models.NotificationChannel]notifier.Channel{
+					models.ChannelWebhook: notifier.NewWebhookChannel(httpManager, loggerinternal.SetupLogger()),
+				}
 			},
-			expectedErr: fmt.Errorf("failed to send request to notify subscribers: %w", errors.New("some error")),
-		},
-		{
-			name: "it returns an error when response code is not 2xx",
-			httpManager: func(t *testing.T) fotmob.HTTPManager {
-				t.Helper()
-				httpManager := mocks.NewHTTPManager(t)
-				httpManager.
-					On("Do", mock.MatchedBy(func(actual *http.Request) bool {
-						return testutils.CompareRequest(t, req, actual)
-					})).
-					Return(&http.Response{StatusCode: http.StatusServiceUnavailable, Body: http.NoBody}, nil).
-					Once()
-				return httpManager
-			},
-			expectedErr: errors.New(fmt.Sprintf("failed to notify subscribers, status code %d", http.StatusServiceUnavailable)),
 		},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			logger := loggerinternal.SetupLogger()
-
-			client := notifier.NewNotifierClient(tt.httpManager(t), logger)
-
-			err := client.Notify(ctx, subscriberNotification)
+			client := notifier.NewNotifierClient(tt.channels(t))
 
+			err := client.Verify(ctx, verification)
 			if tt.expectedErr != nil {
 				assert.EqualError(t, err, tt.expectedErr.Error())
 			} else {
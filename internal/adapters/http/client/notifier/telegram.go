@@ -0,0 +1,46 @@
+package notifier
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"html"
+	"strings"
+
+	"github.com/andrewshostak/result-service/config"
+	"github.com/andrewshostak/result-service/internal/app/models"
+)
+
+const (
+	telegramScheme          = "telegram:"
+	telegramSendMessagePath = "/bot%s/sendMessage"
+)
+
+// TelegramChannel sends a message to a telegram chat on behalf of the service bot.
+type TelegramChannel struct {
+	httpClient HTTPManager
+	logger     Logger
+	config     config.Telegram
+}
+
+func NewTelegramChannel(httpClient HTTPManager, logger Logger, config config.Telegram) *TelegramChannel {
+	return &TelegramChannel{httpClient: httpClient, logger: logger, config: config}
+}
+
+// Notify sends the message formatted with telegram HTML to the chat given as telegram:<chat_id>.
+func (c *TelegramChannel) Notify(ctx context.Context, notification models.SubscriberNotification) (*models.NotificationResponse, error) {
+	m := toMessage(notification)
+
+	url := c.config.APIBaseURL + fmt.Sprintf(telegramSendMessagePath, c.config.BotToken)
+	response, err := postJSON(ctx, c.httpClient, c.logger, url, TelegramMessage{
+		ChatID:    strings.TrimPrefix(notification.Url, telegramScheme),
+		Text:      fmt.Sprintf("<b>%s</b>\n%s", html.EscapeString(m.Title), html.EscapeString(m.Summary)),
+		ParseMode: "HTML",
+	})
+	if err != nil && c.config.BotToken != "" {
+		// the bot token is a part of the request url, so it is removed from errors stored for subscribers
+		return response, errors.New(strings.ReplaceAll(err.Error(), c.config.BotToken, "<bot_token>"))
+	}
+
+	return response, err
+}
@@ -0,0 +1,230 @@
+package notifier
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/andrewshostak/result-service/internal/app/models"
+	"github.com/andrewshostak/result-service/pkg/webhook"
+)
+
+// WebhookChannel sends a signed notification body to the subscriber endpoint.
+type WebhookChannel struct {
+	httpClient HTTPManager
+	logger     Logger
+}
+
+func NewWebhookChannel(httpClient HTTPManager, logger Logger) *WebhookChannel {
+	return &WebhookChannel{httpClient: httpClient, logger: logger}
+}
+
+// Notify sends the notification with the method of the subscription, PATCH by default.
+// Custom headers of the subscription are sent as well, but they don't override headers set by the service.
+// When the subscription has an auth header, the key is sent in it in addition to the signature.
+func (c *WebhookChannel) Notify(ctx context.Context, notification models.SubscriberNotification) (*models.NotificationResponse, error) {
+	timestamp := time.Now()
+
+	body := toNotificationBody(notification)
+	contentType := contentTypeJSON
+	if notification.DeliveryFormat == models.FormatCloudEventsStructured {
+		body = toCloudEvent(notification, timestamp, body)
+		contentType = contentTypeCloudEventsJSON
+	}
+
+	payload, err := json.Marshal(body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal notify subscriber request body: %w", err)
+	}
+
+	method := notification.HTTPMethod
+	if method == "" {
+		method = http.MethodPatch
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, notification.Url, bytes.NewReader(payload))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request to notify subscriber: %w", err)
+	}
+
+	for name, value := range notification.Headers {
+		req.Header.Set(name, value)
+	}
+
+	setAuthHeader(req.Header, notification.AuthHeader, notification.AuthScheme, notification.Key)
+
+	secrets := []string{notification.Key}
+	if notification.PreviousKey != nil {
+		secrets = append(secrets, *notification.PreviousKey)
+	}
+
+	req.Header.Set(webhook.HeaderDeliveryID, notification.DeliveryID)
+	req.Header.Set(webhook.HeaderTimestamp, strconv.FormatInt(timestamp.Unix(), 10))
+	req.Header.Set(webhook.HeaderSignature, webhook.Sign(secrets, timestamp, payload))
+	req.Header.Set("Content-Type", contentType)
+	if notification.Test {
+		req.Header.Set(webhook.HeaderTest, "true")
+	}
+	if notification.DeliveryFormat == models.FormatCloudEventsBinary {
+		setCloudEventHeaders(req.Header, toCloudEvent(notification, timestamp, nil))
+	}
+
+	return send(c.httpClient, c.logger, req, payload, responseBodyLimit)
+}
+
+// NotifyBatch posts the payloads of the items to the batch url in a single request signed with the shared key of the subscriptions.
+// Custom headers and the auth header of the subscriptions are sent as in Notify.
+// The subscriber acknowledges the processed items by their delivery ids in the response body.
+func (c *WebhookChannel) NotifyBatch(ctx context.Context, batch models.BatchNotification) (*models.NotificationResponse, error) {
+	timestamp := time.Now()
+
+	items := make([]BatchItem, 0, len(batch.Items))
+	for _, item := range batch.Items {
+		items = append(items, BatchItem{
+			DeliveryID:     item.Notification.DeliveryID,
+			SubscriptionID: item.SubscriptionID,
+			URL:            item.Notification.Url,
+			Payload:        toNotificationBody(item.Notification),
+		})
+	}
+
+	payload, err := json.Marshal(BatchBody{Type: batchType, DeliveryID: batch.DeliveryID, Items: items})
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal batch request body: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, batch.Url, bytes.NewReader(payload))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create batch request: %w", err)
+	}
+
+	for name, value := range batch.Headers {
+		req.Header.Set(name, value)
+	}
+
+	setAuthHeader(req.Header, batch.AuthHeader, batch.AuthScheme, batch.Key)
+
+	secrets := []string{batch.Key}
+	if batch.PreviousKey != nil {
+		secrets = append(secrets, *batch.PreviousKey)
+	}
+
+	req.Header.Set(webhook.HeaderDeliveryID, batch.DeliveryID)
+	req.Header.Set(webhook.HeaderTimestamp, strconv.FormatInt(timestamp.Unix(), 10))
+	req.Header.Set(webhook.HeaderSignature, webhook.Sign(secrets, timestamp, payload))
+	req.Header.Set("Content-Type", contentTypeJSON)
+
+	response, err := send(c.httpClient, c.logger, req, payload, batchResponseBodyLimit)
+	if response != nil && response.Body != nil {
+		if err == nil {
+			response.Acknowledged = acknowledgedItems(*response.Body)
+		}
+
+		if len(*response.Body) > responseBodyLimit {
+			excerpt := (*response.Body)[:responseBodyLimit]
+			response.Body = &excerpt
+		}
+	}
+
+	return response, err
+}
+
+// acknowledgedItems returns delivery ids acknowledged in the batch response, a body of another shape acknowledges nothing.
+func acknowledgedItems(body string) []string {
+	var batchResponse BatchResponse
+	if err := json.Unmarshal([]byte(body), &batchResponse); err != nil {
+		return nil
+	}
+
+	return batchResponse.Acknowledged
+}
+
+// Verify sends a signed challenge with the method and headers of the subscription, the endpoint has to echo it in the response body.
+func (c *WebhookChannel) Verify(ctx context.Context, verification models.EndpointVerification) error {
+	timestamp := time.Now()
+
+	payload, err := json.Marshal(VerificationBody{
+		Type:           verificationType,
+		Challenge:      verification.Challenge,
+		SubscriptionID: verification.SubscriptionID,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to marshal verification request body: %w", err)
+	}
+
+	method := verification.HTTPMethod
+	if method == "" {
+		method = http.MethodPatch
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, verification.Url, bytes.NewReader(payload))
+	if err != nil {
+		return fmt.Errorf("failed to create verification request: %w", err)
+	}
+
+	for name, value := range verification.Headers {
+		req.Header.Set(name, value)
+	}
+
+	setAuthHeader(req.Header, verification.AuthHeader, verification.AuthScheme, verification.Key)
+
+	req.Header.Set(webhook.HeaderDeliveryID, verification.DeliveryID)
+	req.Header.Set(webhook.HeaderTimestamp, strconv.FormatInt(timestamp.Unix(), 10))
+	req.Header.Set(webhook.HeaderSignature, webhook.Sign([]string{verification.Key}, timestamp, payload))
+	req.Header.Set("Content-Type", contentTypeJSON)
+
+	response, err := send(c.httpClient, c.logger, req, payload, responseBodyLimit)
+	if err != nil {
+		return err
+	}
+
+	if !isChallengeEcho(response.Body, verification.Challenge) {
+		return errors.New("endpoint didn't echo the verification challenge")
+	}
+
+	return nil
+}
+
+// setAuthHeader sets the key, preceded by the scheme when it is given, to the auth header. Nothing is set without the header.
+func setAuthHeader(header http.Header, name *string, scheme *string, key string) {
+	if name == nil {
+		return
+	}
+
+	value := key
+	if scheme != nil {
+		value = *scheme + " " + key
+	}
+
+	header.Set(*name, value)
+}
+
+// isChallengeEcho reports whether the response body is the challenge either as json or as plain text.
+func isChallengeEcho(body *string, challenge string) bool {
+	if body == nil {
+		return false
+	}
+
+	var echo VerificationResponse
+	if err := json.Unmarshal([]byte(*body), &echo); err == nil {
+		return echo.Challenge == challenge
+	}
+
+	return strings.TrimSpace(*body) == challenge
+}
+
+// setCloudEventHeaders maps CloudEvents context attributes to headers of the binary content mode.
+func setCloudEventHeaders(header http.Header, event CloudEvent) {
+	header.Set("ce-specversion", event.SpecVersion)
+	header.Set("ce-id", event.ID)
+	header.Set("ce-source", event.Source)
+	header.Set("ce-type", event.Type)
+	header.Set("ce-subject", event.Subject)
+	header.Set("ce-time", event.Time.Format(time.RFC3339Nano))
+}
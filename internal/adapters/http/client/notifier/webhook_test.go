@@ -0,0 +1,632 @@
+package notifier_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/andrewshostak/result-service/internal/adapters/http/client/fotmob"
+	"github.com/andrewshostak/result-service/internal/adapters/http/client/notifier"
+	"github.com/andrewshostak/result-service/internal/adapters/http/client/notifier/mocks"
+	"github.com/andrewshostak/result-service/internal/app/models"
+	loggerinternal "github.com/andrewshostak/result-service/internal/infra/logger"
+	"github.com/andrewshostak/result-service/pkg/webhook"
+	"github.com/andrewshostak/result-service/testutils"
+	"github.com/brianvoe/gofakeit/v6"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWebhookChannel_Notify(t *testing.T) {
+	ctx := context.Background()
+
+	previousKey := gofakeit.Password(true, true, true, false, false, 10)
+	subscriberNotification := models.SubscriberNotification{
+		DeliveryID:  gofakeit.UUID(),
+		Url:         gofakeit.URL(),
+		Key:         gofakeit.Password(true, true, true, false, false, 10),
+		PreviousKey: &previousKey,
+		Home:        uint(gofakeit.Uint8()),
+		Away:        uint(gofakeit.Uint8()),
+	}
+
+	body := notifier.NotificationBody{
+		Home: subscriberNotification.Home,
+		Away: subscriberNotification.Away,
+	}
+
+	requestBody, err := json.Marshal(body)
+	require.NoError(t, err)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPatch, subscriberNotification.Url, bytes.NewReader(requestBody))
+	require.NoError(t, err)
+	req.Header.Set(webhook.HeaderDeliveryID, subscriberNotification.DeliveryID)
+	req.Header.Set("Content-Type", "application/json")
+
+	okStatusCode, unavailableStatusCode := http.StatusOK, http.StatusServiceUnavailable
+	truncatedBody := strings.Repeat("a", 1024)
+	retryAfter := 2 * time.Minute
+
+	// signature and timestamp depend on the sending time, so they are verified with both keys and then copied
+	isExpectedRequest := func(t *testing.T, actual *http.Request) bool {
+		t.Helper()
+
+		for _, key := range []string{subscriberNotification.Key, previousKey} {
+			if _, err := webhook.NewVerifier(webhook.DefaultTolerance, key).Verify(actual.Header, requestBody); err != nil {
+				t.Logf("failed to verify request signature: %s", err)
+
+				return false
+			}
+		}
+
+		expected := req.Clone(ctx)
+		expected.Header.Set(webhook.HeaderTimestamp, actual.Header.Get(webhook.HeaderTimestamp))
+		expected.Header.Set(webhook.HeaderSignature, actual.Header.Get(webhook.HeaderSignature))
+
+		return testutils.CompareRequest(t, expected, actual)
+	}
+
+	tests := []struct {
+		name               string
+		httpManager        func(t *testing.T) fotmob.HTTPManager
+		expectedStatusCode *int
+		expectedBody       *string
+		expectedRetryAfter *time.Duration
+		expectedErr        error
+	}{
+		{
+			name: "success - it returns no error if response code is 2xx",
+			httpManager: func(t *testing.T) fotmob.HTTPManager {
+				t.Helper()
+				httpManager := mocks.NewHTTPManager(t)
+				httpManager.
+					On("Do", mock.MatchedBy(func(actual *http.Request) bool {
+						return isExpectedRequest(t, actual)
+					})).
+					Return(&http.Response{StatusCode: http.StatusOK, Body: http.NoBody}, nil).
+					Once()
+				return httpManager
+			},
+			expectedStatusCode: &okStatusCode,
+		},
+		{
+			name: "it returns an error when fails to make a request",
+			httpManager: func(t *testing.T) fotmob.HTTPManager {
+				t.Helper()
+				httpManager := mocks.NewHTTPManager(t)
+				httpManager.
+					On("Do", mock.MatchedBy(func(actual *http.Request) bool {
+						return isExpectedRequest(t, actual)
+					})).
+					Return(nil, errors.New("some error")).
+					Once()
+				return httpManager
+			},
+			expectedErr: fmt.Errorf("failed to send request to notify subscribers: %w", errors.New("some error")),
+		},
+		{
+			name: "it returns an error when response code is not 2xx",
+			httpManager: func(t *testing.T) fotmob.HTTPManager {
+				t.Helper()
+				httpManager := mocks.NewHTTPManager(t)
+				httpManager.
+					On("Do", mock.MatchedBy(func(actual *http.Request) bool {
+						return isExpectedRequest(t, actual)
+					})).
+					Return(&http.Response{
+						StatusCode: http.StatusServiceUnavailable,
+						Header:     http.Header{"Retry-After": []string{"120"}},
+						Body:       io.NopCloser(strings.NewReader(strings.Repeat("a", 2048))),
+					}, nil).
+					Once()
+				return httpManager
+			},
+			expectedStatusCode: &unavailableStatusCode,
+			expectedBody:       &truncatedBody,
+			expectedRetryAfter: &retryAfter,
+			expectedErr:        errors.New(fmt.Sprintf("failed to notify subscribers, status code %d", http.StatusServiceUnavailable)),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			logger := loggerinternal.SetupLogger()
+
+			client := notifier.NewWebhookChannel(tt.httpManager(t), logger)
+
+			response, err := client.Notify(ctx, subscriberNotification)
+
+			if tt.expectedErr != nil {
+				assert.EqualError(t, err, tt.expectedErr.Error())
+			} else {
+				assert.NoError(t, err)
+			}
+
+			require.NotNil(t, response)
+			assert.Equal(t, requestBody, response.RequestPayload)
+			assert.Equal(t, tt.expectedStatusCode, response.StatusCode)
+			assert.Equal(t, tt.expectedBody, response.Body)
+			assert.Equal(t, tt.expectedRetryAfter, response.RetryAfter)
+		})
+	}
+}
+
+func TestWebhookChannel_Notify_PayloadV2(t *testing.T) {
+	ctx := context.Background()
+
+	finishType := models.FinishPenalties
+	subscriberNotification := models.SubscriberNotification{
+		DeliveryID:      gofakeit.UUID(),
+		EventType:       models.EventResultFinished,
+		PayloadVersion:  models.PayloadV2,
+		Url:             gofakeit.URL(),
+		Key:             gofakeit.Password(true, true, true, false, false, 10),
+		MatchID:         uint(gofakeit.Uint8()),
+		ExternalMatchID: uint(gofakeit.Uint32()),
+		StartsAt:        gofakeit.Date().UTC(),
+		HomeTeam:        models.Team{ID: uint(gofakeit.Uint8()), Aliases: []string{gofakeit.Name()}},
+		AwayTeam:        models.Team{ID: uint(gofakeit.Uint8())},
+		FinishType:      &finishType,
+		Home:            uint(gofakeit.Uint8()),
+		Away:            uint(gofakeit.Uint8()),
+	}
+
+	finishTypeValue := string(finishType)
+	requestBody, err := json.Marshal(notifier.NotificationBodyV2{
+		Version:    "v2",
+		EventType:  "result.finished",
+		DeliveryID: subscriberNotification.DeliveryID,
+		Match: notifier.MatchPayload{
+			ID:         subscriberNotification.MatchID,
+			ExternalID: subscriberNotification.ExternalMatchID,
+			Kickoff:    subscriberNotification.StartsAt,
+			Home:       notifier.TeamPayload{TeamID: subscriberNotification.HomeTeam.ID, Aliases: subscriberNotification.HomeTeam.Aliases},
+			Away:       notifier.TeamPayload{TeamID: subscriberNotification.AwayTeam.ID, Aliases: []string{}},
+			FinishType: &finishTypeValue,
+			Score:      notifier.ScorePayload{Home: subscriberNotification.Home, Away: subscriberNotification.Away},
+		},
+	})
+	require.NoError(t, err)
+
+	httpManager := mocks.NewHTTPManager(t)
+	httpManager.
+		On("Do", mock.MatchedBy(func(actual *http.Request) bool {
+			_, err := webhook.NewVerifier(webhook.DefaultTolerance, subscriberNotification.Key).Verify(actual.Header, requestBody)
+			return err == nil
+		})).
+		Return(&http.Response{StatusCode: http.StatusOK, Body: http.NoBody}, nil).
+		Once()
+
+	client := notifier.NewWebhookChannel(httpManager, loggerinternal.SetupLogger())
+
+	_, err = client.Notify(ctx, subscriberNotification)
+	assert.NoError(t, err)
+}
+
+func TestWebhookChannel_Notify_CloudEvents(t *testing.T) {
+	ctx := context.Background()
+
+	subscriberNotification := models.SubscriberNotification{
+		DeliveryID:     gofakeit.UUID(),
+		EventType:      models.EventResultFinished,
+		PayloadVersion: models.PayloadV1,
+		Url:            gofakeit.URL(),
+		Key:            gofakeit.Password(true, true, true, false, false, 10),
+		MatchID:        uint(gofakeit.Uint8()),
+		Home:           uint(gofakeit.Uint8()),
+		Away:           uint(gofakeit.Uint8()),
+	}
+
+	data := notifier.NotificationBody{Home: subscriberNotification.Home, Away: subscriberNotification.Away}
+	matchID := fmt.Sprintf("%d", subscriberNotification.MatchID)
+
+	tests := []struct {
+		name              string
+		deliveryFormat    models.DeliveryFormat
+		isExpectedRequest func(t *testing.T, actual *http.Request, body []byte) bool
+	}{
+		{
+			name:           "it sends an event in structured content mode",
+			deliveryFormat: models.FormatCloudEventsStructured,
+			isExpectedRequest: func(t *testing.T, actual *http.Request, body []byte) bool {
+				t.Helper()
+
+				var event struct {
+					notifier.CloudEvent
+					Data notifier.NotificationBody `json:"data"`
+				}
+				require.NoError(t, json.Unmarshal(body, &event))
+
+				return actual.Header.Get("Content-Type") == "application/cloudevents+json" &&
+					event.SpecVersion == "1.0" &&
+					event.ID == subscriberNotification.DeliveryID &&
+					event.Source == "result-service" &&
+					event.Type == "result.finished" &&
+					event.Subject == matchID &&
+					event.DataContentType == "application/json" &&
+					event.Data == data
+			},
+		},
+		{
+			name:           "it sends an event in binary content mode",
+			deliveryFormat: models.FormatCloudEventsBinary,
+			isExpectedRequest: func(t *testing.T, actual *http.Request, body []byte) bool {
+				t.Helper()
+
+				var actualData notifier.NotificationBody
+				require.NoError(t, json.Unmarshal(body, &actualData))
+
+				_, err := time.Parse(time.RFC3339Nano, actual.Header.Get("ce-time"))
+
+				return actual.Header.Get("Content-Type") == "application/json" &&
+					actual.Header.Get("ce-specversion") == "1.0" &&
+					actual.Header.Get("ce-id") == subscriberNotification.DeliveryID &&
+					actual.Header.Get("ce-source") == "result-service" &&
+					actual.Header.Get("ce-type") == "result.finished" &&
+					actual.Header.Get("ce-subject") == matchID &&
+					err == nil &&
+					actualData == data
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			notification := subscriberNotification
+			notification.DeliveryFormat = tt.deliveryFormat
+
+			httpManager := mocks.NewHTTPManager(t)
+			httpManager.
+				On("Do", mock.MatchedBy(func(actual *http.Request) bool {
+					body, err := io.ReadAll(actual.Body)
+					require.NoError(t, err)
+
+					if _, err := webhook.NewVerifier(webhook.DefaultTolerance, notification.Key).Verify(actual.Header, body); err != nil {
+						return false
+					}
+
+					return tt.isExpectedRequest(t, actual, body)
+				})).
+				Return(&http.Response{StatusCode: http.StatusOK, Body: http.NoBody}, nil).
+				Once()
+
+			client := notifier.NewWebhookChannel(httpManager, loggerinternal.SetupLogger())
+
+			_, err := client.Notify(ctx, notification)
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestWebhookChannel_Notify_MethodAndHeaders(t *testing.T) {
+	ctx := context.Background()
+
+	token := gofakeit.UUID()
+	subscriberNotification := models.SubscriberNotification{
+		DeliveryID: gofakeit.UUID(),
+		HTTPMethod: http.MethodPut,
+		Headers: map[string]string{
+			"Authorization": "Bearer " + token,
+			"Content-Type":  "text/plain",
+		},
+		Url: gofakeit.URL(),
+		Key: gofakeit.Password(true, true, true, false, false, 10),
+	}
+
+	httpManager := mocks.NewHTTPManager(t)
+	httpManager.
+		On("Do", mock.MatchedBy(func(actual *http.Request) bool {
+			return actual.Method == http.MethodPut &&
+				actual.Header.Get("Authorization") == "Bearer "+token &&
+				actual.Header.Get("Content-Type") == "application/json" &&
+				actual.Header.Get(webhook.HeaderDeliveryID) == subscriberNotification.DeliveryID
+		})).
+		Return(&http.Response{StatusCode: http.StatusOK, Body: http.NoBody}, nil).
+		Once()
+
+	client := notifier.NewWebhookChannel(httpManager, loggerinternal.SetupLogger())
+
+	_, err := client.Notify(ctx, subscriberNotification)
+	assert.NoError(t, err)
+}
+
+func TestWebhookChannel_Notify_AuthHeader(t *testing.T) {
+	ctx := context.Background()
+
+	key := gofakeit.Password(true, true, true, false, false, 10)
+	authorization, apiKey, bearer := "Authorization", "X-Api-Key", "Bearer"
+
+	tests := []struct {
+		name           string
+		authHeader     *string
+		authScheme     *string
+		headers        map[string]string
+		expectedHeader string
+		expectedValue  string
+	}{
+		{
+			name:           "it sends the key preceded by the scheme",
+			authHeader:     &authorization,
+			authScheme:     &bearer,
+			expectedHeader: authorization,
+			expectedValue:  "Bearer " + key,
+		},
+		{
+			name:           "it sends the bare key when scheme is not set",
+			authHeader:     &apiKey,
+			expectedHeader: apiKey,
+			expectedValue:  key,
+		},
+		{
+			name:           "it overrides a custom header of the same name",
+			authHeader:     &authorization,
+			authScheme:     &bearer,
+			headers:        map[string]string{"Authorization": "Basic credentials"},
+			expectedHeader: authorization,
+			expectedValue:  "Bearer " + key,
+		},
+		{
+			name:           "it doesn't send the key when auth header is not set",
+			headers:        map[string]string{"Authorization": "Basic credentials"},
+			expectedHeader: authorization,
+			expectedValue:  "Basic credentials",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			subscriberNotification := models.SubscriberNotification{
+				DeliveryID: gofakeit.UUID(),
+				Headers:    tt.headers,
+				AuthHeader: tt.authHeader,
+				AuthScheme: tt.authScheme,
+				Url:        gofakeit.URL(),
+				Key:        key,
+			}
+
+			httpManager := mocks.NewHTTPManager(t)
+			httpManager.
+				On("Do", mock.MatchedBy(func(actual *http.Request) bool {
+					return actual.Header.Get(tt.expectedHeader) == tt.expectedValue &&
+						actual.Header.Get(webhook.HeaderSignature) != ""
+				})).
+				Return(&http.Response{StatusCode: http.StatusOK, Body: http.NoBody}, nil).
+				Once()
+
+			client := notifier.NewWebhookChannel(httpManager, loggerinternal.SetupLogger())
+
+			_, err := client.Notify(ctx, subscriberNotification)
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestWebhookChannel_Notify_Test(t *testing.T) {
+	ctx := context.Background()
+
+	subscriberNotification := models.SubscriberNotification{
+		DeliveryID:     gofakeit.UUID(),
+		EventType:      models.EventResultFinished,
+		PayloadVersion: models.PayloadV2,
+		Url:            gofakeit.URL(),
+		Key:            gofakeit.Password(true, true, true, false, false, 10),
+		Test:           true,
+	}
+
+	httpManager := mocks.NewHTTPManager(t)
+	httpManager.
+		On("Do", mock.MatchedBy(func(actual *http.Request) bool {
+			reader, err := actual.GetBody()
+			require.NoError(t, err)
+
+			var body notifier.NotificationBodyV2
+			require.NoError(t, json.NewDecoder(reader).Decode(&body))
+
+			return actual.Header.Get(webhook.HeaderTest) == "true" && body.Test
+		})).
+		Return(&http.Response{StatusCode: http.StatusOK, Body: http.NoBody}, nil).
+		Once()
+
+	client := notifier.NewWebhookChannel(httpManager, loggerinternal.SetupLogger())
+
+	_, err := client.Notify(ctx, subscriberNotification)
+	assert.NoError(t, err)
+}
+
+func TestWebhookChannel_Verify(t *testing.T) {
+	ctx := context.Background()
+
+	apiKey := "X-Api-Key"
+	verification := models.EndpointVerification{
+		SubscriptionID: uint(gofakeit.Uint8()),
+		DeliveryID:     gofakeit.UUID(),
+		Challenge:      gofakeit.UUID(),
+		HTTPMethod:     http.MethodPost,
+		Headers:        map[string]string{"Authorization": "Bearer token"},
+		AuthHeader:     &apiKey,
+		Url:            gofakeit.URL(),
+		Key:            gofakeit.Password(true, true, true, false, false, 10),
+	}
+
+	requestMatcher := mock.MatchedBy(func(actual *http.Request) bool {
+		body, err := actual.GetBody()
+		if err != nil {
+			return false
+		}
+
+		requestBody, err := io.ReadAll(body)
+		if err != nil {
+			return false
+		}
+
+		if _, err := webhook.NewVerifier(webhook.DefaultTolerance, verification.Key).Verify(actual.Header, requestBody); err != nil {
+			return false
+		}
+
+		expectedBody := fmt.Sprintf(`{"type":"verification","challenge":%q,"subscription_id":%d}`, verification.Challenge, verification.SubscriptionID)
+
+		return actual.Method == http.MethodPost &&
+			actual.URL.String() == verification.Url &&
+			actual.Header.Get("Authorization") == "Bearer token" &&
+			actual.Header.Get(apiKey) == verification.Key &&
+			actual.Header.Get(webhook.HeaderDeliveryID) == verification.DeliveryID &&
+			string(requestBody) == expectedBody
+	})
+
+	response := func(statusCode int, body string) *http.Response {
+		return &http.Response{StatusCode: statusCode, Body: io.NopCloser(strings.NewReader(body))}
+	}
+
+	tests := []struct {
+		name        string
+		response    *http.Response
+		expectedErr error
+	}{
+		{
+			name:        "it returns an error when endpoint responds with an error",
+			response:    response(http.StatusNotFound, "not found"),
+			expectedErr: errors.New("failed to notify subscribers, status code 404"),
+		},
+		{
+			name:        "it returns an error when endpoint doesn't echo the challenge",
+			response:    response(http.StatusOK, `{"challenge":"something else"}`),
+			expectedErr: errors.New("endpoint didn't echo the verification challenge"),
+		},
+		{
+			name:        "it returns an error when endpoint responds without a body",
+			response:    response(http.StatusNoContent, ""),
+			expectedErr: errors.New("endpoint didn't echo the verification challenge"),
+		},
+		{
+			name:     "success - endpoint echoes the challenge as json",
+			response: response(http.StatusOK, fmt.Sprintf(`{"challenge":%q}`, verification.Challenge)),
+		},
+		{
+			name:     "success - endpoint echoes the challenge as plain text",
+			response: response(http.StatusOK, verification.Challenge+"\n"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			httpManager := mocks.NewHTTPManager(t)
+			httpManager.On("Do", requestMatcher).Return(tt.response, nil).Once()
+
+			client := notifier.NewWebhookChannel(httpManager, loggerinternal.SetupLogger())
+
+			err := client.Verify(ctx, verification)
+			if tt.expectedErr != nil {
+				assert.EqualError(t, err, tt.expectedErr.Error())
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestWebhookChannel_NotifyBatch(t *testing.T) {
+	ctx := context.Background()
+
+	key := gofakeit.Password(true, true, true, false, false, 10)
+	previousKey := gofakeit.Password(true, true, true, false, false, 10)
+	authHeader, authScheme := "Authorization", "Bearer"
+	batch := models.BatchNotification{
+		DeliveryID:  gofakeit.UUID(),
+		Url:         gofakeit.URL(),
+		Key:         key,
+		PreviousKey: &previousKey,
+		Headers:     map[string]string{"X-Tenant": "prognoz"},
+		AuthHeader:  &authHeader,
+		AuthScheme:  &authScheme,
+		Items: []models.BatchNotificationItem{
+			{SubscriptionID: 1, Notification: models.SubscriberNotification{DeliveryID: gofakeit.UUID(), Url: gofakeit.URL(), Home: 2, Away: 1}},
+			{SubscriptionID: 2, Notification: models.SubscriberNotification{DeliveryID: gofakeit.UUID(), Url: gofakeit.URL(), Home: 2, Away: 1}},
+		},
+	}
+
+	requestMatcher := mock.MatchedBy(func(actual *http.Request) bool {
+		reader, err := actual.GetBody()
+		if err != nil {
+			return false
+		}
+
+		requestBody, err := io.ReadAll(reader)
+		if err != nil {
+			return false
+		}
+
+		for _, secret := range []string{key, previousKey} {
+			if _, err := webhook.NewVerifier(webhook.DefaultTolerance, secret).Verify(actual.Header, requestBody); err != nil {
+				return false
+			}
+		}
+
+		var body notifier.BatchBody
+		if err := json.Unmarshal(requestBody, &body); err != nil {
+			return false
+		}
+
+		return actual.Method == http.MethodPost &&
+			actual.URL.String() == batch.Url &&
+			actual.Header.Get(webhook.HeaderDeliveryID) == batch.DeliveryID &&
+			actual.Header.Get("X-Tenant") == "prognoz" &&
+			actual.Header.Get(authHeader) == authScheme+" "+key &&
+			body.Type == "batch" &&
+			len(body.Items) == 2 &&
+			body.Items[0].DeliveryID == batch.Items[0].Notification.DeliveryID &&
+			body.Items[1].SubscriptionID == batch.Items[1].SubscriptionID &&
+			body.Items[1].URL == batch.Items[1].Notification.Url
+	})
+
+	response := func(statusCode int, body string) *http.Response {
+		return &http.Response{StatusCode: statusCode, Body: io.NopCloser(strings.NewReader(body))}
+	}
+
+	tests := []struct {
+		name                 string
+		response             *http.Response
+		expectedAcknowledged []string
+		expectedErr          bool
+	}{
+		{
+			name:        "it returns an error and acknowledges nothing when batch url responds with an error",
+			response:    response(http.StatusInternalServerError, fmt.Sprintf(`{"acknowledged":[%q]}`, batch.Items[0].Notification.DeliveryID)),
+			expectedErr: true,
+		},
+		{
+			name:     "success - it acknowledges nothing when response body is not a batch response",
+			response: response(http.StatusOK, "ok"),
+		},
+		{
+			name:                 "success - it returns acknowledged delivery ids",
+			response:             response(http.StatusOK, fmt.Sprintf(`{"acknowledged":[%q]}`, batch.Items[0].Notification.DeliveryID)),
+			expectedAcknowledged: []string{batch.Items[0].Notification.DeliveryID},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			httpManager := mocks.NewHTTPManager(t)
+			httpManager.On("Do", requestMatcher).Return(tt.response, nil).Once()
+
+			client := notifier.NewWebhookChannel(httpManager, loggerinternal.SetupLogger())
+
+			actual, err := client.NotifyBatch(ctx, batch)
+			if tt.expectedErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+			require.NotNil(t, actual)
+			assert.Equal(t, tt.expectedAcknowledged, actual.Acknowledged)
+		})
+	}
+}
@@ -16,12 +16,17 @@ import (
 )
 
 const (
-	checkResultPath      = "/v1/triggers/result_check"
-	notifySubscriberPath = "/v1/triggers/subscriber_notification"
+	checkResultPath       = "/v1/triggers/result_check"
+	liveCheckPath         = "/v1/triggers/live_check"
+	notifySubscriberPath  = "/v1/triggers/subscriber_notification"
+	eventDeliveryPath     = "/v1/triggers/event_delivery"
+	notificationBatchPath = "/v1/triggers/notification_batch"
+	kickoffReminderPath   = "/v1/triggers/kickoff_reminder"
 )
 
 const (
 	errAlreadyExists = "AlreadyExists"
+	errNotFound      = "NotFound"
 )
 
 type TaskClient struct {
@@ -35,97 +40,143 @@ func NewClient(config config.GoogleCloud, dispatchDeadline time.Duration, client
 }
 
 func (c *TaskClient) GetResultCheckTask(ctx context.Context, matchID uint, attempt uint) (*models.Task, error) {
-	queuePath := fmt.Sprintf("projects/%s/locations/%s/queues/%s", c.config.ProjectID, c.config.Region, c.config.CheckResultQueueName)
-	name := fmt.Sprintf("%s/tasks/match-%d-attempt-%d", queuePath, matchID, attempt)
+	name := fmt.Sprintf("match-%d-attempt-%d", matchID, attempt)
 
-	req := &taskspb.GetTaskRequest{Name: name}
+	return c.getTask(ctx, c.config.CheckResultQueueName, name, "result-check")
+}
+
+func (c *TaskClient) ScheduleResultCheck(ctx context.Context, matchID uint, attempt uint, scheduleAt time.Time) (*models.Task, error) {
+	name := fmt.Sprintf("match-%d-attempt-%d", matchID, attempt)
+	payload := map[string]uint{"match_id": matchID}
+
+	return c.createTask(ctx, c.config.CheckResultQueueName, name, checkResultPath, payload, scheduleAt, "result-check")
+}
+
+func (c *TaskClient) DeleteResultCheckTask(ctx context.Context, taskName string) error {
+	req := &taskspb.DeleteTaskRequest{Name: taskName}
+	err := c.client.DeleteTask(ctx, req)
 
-	task, err := c.client.GetTask(ctx, req)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get result-check task: %w", err)
+		return fmt.Errorf("failed to delete result-check task: %w", err)
 	}
 
-	return &models.Task{Name: task.Name, ExecuteAt: task.ScheduleTime.AsTime()}, nil
+	return nil
 }
 
-func (c *TaskClient) ScheduleResultCheck(ctx context.Context, matchID uint, attempt uint, scheduleAt time.Time) (*models.Task, error) {
-	targetURL := fmt.Sprintf("%s%s", c.config.TargetURL, checkResultPath)
+// GetSubscriberNotificationTask gets the task of the regular notification of the subscriber.
+func (c *TaskClient) GetSubscriberNotificationTask(ctx context.Context, subscriptionID uint) (*models.Task, error) {
+	name := fmt.Sprintf("subscription-%d", subscriptionID)
 
-	queuePath := fmt.Sprintf("projects/%s/locations/%s/queues/%s", c.config.ProjectID, c.config.Region, c.config.CheckResultQueueName)
+	return c.getTask(ctx, c.config.NotifySubscriberQueueName, name, "subscriber-notification")
+}
 
-	payload := map[string]uint{"match_id": matchID}
-	body, err := json.Marshal(payload)
-	if err != nil {
-		return nil, err
+// ScheduleSubscriberNotification creates a task to notify the subscriber.
+// The task of the regular notification is named after the subscription, so it is created only once.
+// A non-empty redelivery id gives the task a unique name, which allows to notify the subscriber again.
+func (c *TaskClient) ScheduleSubscriberNotification(ctx context.Context, subscriptionID uint, redeliveryID string) error {
+	name := fmt.Sprintf("subscription-%d", subscriptionID)
+	if redeliveryID != "" {
+		name = fmt.Sprintf("%s-redelivery-%s", name, redeliveryID)
 	}
 
-	req := &taskspb.CreateTaskRequest{
-		Parent: queuePath,
-		Task: &taskspb.Task{
-			Name:             fmt.Sprintf("%s/tasks/match-%d-attempt-%d", queuePath, matchID, attempt),
-			ScheduleTime:     timestamppb.New(scheduleAt),
-			DispatchDeadline: durationpb.New(c.dispatchDeadline),
-			MessageType: &taskspb.Task_HttpRequest{
-				HttpRequest: &taskspb.HttpRequest{
-					HttpMethod: taskspb.HttpMethod_POST,
-					Url:        targetURL,
-					Body:       body,
-					Headers: map[string]string{
-						"Content-Type": "application/json",
-					},
-					AuthorizationHeader: &taskspb.HttpRequest_OidcToken{
-						OidcToken: &taskspb.OidcToken{
-							ServiceAccountEmail: c.config.ServiceAccountEmail,
-							Audience:            c.config.TargetURL,
-						},
-					},
-				},
-			},
-		},
-	}
+	payload := map[string]uint{"subscription_id": subscriptionID}
 
-	createdTask, err := c.client.CreateTask(ctx, req)
-	if err != nil {
-		fmt.Printf("failed to create task: %s\n", err.Error())
-		if c.isTaskAlreadyExistsError(err) {
-			return nil, models.NewResourceAlreadyExistsError(fmt.Errorf("result-check task already exists: %w", err))
-		}
+	_, err := c.createTask(ctx, c.config.NotifySubscriberQueueName, name, notifySubscriberPath, payload, time.Time{}, "subscriber-notification")
+	return err
+}
 
-		return nil, fmt.Errorf("failed to create result-check task: %w", err)
-	}
+// ScheduleSubscriberNotificationRetry creates a task to notify the subscriber again at the given time.
+// The retry id gives the task a unique name.
+func (c *TaskClient) ScheduleSubscriberNotificationRetry(ctx context.Context, subscriptionID uint, retryID string, scheduleAt time.Time) error {
+	name := fmt.Sprintf("subscription-%d-retry-%s", subscriptionID, retryID)
+	payload := map[string]uint{"subscription_id": subscriptionID}
 
-	return &models.Task{
-		Name:      createdTask.Name,
-		ExecuteAt: createdTask.ScheduleTime.AsTime(),
-	}, nil
+	_, err := c.createTask(ctx, c.config.NotifySubscriberQueueName, name, notifySubscriberPath, payload, scheduleAt, "subscriber-notification")
+	return err
 }
 
-func (c *TaskClient) DeleteResultCheckTask(ctx context.Context, taskName string) error {
-	req := &taskspb.DeleteTaskRequest{Name: taskName}
-	err := c.client.DeleteTask(ctx, req)
+// ScheduleLiveCheck creates a task to check the match while it is in play.
+// The task is named after the match and the sequence number of the check, so a check is created only once.
+func (c *TaskClient) ScheduleLiveCheck(ctx context.Context, matchID uint, sequence uint, scheduleAt time.Time) error {
+	name := fmt.Sprintf("match-%d-live-%d", matchID, sequence)
+	payload := map[string]uint{"match_id": matchID, "sequence": sequence}
 
-	if err != nil {
-		return fmt.Errorf("failed to delete result-check task: %w", err)
+	_, err := c.createTask(ctx, c.config.CheckResultQueueName, name, liveCheckPath, payload, scheduleAt, "live-check")
+	return err
+}
+
+// ScheduleEventDelivery creates a task to deliver the match event to the subscriber.
+// The task is named after the event delivery and the attempt number, so each attempt is created only once.
+func (c *TaskClient) ScheduleEventDelivery(ctx context.Context, eventDeliveryID uint, attempt uint, scheduleAt time.Time) error {
+	name := fmt.Sprintf("event-delivery-%d-attempt-%d", eventDeliveryID, attempt)
+	payload := map[string]uint{"event_delivery_id": eventDeliveryID}
+
+	_, err := c.createTask(ctx, c.config.NotifySubscriberQueueName, name, eventDeliveryPath, payload, scheduleAt, "event-delivery")
+	return err
+}
+
+// ScheduleNotificationBatch creates a task to deliver the notification batch once its window is over.
+// The task is named after the batch, so subscriptions joining the batch create it only once.
+func (c *TaskClient) ScheduleNotificationBatch(ctx context.Context, notificationBatchID uint, scheduleAt time.Time) error {
+	name := fmt.Sprintf("notification-batch-%d", notificationBatchID)
+	payload := map[string]uint{"notification_batch_id": notificationBatchID}
+
+	_, err := c.createTask(ctx, c.config.NotifySubscriberQueueName, name, notificationBatchPath, payload, scheduleAt, "notification-batch")
+	return err
+}
+
+// ScheduleKickoffReminder creates a task to remind the subscriber about the kickoff.
+// The task is named after the subscription and the kickoff, so a reminder of a kickoff is created only once,
+// and a reminder of the moved kickoff gets its own task.
+func (c *TaskClient) ScheduleKickoffReminder(ctx context.Context, subscriptionID uint, kickoff time.Time, scheduleAt time.Time) error {
+	name := fmt.Sprintf("subscription-%d-reminder-%d", subscriptionID, kickoff.Unix())
+	payload := map[string]uint{"subscription_id": subscriptionID, "kickoff": uint(kickoff.Unix())}
+
+	_, err := c.createTask(ctx, c.config.NotifySubscriberQueueName, name, kickoffReminderPath, payload, scheduleAt, "kickoff-reminder")
+	return err
+}
+
+// DeleteKickoffReminderTask deletes the reminder task of the kickoff. A task which doesn't exist is already executed or deleted.
+func (c *TaskClient) DeleteKickoffReminderTask(ctx context.Context, subscriptionID uint, kickoff time.Time) error {
+	name := fmt.Sprintf("subscription-%d-reminder-%d", subscriptionID, kickoff.Unix())
+	req := &taskspb.DeleteTaskRequest{Name: fmt.Sprintf("%s/tasks/%s", c.queuePath(c.config.NotifySubscriberQueueName), name)}
+
+	if err := c.client.DeleteTask(ctx, req); err != nil && !c.isTaskNotFoundError(err) {
+		return fmt.Errorf("failed to delete kickoff-reminder task: %w", err)
 	}
 
 	return nil
 }
 
-func (c *TaskClient) ScheduleSubscriberNotification(ctx context.Context, subscriptionID uint) error {
-	targetURL := fmt.Sprintf("%s%s", c.config.TargetURL, notifySubscriberPath)
+// createTask creates an http task calling the service itself. A zero schedule time makes the task run right away.
+func (c *TaskClient) createTask(
+	ctx context.Context,
+	queueName string,
+	name string,
+	path string,
+	payload map[string]uint,
+	scheduleAt time.Time,
+	kind string,
+) (*models.Task, error) {
+	targetURL := fmt.Sprintf("%s%s", c.config.TargetURL, path)
 
-	queuePath := fmt.Sprintf("projects/%s/locations/%s/queues/%s", c.config.ProjectID, c.config.Region, c.config.NotifySubscriberQueueName)
+	queuePath := c.queuePath(queueName)
 
-	payload := map[string]uint{"subscription_id": subscriptionID}
 	body, err := json.Marshal(payload)
 	if err != nil {
-		return err
+		return nil, err
+	}
+
+	var scheduleTime *timestamppb.Timestamp
+	if !scheduleAt.IsZero() {
+		scheduleTime = timestamppb.New(scheduleAt)
 	}
 
 	req := &taskspb.CreateTaskRequest{
 		Parent: queuePath,
 		Task: &taskspb.Task{
-			Name:             fmt.Sprintf("%s/tasks/subscription-%d", queuePath, subscriptionID),
+			Name:             fmt.Sprintf("%s/tasks/%s", queuePath, name),
+			ScheduleTime:     scheduleTime,
 			DispatchDeadline: durationpb.New(c.dispatchDeadline),
 			MessageType: &taskspb.Task_HttpRequest{
 				HttpRequest: &taskspb.HttpRequest{
@@ -146,17 +197,41 @@ func (c *TaskClient) ScheduleSubscriberNotification(ctx context.Context, subscri
 		},
 	}
 
-	if _, err := c.client.CreateTask(ctx, req); err != nil {
+	createdTask, err := c.client.CreateTask(ctx, req)
+	if err != nil {
 		if c.isTaskAlreadyExistsError(err) {
-			return models.NewResourceAlreadyExistsError(fmt.Errorf("subscriber-notification task already exists: %w", err))
+			return nil, models.NewResourceAlreadyExistsError(fmt.Errorf("%s task already exists: %w", kind, err))
 		}
 
-		return fmt.Errorf("failed to create subscriber-notification task: %w", err)
+		return nil, fmt.Errorf("failed to create %s task: %w", kind, err)
 	}
 
-	return nil
+	return &models.Task{Name: createdTask.Name, ExecuteAt: createdTask.ScheduleTime.AsTime()}, nil
+}
+
+func (c *TaskClient) getTask(ctx context.Context, queueName string, name string, kind string) (*models.Task, error) {
+	req := &taskspb.GetTaskRequest{Name: fmt.Sprintf("%s/tasks/%s", c.queuePath(queueName), name)}
+
+	task, err := c.client.GetTask(ctx, req)
+	if err != nil {
+		if c.isTaskNotFoundError(err) {
+			return nil, models.NewResourceNotFoundError(fmt.Errorf("%s task not found: %w", kind, err))
+		}
+
+		return nil, fmt.Errorf("failed to get %s task: %w", kind, err)
+	}
+
+	return &models.Task{Name: task.Name, ExecuteAt: task.ScheduleTime.AsTime()}, nil
+}
+
+func (c *TaskClient) queuePath(queueName string) string {
+	return fmt.Sprintf("projects/%s/locations/%s/queues/%s", c.config.ProjectID, c.config.Region, queueName)
 }
 
 func (c *TaskClient) isTaskAlreadyExistsError(err error) bool {
 	return strings.Contains(err.Error(), errAlreadyExists)
 }
+
+func (c *TaskClient) isTaskNotFoundError(err error) bool {
+	return strings.Contains(err.Error(), errNotFound)
+}
@@ -2,8 +2,10 @@ package handler
 
 import (
 	"context"
+	"time"
 
 	"github.com/andrewshostak/result-service/internal/app/models"
+	"github.com/rs/zerolog"
 )
 
 type AliasService interface {
@@ -12,17 +14,70 @@ type AliasService interface {
 
 type MatchService interface {
 	Create(ctx context.Context, request models.CreateMatchRequest) (uint, error)
+	Get(ctx context.Context, id uint) (*models.Match, error)
+	ListResultCheckAttempts(ctx context.Context, matchID uint) ([]models.ResultCheckAttempt, error)
 }
 
 type SubscriptionService interface {
-	Create(ctx context.Context, request models.CreateSubscriptionRequest) error
+	Create(ctx context.Context, request models.CreateSubscriptionRequest) (uint, error)
+	Get(ctx context.Context, id uint) (*models.Subscription, error)
+	List(ctx context.Context, filter models.SubscriptionFilter) ([]models.Subscription, error)
 	Delete(ctx context.Context, request models.DeleteSubscriptionRequest) error
+	DeleteByID(ctx context.Context, id uint) error
+	RotateSecretKey(ctx context.Context, request models.RotateSecretKeyRequest) error
+	ListDeliveries(ctx context.Context, subscriptionID uint) ([]models.NotificationAttempt, error)
+	Redeliver(ctx context.Context, request models.RedeliverRequest) (string, error)
+	Verify(ctx context.Context, id uint) error
+	Test(ctx context.Context, id uint) (*models.TestDeliveryResult, error)
+	TestURL(ctx context.Context, request models.TestDeliveryRequest) (*models.TestDeliveryResult, error)
+}
+
+type StandingSubscriptionService interface {
+	Create(ctx context.Context, request models.CreateStandingSubscriptionRequest) (*models.StandingSubscription, error)
+	List(ctx context.Context) ([]models.StandingSubscription, error)
+	Delete(ctx context.Context, id uint) error
+}
+
+type SubscriberHostService interface {
+	List(ctx context.Context) ([]models.SubscriberHost, error)
+	Enable(ctx context.Context, host string) (uint, error)
+}
+
+type ResultFeedService interface {
+	List(ctx context.Context, request models.ListResultEventsRequest) (*models.ResultEventsPage, error)
+}
+
+type LiveUpdateService interface {
+	Watch(ctx context.Context, request models.WatchMatchUpdatesRequest, send func([]models.MatchUpdate) error) error
 }
 
 type ResultCheckerService interface {
 	CheckResult(ctx context.Context, matchID uint) error
+	CheckLive(ctx context.Context, matchID uint, sequence uint) error
 }
 
 type SubscriberNotifierService interface {
 	NotifySubscriber(ctx context.Context, subscriptionID uint) error
+	NotifyEvent(ctx context.Context, eventDeliveryID uint) error
+	NotifyBatch(ctx context.Context, notificationBatchID uint) error
+}
+
+type KickoffReminderService interface {
+	Remind(ctx context.Context, subscriptionID uint, kickoff time.Time) error
+}
+
+type ReconcilerService interface {
+	Reconcile(ctx context.Context) (*models.ReconciliationReport, error)
+}
+
+type OutboxDispatcherService interface {
+	DispatchPending(ctx context.Context) error
+}
+
+type PlannerService interface {
+	Plan(ctx context.Context) (*models.PlanningReport, error)
+}
+
+type Logger interface {
+	Error() *zerolog.Event
 }
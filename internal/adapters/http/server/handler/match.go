@@ -45,3 +45,51 @@ func (h *MatchHandler) Create(c *gin.Context) {
 
 	c.JSON(http.StatusOK, gin.H{"match_id": result})
 }
+
+func (h *MatchHandler) Get(c *gin.Context) {
+	var params GetMatchRequest
+	if err := c.ShouldBindUri(&params); err != nil {
+		c.JSON(http.StatusBadRequest, NewErrorResponse(models.CodeInvalidRequest, err))
+
+		return
+	}
+
+	result, err := h.matchService.Get(c.Request.Context(), params.ID)
+	if errors.As(err, &models.ResourceNotFoundError{}) {
+		c.JSON(http.StatusNotFound, NewErrorResponse(models.CodeResourceNotFound, err))
+
+		return
+	}
+
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, NewErrorResponse(models.CodeInternalServerError, err))
+
+		return
+	}
+
+	c.JSON(http.StatusOK, NewMatchResponse(*result))
+}
+
+func (h *MatchHandler) ListResultCheckAttempts(c *gin.Context) {
+	var params GetMatchRequest
+	if err := c.ShouldBindUri(&params); err != nil {
+		c.JSON(http.StatusBadRequest, NewErrorResponse(models.CodeInvalidRequest, err))
+
+		return
+	}
+
+	result, err := h.matchService.ListResultCheckAttempts(c.Request.Context(), params.ID)
+	if errors.As(err, &models.ResourceNotFoundError{}) {
+		c.JSON(http.StatusNotFound, NewErrorResponse(models.CodeResourceNotFound, err))
+
+		return
+	}
+
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, NewErrorResponse(models.CodeInternalServerError, err))
+
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"attempts": NewResultCheckAttemptsResponse(result)})
+}
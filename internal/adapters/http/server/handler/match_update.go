@@ -0,0 +1,84 @@
+package handler
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/andrewshostak/result-service/internal/app/models"
+	"github.com/gin-gonic/gin"
+)
+
+const matchUpdateEvent = "match.update"
+
+type MatchUpdateHandler struct {
+	liveUpdateService LiveUpdateService
+	logger            Logger
+}
+
+func NewMatchUpdateHandler(liveUpdateService LiveUpdateService, logger Logger) *MatchUpdateHandler {
+	return &MatchUpdateHandler{liveUpdateService: liveUpdateService, logger: logger}
+}
+
+// Stream streams match updates as server-sent events. Event ids are the ids of the updates,
+// so a client reconnecting with Last-Event-ID header continues from the last update it has received.
+// The service has no tenants, so updates of all matches are streamed when match ids are not given.
+func (h *MatchUpdateHandler) Stream(c *gin.Context) {
+	var params StreamMatchUpdatesRequest
+	if err := c.ShouldBindQuery(&params); err != nil {
+		c.JSON(http.StatusBadRequest, NewErrorResponse(models.CodeInvalidRequest, err))
+
+		return
+	}
+
+	request := models.WatchMatchUpdatesRequest{MatchIDs: params.MatchIDs}
+
+	if lastEventID := c.GetHeader("Last-Event-ID"); lastEventID != "" {
+		parsed, err := strconv.ParseUint(lastEventID, 10, 64)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, NewErrorResponse(models.CodeInvalidRequest, fmt.Errorf("invalid Last-Event-ID header: %w", err)))
+
+			return
+		}
+
+		id := uint(parsed)
+		request.LastEventID = &id
+	}
+
+	c.Header("Content-Type", "text/event-stream")
+	c.Header("Cache-Control", "no-cache")
+	c.Header("Connection", "keep-alive")
+	c.Status(http.StatusOK)
+	c.Writer.Flush()
+
+	// the response is already started, so an error can only end the stream and the client reconnects
+	err := h.liveUpdateService.Watch(c.Request.Context(), request, func(updates []models.MatchUpdate) error {
+		if len(updates) == 0 {
+			if _, err := fmt.Fprint(c.Writer, ": keep-alive\n\n"); err != nil {
+				return err
+			}
+
+			c.Writer.Flush()
+			return nil
+		}
+
+		for _, update := range updates {
+			data, err := json.Marshal(NewMatchUpdateResponse(update))
+			if err != nil {
+				return fmt.Errorf("failed to marshal match update: %w", err)
+			}
+
+			if _, err := fmt.Fprintf(c.Writer, "id: %d\nevent: %s\ndata: %s\n\n", update.ID, matchUpdateEvent, data); err != nil {
+				return err
+			}
+		}
+
+		c.Writer.Flush()
+		return nil
+	})
+	// a write failing because the client has gone away is the usual end of a stream
+	if err != nil && c.Request.Context().Err() == nil {
+		h.logger.Error().Err(err).Uints("match_ids", request.MatchIDs).Msg("match updates stream failed")
+	}
+}
@@ -1,6 +1,8 @@
 package handler
 
 import (
+	"encoding/json"
+	"strconv"
 	"time"
 
 	"github.com/andrewshostak/result-service/internal/app/models"
@@ -12,10 +14,210 @@ type CreateMatchRequest struct {
 	AliasAway string    `binding:"required" json:"alias_away"`
 }
 
+type GetMatchRequest struct {
+	ID uint `uri:"id" binding:"required"`
+}
+
+type MatchResponse struct {
+	ID            uint                            `json:"id"`
+	StartsAt      time.Time                       `json:"starts_at"`
+	HomeTeamID    uint                            `json:"home_team_id"`
+	AwayTeamID    uint                            `json:"away_team_id"`
+	ResultStatus  string                          `json:"result_status"`
+	StatusHistory []MatchStatusTransitionResponse `json:"status_history"`
+}
+
+type MatchStatusTransitionResponse struct {
+	From          string    `json:"from"`
+	To            string    `json:"to"`
+	Reason        string    `json:"reason"`
+	AttemptNumber *uint     `json:"attempt_number,omitempty"`
+	CreatedAt     time.Time `json:"created_at"`
+}
+
+type ResultCheckAttemptResponse struct {
+	AttemptNumber  uint            `json:"attempt_number"`
+	ScheduledAt    *time.Time      `json:"scheduled_at,omitempty"`
+	ExecutedAt     time.Time       `json:"executed_at"`
+	ProviderStatus *string         `json:"provider_status,omitempty"`
+	HomeScore      *int            `json:"home_score,omitempty"`
+	AwayScore      *int            `json:"away_score,omitempty"`
+	Outcome        string          `json:"outcome"`
+	Error          *string         `json:"error,omitempty"`
+	Snapshot       json.RawMessage `json:"snapshot,omitempty"`
+}
+
+type GetSubscriptionRequest struct {
+	ID uint `uri:"id" binding:"required"`
+}
+
+type RedeliverRequest struct {
+	ID    uint `uri:"id" binding:"required"`
+	Force bool `form:"force"`
+}
+
+type DeliveryResponse struct {
+	DeliveryID     string          `json:"delivery_id"`
+	EventType      string          `json:"event_type"`
+	ExecutedAt     time.Time       `json:"executed_at"`
+	RequestPayload json.RawMessage `json:"request_payload,omitempty"`
+	ResponseStatus *int            `json:"response_status,omitempty"`
+	ResponseBody   *string         `json:"response_body,omitempty"`
+	LatencyMs      int64           `json:"latency_ms"`
+	Error          *string         `json:"error,omitempty"`
+}
+
 type CreateSubscriptionRequest struct {
-	MatchID   uint   `binding:"required" json:"match_id"`
-	URL       string `binding:"required" json:"url"`
-	SecretKey string `binding:"required" json:"secret_key"`
+	MatchID        uint              `binding:"required" json:"match_id"`
+	URL            string            `binding:"required" json:"url"`
+	SecretKey      string            `binding:"required" json:"secret_key"`
+	PayloadVersion string            `binding:"omitempty,oneof=v1 v2" json:"payload_version"`
+	DeliveryFormat string            `binding:"omitempty,oneof=webhook cloudevents_structured cloudevents_binary" json:"delivery_format"`
+	EventTypes     []string          `binding:"omitempty,dive,oneof=match.kickoff match.half_time match.goal result.finished match.cancelled match.rescheduled" json:"event_types"`
+	Channel        string            `binding:"omitempty,oneof=webhook telegram slack discord email" json:"channel"`
+	HTTPMethod     string            `binding:"omitempty,oneof=POST PUT PATCH" json:"http_method"`
+	Headers        map[string]string `json:"headers"`
+	AuthHeader     *string           `json:"auth_header"` // header the secret key is sent in, e.g. Authorization or X-Api-Key
+	AuthScheme     *string           `json:"auth_scheme"` // scheme preceding the secret key in the auth header, e.g. Bearer
+	BatchURL       *string           `json:"batch_url"`
+	RemindBefore   *uint             `binding:"omitempty,min=1,max=10080" json:"remind_before"` // minutes before the kickoff
+	Verify         bool              `json:"verify"`                                            // the endpoint has to echo a verification challenge before it is notified
+}
+
+type TestDeliveryRequest struct {
+	URL            string            `binding:"required" json:"url"`
+	SecretKey      string            `binding:"required" json:"secret_key"`
+	PayloadVersion string            `binding:"omitempty,oneof=v1 v2" json:"payload_version"`
+	DeliveryFormat string            `binding:"omitempty,oneof=webhook cloudevents_structured cloudevents_binary" json:"delivery_format"`
+	Channel        string            `binding:"omitempty,oneof=webhook telegram slack discord email" json:"channel"`
+	HTTPMethod     string            `binding:"omitempty,oneof=POST PUT PATCH" json:"http_method"`
+	Headers        map[string]string `json:"headers"`
+	AuthHeader     *string           `json:"auth_header"`
+	AuthScheme     *string           `json:"auth_scheme"`
+}
+
+type TestDeliveryResponse struct {
+	DeliveryID     string  `json:"delivery_id"`
+	ResponseStatus *int    `json:"response_status,omitempty"`
+	ResponseBody   *string `json:"response_body,omitempty"`
+	LatencyMs      int64   `json:"latency_ms"`
+	Error          *string `json:"error,omitempty"`
+}
+
+type CreateStandingSubscriptionRequest struct {
+	Alias          *string        `binding:"required_without=League" json:"alias"`
+	League         *LeagueRequest `json:"league"`
+	URLTemplate    string         `binding:"required" json:"url_template"`
+	SecretKey      string         `binding:"required" json:"secret_key"`
+	PayloadVersion string         `binding:"omitempty,oneof=v1 v2" json:"payload_version"`
+	DeliveryFormat string         `binding:"omitempty,oneof=webhook cloudevents_structured cloudevents_binary" json:"delivery_format"`
+	AuthHeader     *string        `json:"auth_header"`
+	AuthScheme     *string        `json:"auth_scheme"`
+}
+
+type LeagueRequest struct {
+	Name        string `binding:"required" json:"name"`
+	CountryCode string `binding:"required" json:"country_code"`
+}
+
+type GetStandingSubscriptionRequest struct {
+	ID uint `uri:"id" binding:"required"`
+}
+
+type StandingSubscriptionResponse struct {
+	ID             uint            `json:"id"`
+	TeamID         *uint           `json:"team_id,omitempty"`
+	League         *LeagueResponse `json:"league,omitempty"`
+	URLTemplate    string          `json:"url_template"`
+	PayloadVersion string          `json:"payload_version"`
+	DeliveryFormat string          `json:"delivery_format"`
+	AuthHeader     *string         `json:"auth_header,omitempty"`
+	AuthScheme     *string         `json:"auth_scheme,omitempty"`
+	CreatedAt      time.Time       `json:"created_at"`
+}
+
+type LeagueResponse struct {
+	Name        string `json:"name"`
+	CountryCode string `json:"country_code"`
+}
+
+type GetSubscriberHostRequest struct {
+	Host string `uri:"host" binding:"required"`
+}
+
+type SubscriberHostResponse struct {
+	Host                string     `json:"host"`
+	ConsecutiveFailures uint       `json:"consecutive_failures"`
+	LastError           *string    `json:"last_error,omitempty"`
+	LastFailureAt       *time.Time `json:"last_failure_at,omitempty"`
+	LastSuccessAt       *time.Time `json:"last_success_at,omitempty"`
+	SuspendedAt         *time.Time `json:"suspended_at,omitempty"`
+}
+
+type ListResultsRequest struct {
+	Since uint `form:"since"`
+	Limit uint `form:"limit" binding:"omitempty,max=500"`
+	Wait  uint `form:"wait" binding:"omitempty,max=300"` // seconds to wait for new events when there are none after the cursor
+}
+
+type ResultEventResponse struct {
+	ID         uint          `json:"id"`
+	EventType  string        `json:"event_type"`
+	MatchID    uint          `json:"match_id"`
+	HomeTeamID uint          `json:"home_team_id"`
+	AwayTeamID uint          `json:"away_team_id"`
+	Kickoff    time.Time     `json:"kickoff"`
+	Score      ScoreResponse `json:"score"`
+	FinishType *string       `json:"finish_type"`
+	CreatedAt  time.Time     `json:"created_at"`
+}
+
+type ScoreResponse struct {
+	Home int `json:"home"`
+	Away int `json:"away"`
+}
+
+type ResultEventsResponse struct {
+	Events     []ResultEventResponse `json:"events"`
+	NextCursor string                `json:"next_cursor"`
+}
+
+type StreamMatchUpdatesRequest struct {
+	MatchIDs []uint `form:"match_id"` // all matches when empty
+}
+
+type MatchUpdateResponse struct {
+	ID         uint          `json:"id"`
+	MatchID    uint          `json:"match_id"`
+	Status     string        `json:"status"`
+	Score      ScoreResponse `json:"score"`
+	ObservedAt time.Time     `json:"observed_at"`
+}
+
+type ListSubscriptionsRequest struct {
+	MatchID   *uint   `form:"match_id"`
+	Status    *string `form:"status" binding:"omitempty,oneof=pending scheduling_error successful subscriber_error match_cancelled match_failed dead_letter unverified suspended unsubscribed"`
+	URLPrefix string  `form:"url_prefix"`
+}
+
+type SubscriptionResponse struct {
+	ID               uint       `json:"id"`
+	MatchID          uint       `json:"match_id"`
+	URL              string     `json:"url"`
+	Status           string     `json:"status"`
+	PayloadVersion   string     `json:"payload_version"`
+	DeliveryFormat   string     `json:"delivery_format"`
+	EventTypes       []string   `json:"event_types"`
+	Channel          string     `json:"channel"`
+	HTTPMethod       string     `json:"http_method,omitempty"`
+	AuthHeader       *string    `json:"auth_header,omitempty"`
+	AuthScheme       *string    `json:"auth_scheme,omitempty"`
+	BatchURL         *string    `json:"batch_url,omitempty"`
+	RemindBefore     *uint      `json:"remind_before,omitempty"`
+	DeliveryAttempts uint       `json:"delivery_attempts"`
+	SubscriberError  *string    `json:"subscriber_error,omitempty"`
+	NotifiedAt       *time.Time `json:"notified_at,omitempty"`
+	CreatedAt        time.Time  `json:"created_at"`
 }
 
 type DeleteSubscriptionRequest struct {
@@ -26,6 +228,11 @@ type DeleteSubscriptionRequest struct {
 	SecretKey string    `form:"secret_key" binding:"required"`
 }
 
+type RotateSecretKeyRequest struct {
+	SecretKey    string `binding:"required" json:"secret_key"`
+	NewSecretKey string `binding:"required" json:"new_secret_key"`
+}
+
 type SearchAliasRequest struct {
 	Search string `form:"search" binding:"required"`
 }
@@ -34,10 +241,57 @@ type TriggerResultCheckRequest struct {
 	MatchID uint `json:"match_id" binding:"required"`
 }
 
+type TriggerLiveCheckRequest struct {
+	MatchID  uint `json:"match_id" binding:"required"`
+	Sequence uint `json:"sequence" binding:"required"`
+}
+
 type TriggerSubscriptionNotificationRequest struct {
 	SubscriptionID uint `json:"subscription_id" binding:"required"`
 }
 
+type TriggerEventDeliveryRequest struct {
+	EventDeliveryID uint `json:"event_delivery_id" binding:"required"`
+}
+
+type TriggerKickoffReminderRequest struct {
+	SubscriptionID uint  `json:"subscription_id" binding:"required"`
+	Kickoff        int64 `json:"kickoff" binding:"required"` // unix time of the kickoff the reminder was scheduled for
+}
+
+type TriggerNotificationBatchRequest struct {
+	NotificationBatchID uint `json:"notification_batch_id" binding:"required"`
+}
+
+type ReconciliationReportResponse struct {
+	StartedAt  time.Time                    `json:"started_at"`
+	FinishedAt time.Time                    `json:"finished_at"`
+	Items      []ReconciliationItemResponse `json:"items"`
+}
+
+type ReconciliationItemResponse struct {
+	MatchID         uint    `json:"match_id"`
+	SubscriptionID  *uint   `json:"subscription_id,omitempty"`
+	EventDeliveryID *uint   `json:"event_delivery_id,omitempty"`
+	Issue           string  `json:"issue"`
+	Action          string  `json:"action"`
+	Error           *string `json:"error,omitempty"`
+}
+
+type PlanningReportResponse struct {
+	StartedAt  time.Time              `json:"started_at"`
+	FinishedAt time.Time              `json:"finished_at"`
+	Items      []PlanningItemResponse `json:"items"`
+}
+
+type PlanningItemResponse struct {
+	StandingSubscriptionID uint    `json:"standing_subscription_id"`
+	ExternalMatchID        uint    `json:"external_match_id"`
+	MatchID                *uint   `json:"match_id,omitempty"`
+	SubscriptionID         *uint   `json:"subscription_id,omitempty"`
+	Error                  *string `json:"error,omitempty"`
+}
+
 type ErrorResponse struct {
 	Code  string `json:"code"`
 	Error string `json:"error"`
@@ -50,6 +304,248 @@ func NewErrorResponse(code models.Code, error error) ErrorResponse {
 	}
 }
 
+func NewMatchResponse(match models.Match) MatchResponse {
+	history := make([]MatchStatusTransitionResponse, 0, len(match.StatusHistory))
+	for _, transition := range match.StatusHistory {
+		history = append(history, MatchStatusTransitionResponse{
+			From:          string(transition.From),
+			To:            string(transition.To),
+			Reason:        transition.Reason,
+			AttemptNumber: transition.AttemptNumber,
+			CreatedAt:     transition.CreatedAt,
+		})
+	}
+
+	return MatchResponse{
+		ID:            match.ID,
+		StartsAt:      match.StartsAt,
+		HomeTeamID:    match.HomeTeamID,
+		AwayTeamID:    match.AwayTeamID,
+		ResultStatus:  string(match.ResultStatus),
+		StatusHistory: history,
+	}
+}
+
+func NewResultCheckAttemptsResponse(attempts []models.ResultCheckAttempt) []ResultCheckAttemptResponse {
+	response := make([]ResultCheckAttemptResponse, 0, len(attempts))
+	for _, attempt := range attempts {
+		var providerStatus *string
+		if attempt.ProviderStatus != nil {
+			status := string(*attempt.ProviderStatus)
+			providerStatus = &status
+		}
+
+		response = append(response, ResultCheckAttemptResponse{
+			AttemptNumber:  attempt.AttemptNumber,
+			ScheduledAt:    attempt.ScheduledAt,
+			ExecutedAt:     attempt.ExecutedAt,
+			ProviderStatus: providerStatus,
+			HomeScore:      attempt.HomeScore,
+			AwayScore:      attempt.AwayScore,
+			Outcome:        string(attempt.Outcome),
+			Error:          attempt.ErrorMessage,
+			Snapshot:       attempt.Snapshot,
+		})
+	}
+
+	return response
+}
+
+func NewDeliveriesResponse(attempts []models.NotificationAttempt) []DeliveryResponse {
+	response := make([]DeliveryResponse, 0, len(attempts))
+	for _, attempt := range attempts {
+		response = append(response, DeliveryResponse{
+			DeliveryID:     attempt.DeliveryID,
+			EventType:      string(attempt.EventType),
+			ExecutedAt:     attempt.ExecutedAt,
+			RequestPayload: attempt.RequestPayload,
+			ResponseStatus: attempt.ResponseStatus,
+			ResponseBody:   attempt.ResponseBody,
+			LatencyMs:      attempt.Latency.Milliseconds(),
+			Error:          attempt.ErrorMessage,
+		})
+	}
+
+	return response
+}
+
+func NewTestDeliveryResponse(result models.TestDeliveryResult) TestDeliveryResponse {
+	return TestDeliveryResponse{
+		DeliveryID:     result.DeliveryID,
+		ResponseStatus: result.StatusCode,
+		ResponseBody:   result.Body,
+		LatencyMs:      result.Latency.Milliseconds(),
+		Error:          result.Error,
+	}
+}
+
+func NewReconciliationReportResponse(report models.ReconciliationReport) ReconciliationReportResponse {
+	items := make([]ReconciliationItemResponse, 0, len(report.Items))
+	for _, item := range report.Items {
+		items = append(items, ReconciliationItemResponse{
+			MatchID:         item.MatchID,
+			SubscriptionID:  item.SubscriptionID,
+			EventDeliveryID: item.EventDeliveryID,
+			Issue:           string(item.Issue),
+			Action:          string(item.Action),
+			Error:           item.Error,
+		})
+	}
+
+	return ReconciliationReportResponse{
+		StartedAt:  report.StartedAt,
+		FinishedAt: report.FinishedAt,
+		Items:      items,
+	}
+}
+
+func NewSubscriptionResponse(subscription models.Subscription) SubscriptionResponse {
+	eventTypes := make([]string, 0, len(subscription.EventTypes))
+	for _, eventType := range subscription.EventTypes {
+		eventTypes = append(eventTypes, string(eventType))
+	}
+
+	// method is stored for every subscription, but only webhooks are sent with it
+	var httpMethod string
+	if subscription.Channel == models.ChannelWebhook {
+		httpMethod = subscription.HTTPMethod
+	}
+
+	return SubscriptionResponse{
+		ID:               subscription.ID,
+		MatchID:          subscription.MatchID,
+		URL:              subscription.Url,
+		Status:           string(subscription.Status),
+		PayloadVersion:   string(subscription.PayloadVersion),
+		DeliveryFormat:   string(subscription.DeliveryFormat),
+		EventTypes:       eventTypes,
+		Channel:          string(subscription.Channel),
+		HTTPMethod:       httpMethod,
+		AuthHeader:       subscription.AuthHeader,
+		AuthScheme:       subscription.AuthScheme,
+		BatchURL:         subscription.BatchURL,
+		RemindBefore:     subscription.RemindBefore,
+		DeliveryAttempts: subscription.DeliveryAttempts,
+		SubscriberError:  subscription.SubscriberError,
+		NotifiedAt:       subscription.NotifiedAt,
+		CreatedAt:        subscription.CreatedAt,
+	}
+}
+
+func NewSubscriptionsResponse(subscriptions []models.Subscription) []SubscriptionResponse {
+	response := make([]SubscriptionResponse, 0, len(subscriptions))
+	for _, subscription := range subscriptions {
+		response = append(response, NewSubscriptionResponse(subscription))
+	}
+
+	return response
+}
+
+func NewStandingSubscriptionResponse(subscription models.StandingSubscription) StandingSubscriptionResponse {
+	var league *LeagueResponse
+	if subscription.League != nil {
+		league = &LeagueResponse{Name: subscription.League.Name, CountryCode: subscription.League.CountryCode}
+	}
+
+	return StandingSubscriptionResponse{
+		ID:             subscription.ID,
+		TeamID:         subscription.TeamID,
+		League:         league,
+		URLTemplate:    subscription.URLTemplate,
+		PayloadVersion: string(subscription.PayloadVersion),
+		DeliveryFormat: string(subscription.DeliveryFormat),
+		AuthHeader:     subscription.AuthHeader,
+		AuthScheme:     subscription.AuthScheme,
+		CreatedAt:      subscription.CreatedAt,
+	}
+}
+
+func NewStandingSubscriptionsResponse(subscriptions []models.StandingSubscription) []StandingSubscriptionResponse {
+	response := make([]StandingSubscriptionResponse, 0, len(subscriptions))
+	for _, subscription := range subscriptions {
+		response = append(response, NewStandingSubscriptionResponse(subscription))
+	}
+
+	return response
+}
+
+func NewSubscriberHostsResponse(hosts []models.SubscriberHost) []SubscriberHostResponse {
+	response := make([]SubscriberHostResponse, 0, len(hosts))
+	for _, host := range hosts {
+		response = append(response, SubscriberHostResponse{
+			Host:                host.Host,
+			ConsecutiveFailures: host.ConsecutiveFailures,
+			LastError:           host.LastError,
+			LastFailureAt:       host.LastFailureAt,
+			LastSuccessAt:       host.LastSuccessAt,
+			SuspendedAt:         host.SuspendedAt,
+		})
+	}
+
+	return response
+}
+
+func NewResultEventsResponse(page models.ResultEventsPage) ResultEventsResponse {
+	events := make([]ResultEventResponse, 0, len(page.Events))
+	for _, event := range page.Events {
+		var finishType *string
+		if event.FinishType != nil {
+			mapped := string(*event.FinishType)
+			finishType = &mapped
+		}
+
+		events = append(events, ResultEventResponse{
+			ID:         event.ID,
+			EventType:  string(event.Type),
+			MatchID:    event.MatchID,
+			HomeTeamID: event.HomeTeamID,
+			AwayTeamID: event.AwayTeamID,
+			Kickoff:    event.StartsAt,
+			Score:      ScoreResponse{Home: event.HomeScore, Away: event.AwayScore},
+			FinishType: finishType,
+			CreatedAt:  event.CreatedAt,
+		})
+	}
+
+	return ResultEventsResponse{Events: events, NextCursor: strconv.FormatUint(uint64(page.NextCursor), 10)}
+}
+
+func NewMatchUpdateResponse(update models.MatchUpdate) MatchUpdateResponse {
+	return MatchUpdateResponse{
+		ID:         update.ID,
+		MatchID:    update.MatchID,
+		Status:     string(update.Status),
+		Score:      ScoreResponse{Home: update.HomeScore, Away: update.AwayScore},
+		ObservedAt: update.ObservedAt,
+	}
+}
+
+func NewPlanningReportResponse(report models.PlanningReport) PlanningReportResponse {
+	items := make([]PlanningItemResponse, 0, len(report.Items))
+	for _, item := range report.Items {
+		items = append(items, PlanningItemResponse{
+			StandingSubscriptionID: item.StandingSubscriptionID,
+			ExternalMatchID:        item.ExternalMatchID,
+			MatchID:                item.MatchID,
+			SubscriptionID:         item.SubscriptionID,
+			Error:                  item.Error,
+		})
+	}
+
+	return PlanningReportResponse{
+		StartedAt:  report.StartedAt,
+		FinishedAt: report.FinishedAt,
+		Items:      items,
+	}
+}
+
+func (rr *RedeliverRequest) ToDomain() models.RedeliverRequest {
+	return models.RedeliverRequest{
+		SubscriptionID: rr.ID,
+		Force:          rr.Force,
+	}
+}
+
 func (cmr *CreateMatchRequest) ToDomain() models.CreateMatchRequest {
 	return models.CreateMatchRequest{
 		StartsAt:  cmr.StartsAt,
@@ -59,10 +555,85 @@ func (cmr *CreateMatchRequest) ToDomain() models.CreateMatchRequest {
 }
 
 func (csr *CreateSubscriptionRequest) ToDomain() models.CreateSubscriptionRequest {
+	eventTypes := make([]models.NotificationEventType, 0, len(csr.EventTypes))
+	for _, eventType := range csr.EventTypes {
+		eventTypes = append(eventTypes, models.NotificationEventType(eventType))
+	}
+
 	return models.CreateSubscriptionRequest{
-		MatchID:   csr.MatchID,
-		URL:       csr.URL,
-		SecretKey: csr.SecretKey,
+		MatchID:        csr.MatchID,
+		URL:            csr.URL,
+		SecretKey:      csr.SecretKey,
+		PayloadVersion: models.PayloadVersion(csr.PayloadVersion),
+		DeliveryFormat: models.DeliveryFormat(csr.DeliveryFormat),
+		EventTypes:     eventTypes,
+		Channel:        models.NotificationChannel(csr.Channel),
+		HTTPMethod:     csr.HTTPMethod,
+		Headers:        csr.Headers,
+		AuthHeader:     csr.AuthHeader,
+		AuthScheme:     csr.AuthScheme,
+		BatchURL:       csr.BatchURL,
+		RemindBefore:   csr.RemindBefore,
+		Verify:         csr.Verify,
+	}
+}
+
+func (tdr *TestDeliveryRequest) ToDomain() models.TestDeliveryRequest {
+	return models.TestDeliveryRequest{
+		URL:            tdr.URL,
+		SecretKey:      tdr.SecretKey,
+		PayloadVersion: models.PayloadVersion(tdr.PayloadVersion),
+		DeliveryFormat: models.DeliveryFormat(tdr.DeliveryFormat),
+		Channel:        models.NotificationChannel(tdr.Channel),
+		HTTPMethod:     tdr.HTTPMethod,
+		Headers:        tdr.Headers,
+		AuthHeader:     tdr.AuthHeader,
+		AuthScheme:     tdr.AuthScheme,
+	}
+}
+
+func (cssr *CreateStandingSubscriptionRequest) ToDomain() models.CreateStandingSubscriptionRequest {
+	var league *models.League
+	if cssr.League != nil {
+		league = &models.League{Name: cssr.League.Name, CountryCode: cssr.League.CountryCode}
+	}
+
+	return models.CreateStandingSubscriptionRequest{
+		Alias:          cssr.Alias,
+		League:         league,
+		URLTemplate:    cssr.URLTemplate,
+		SecretKey:      cssr.SecretKey,
+		PayloadVersion: models.PayloadVersion(cssr.PayloadVersion),
+		DeliveryFormat: models.DeliveryFormat(cssr.DeliveryFormat),
+		AuthHeader:     cssr.AuthHeader,
+		AuthScheme:     cssr.AuthScheme,
+	}
+}
+
+func (lrr *ListResultsRequest) ToDomain() models.ListResultEventsRequest {
+	limit := lrr.Limit
+	if limit == 0 {
+		limit = defaultResultsLimit
+	}
+
+	return models.ListResultEventsRequest{
+		Since: lrr.Since,
+		Limit: limit,
+		Wait:  time.Duration(lrr.Wait) * time.Second,
+	}
+}
+
+func (lsr *ListSubscriptionsRequest) ToDomain() models.SubscriptionFilter {
+	var status *models.SubscriptionStatus
+	if lsr.Status != nil {
+		s := models.SubscriptionStatus(*lsr.Status)
+		status = &s
+	}
+
+	return models.SubscriptionFilter{
+		MatchID:   lsr.MatchID,
+		Status:    status,
+		URLPrefix: lsr.URLPrefix,
 	}
 }
 
@@ -75,3 +646,10 @@ func (dsr *DeleteSubscriptionRequest) ToDomain() models.DeleteSubscriptionReques
 		SecretKey: dsr.SecretKey,
 	}
 }
+
+func (rskr *RotateSecretKeyRequest) ToDomain() models.RotateSecretKeyRequest {
+	return models.RotateSecretKeyRequest{
+		SecretKey:    rskr.SecretKey,
+		NewSecretKey: rskr.NewSecretKey,
+	}
+}
@@ -0,0 +1,49 @@
+package handler
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/andrewshostak/result-service/internal/app/models"
+	"github.com/gin-gonic/gin"
+)
+
+const defaultResultsLimit = 100
+
+type ResultHandler struct {
+	resultFeedService ResultFeedService
+}
+
+func NewResultHandler(resultFeedService ResultFeedService) *ResultHandler {
+	return &ResultHandler{resultFeedService: resultFeedService}
+}
+
+// List returns the result events after the cursor. The page after a cursor changes only when new events appear,
+// so the next cursor is the ETag of the page and a consumer which has seen it gets 304.
+func (h *ResultHandler) List(c *gin.Context) {
+	var params ListResultsRequest
+	if err := c.ShouldBindQuery(&params); err != nil {
+		c.JSON(http.StatusBadRequest, NewErrorResponse(models.CodeInvalidRequest, err))
+
+		return
+	}
+
+	page, err := h.resultFeedService.List(c.Request.Context(), params.ToDomain())
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, NewErrorResponse(models.CodeInternalServerError, err))
+
+		return
+	}
+
+	etag := fmt.Sprintf(`"%d"`, page.NextCursor)
+	c.Header("ETag", etag)
+	c.Header("Cache-Control", "no-cache")
+
+	if c.GetHeader("If-None-Match") == etag {
+		c.Status(http.StatusNotModified)
+
+		return
+	}
+
+	c.JSON(http.StatusOK, NewResultEventsResponse(*page))
+}
@@ -0,0 +1,88 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/andrewshostak/result-service/internal/app/models"
+	"github.com/gin-gonic/gin"
+)
+
+type StandingSubscriptionHandler struct {
+	standingSubscriptionService StandingSubscriptionService
+}
+
+func NewStandingSubscriptionHandler(standingSubscriptionService StandingSubscriptionService) *StandingSubscriptionHandler {
+	return &StandingSubscriptionHandler{standingSubscriptionService: standingSubscriptionService}
+}
+
+func (h *StandingSubscriptionHandler) Create(c *gin.Context) {
+	var params CreateStandingSubscriptionRequest
+	if err := c.ShouldBindJSON(&params); err != nil {
+		c.JSON(http.StatusBadRequest, NewErrorResponse(models.CodeInvalidRequest, err))
+
+		return
+	}
+
+	result, err := h.standingSubscriptionService.Create(c.Request.Context(), params.ToDomain())
+	if errors.As(err, &models.ResourceNotFoundError{}) {
+		c.JSON(http.StatusBadRequest, NewErrorResponse(models.CodeResourceNotFound, err))
+
+		return
+	}
+
+	if errors.As(err, &models.ResourceAlreadyExistsError{}) {
+		c.JSON(http.StatusConflict, NewErrorResponse(models.CodeResourceAlreadyExists, err))
+
+		return
+	}
+
+	if errors.As(err, &models.UnprocessableContentError{}) {
+		c.JSON(http.StatusUnprocessableEntity, NewErrorResponse(models.CodeUnprocessableContent, err))
+
+		return
+	}
+
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, NewErrorResponse(models.CodeInternalServerError, err))
+
+		return
+	}
+
+	c.JSON(http.StatusCreated, NewStandingSubscriptionResponse(*result))
+}
+
+func (h *StandingSubscriptionHandler) List(c *gin.Context) {
+	result, err := h.standingSubscriptionService.List(c.Request.Context())
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, NewErrorResponse(models.CodeInternalServerError, err))
+
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"standing_subscriptions": NewStandingSubscriptionsResponse(result)})
+}
+
+func (h *StandingSubscriptionHandler) Delete(c *gin.Context) {
+	var params GetStandingSubscriptionRequest
+	if err := c.ShouldBindUri(&params); err != nil {
+		c.JSON(http.StatusBadRequest, NewErrorResponse(models.CodeInvalidRequest, err))
+
+		return
+	}
+
+	err := h.standingSubscriptionService.Delete(c.Request.Context(), params.ID)
+	if errors.As(err, &models.ResourceNotFoundError{}) {
+		c.JSON(http.StatusNotFound, NewErrorResponse(models.CodeResourceNotFound, err))
+
+		return
+	}
+
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, NewErrorResponse(models.CodeInternalServerError, err))
+
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
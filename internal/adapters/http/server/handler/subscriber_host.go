@@ -0,0 +1,52 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/andrewshostak/result-service/internal/app/models"
+	"github.com/gin-gonic/gin"
+)
+
+type SubscriberHostHandler struct {
+	subscriberHostService SubscriberHostService
+}
+
+func NewSubscriberHostHandler(subscriberHostService SubscriberHostService) *SubscriberHostHandler {
+	return &SubscriberHostHandler{subscriberHostService: subscriberHostService}
+}
+
+func (h *SubscriberHostHandler) List(c *gin.Context) {
+	result, err := h.subscriberHostService.List(c.Request.Context())
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, NewErrorResponse(models.CodeInternalServerError, err))
+
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"subscriber_hosts": NewSubscriberHostsResponse(result)})
+}
+
+func (h *SubscriberHostHandler) Enable(c *gin.Context) {
+	var params GetSubscriberHostRequest
+	if err := c.ShouldBindUri(&params); err != nil {
+		c.JSON(http.StatusBadRequest, NewErrorResponse(models.CodeInvalidRequest, err))
+
+		return
+	}
+
+	result, err := h.subscriberHostService.Enable(c.Request.Context(), params.Host)
+	if errors.As(err, &models.ResourceNotFoundError{}) {
+		c.JSON(http.StatusNotFound, NewErrorResponse(models.CodeResourceNotFound, err))
+
+		return
+	}
+
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, NewErrorResponse(models.CodeInternalServerError, err))
+
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"enabled_subscriptions": result})
+}
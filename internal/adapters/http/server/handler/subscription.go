@@ -24,13 +24,91 @@ func (h *SubscriptionHandler) Create(c *gin.Context) {
 		return
 	}
 
-	err := h.subscriptionService.Create(c.Request.Context(), params.ToDomain())
+	result, err := h.subscriptionService.Create(c.Request.Context(), params.ToDomain())
 	if errors.As(err, &models.ResourceNotFoundError{}) {
 		c.JSON(http.StatusBadRequest, NewErrorResponse(models.CodeResourceNotFound, err))
 
 		return
 	}
 
+	if errors.As(err, &models.ResourceAlreadyExistsError{}) {
+		c.JSON(http.StatusConflict, NewErrorResponse(models.CodeResourceAlreadyExists, err))
+
+		return
+	}
+
+	if errors.As(err, &models.UnprocessableContentError{}) {
+		c.JSON(http.StatusUnprocessableEntity, NewErrorResponse(models.CodeUnprocessableContent, err))
+
+		return
+	}
+
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, NewErrorResponse(models.CodeInternalServerError, err))
+
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"subscription_id": result})
+}
+
+func (h *SubscriptionHandler) List(c *gin.Context) {
+	var params ListSubscriptionsRequest
+	if err := c.ShouldBindQuery(&params); err != nil {
+		c.JSON(http.StatusBadRequest, NewErrorResponse(models.CodeInvalidRequest, err))
+
+		return
+	}
+
+	result, err := h.subscriptionService.List(c.Request.Context(), params.ToDomain())
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, NewErrorResponse(models.CodeInternalServerError, err))
+
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"subscriptions": NewSubscriptionsResponse(result)})
+}
+
+func (h *SubscriptionHandler) Get(c *gin.Context) {
+	var params GetSubscriptionRequest
+	if err := c.ShouldBindUri(&params); err != nil {
+		c.JSON(http.StatusBadRequest, NewErrorResponse(models.CodeInvalidRequest, err))
+
+		return
+	}
+
+	result, err := h.subscriptionService.Get(c.Request.Context(), params.ID)
+	if errors.As(err, &models.ResourceNotFoundError{}) {
+		c.JSON(http.StatusNotFound, NewErrorResponse(models.CodeResourceNotFound, err))
+
+		return
+	}
+
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, NewErrorResponse(models.CodeInternalServerError, err))
+
+		return
+	}
+
+	c.JSON(http.StatusOK, NewSubscriptionResponse(*result))
+}
+
+func (h *SubscriptionHandler) DeleteByID(c *gin.Context) {
+	var params GetSubscriptionRequest
+	if err := c.ShouldBindUri(&params); err != nil {
+		c.JSON(http.StatusBadRequest, NewErrorResponse(models.CodeInvalidRequest, err))
+
+		return
+	}
+
+	err := h.subscriptionService.DeleteByID(c.Request.Context(), params.ID)
+	if errors.As(err, &models.ResourceNotFoundError{}) {
+		c.JSON(http.StatusNotFound, NewErrorResponse(models.CodeResourceNotFound, err))
+
+		return
+	}
+
 	if errors.As(err, &models.UnprocessableContentError{}) {
 		c.JSON(http.StatusUnprocessableEntity, NewErrorResponse(models.CodeUnprocessableContent, err))
 
@@ -55,7 +133,127 @@ func (h *SubscriptionHandler) Delete(c *gin.Context) {
 
 	err := h.subscriptionService.Delete(c.Request.Context(), params.ToDomain())
 	if errors.As(err, &models.ResourceNotFoundError{}) {
-		c.JSON(http.StatusBadRequest, NewErrorResponse(models.CodeResourceNotFound, err))
+		c.JSON(http.StatusNotFound, NewErrorResponse(models.CodeResourceNotFound, err))
+
+		return
+	}
+
+	if errors.As(err, &models.UnprocessableContentError{}) {
+		c.JSON(http.StatusUnprocessableEntity, NewErrorResponse(models.CodeUnprocessableContent, err))
+
+		return
+	}
+
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, NewErrorResponse(models.CodeInternalServerError, err))
+
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+func (h *SubscriptionHandler) RotateSecretKey(c *gin.Context) {
+	var params RotateSecretKeyRequest
+	if err := c.ShouldBindJSON(&params); err != nil {
+		c.JSON(http.StatusBadRequest, NewErrorResponse(models.CodeInvalidRequest, err))
+
+		return
+	}
+
+	err := h.subscriptionService.RotateSecretKey(c.Request.Context(), params.ToDomain())
+	if errors.As(err, &models.ResourceNotFoundError{}) {
+		c.JSON(http.StatusNotFound, NewErrorResponse(models.CodeResourceNotFound, err))
+
+		return
+	}
+
+	if errors.As(err, &models.UnprocessableContentError{}) {
+		c.JSON(http.StatusUnprocessableEntity, NewErrorResponse(models.CodeUnprocessableContent, err))
+
+		return
+	}
+
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, NewErrorResponse(models.CodeInternalServerError, err))
+
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+func (h *SubscriptionHandler) ListDeliveries(c *gin.Context) {
+	var params GetSubscriptionRequest
+	if err := c.ShouldBindUri(&params); err != nil {
+		c.JSON(http.StatusBadRequest, NewErrorResponse(models.CodeInvalidRequest, err))
+
+		return
+	}
+
+	result, err := h.subscriptionService.ListDeliveries(c.Request.Context(), params.ID)
+	if errors.As(err, &models.ResourceNotFoundError{}) {
+		c.JSON(http.StatusNotFound, NewErrorResponse(models.CodeResourceNotFound, err))
+
+		return
+	}
+
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, NewErrorResponse(models.CodeInternalServerError, err))
+
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"deliveries": NewDeliveriesResponse(result)})
+}
+
+func (h *SubscriptionHandler) Redeliver(c *gin.Context) {
+	var params RedeliverRequest
+	if err := c.ShouldBindUri(&params); err != nil {
+		c.JSON(http.StatusBadRequest, NewErrorResponse(models.CodeInvalidRequest, err))
+
+		return
+	}
+
+	if err := c.ShouldBindQuery(&params); err != nil {
+		c.JSON(http.StatusBadRequest, NewErrorResponse(models.CodeInvalidRequest, err))
+
+		return
+	}
+
+	result, err := h.subscriptionService.Redeliver(c.Request.Context(), params.ToDomain())
+	if errors.As(err, &models.ResourceNotFoundError{}) {
+		c.JSON(http.StatusNotFound, NewErrorResponse(models.CodeResourceNotFound, err))
+
+		return
+	}
+
+	if errors.As(err, &models.UnprocessableContentError{}) {
+		c.JSON(http.StatusUnprocessableEntity, NewErrorResponse(models.CodeUnprocessableContent, err))
+
+		return
+	}
+
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, NewErrorResponse(models.CodeInternalServerError, err))
+
+		return
+	}
+
+	c.JSON(http.StatusAccepted, gin.H{"redelivery_id": result})
+}
+
+func (h *SubscriptionHandler) Verify(c *gin.Context) {
+	var params GetSubscriptionRequest
+	if err := c.ShouldBindUri(&params); err != nil {
+		c.JSON(http.StatusBadRequest, NewErrorResponse(models.CodeInvalidRequest, err))
+
+		return
+	}
+
+	err := h.subscriptionService.Verify(c.Request.Context(), params.ID)
+	if errors.As(err, &models.ResourceNotFoundError{}) {
+		c.JSON(http.StatusNotFound, NewErrorResponse(models.CodeResourceNotFound, err))
 
 		return
 	}
@@ -74,3 +272,51 @@ func (h *SubscriptionHandler) Delete(c *gin.Context) {
 
 	c.Status(http.StatusNoContent)
 }
+
+func (h *SubscriptionHandler) Test(c *gin.Context) {
+	var params GetSubscriptionRequest
+	if err := c.ShouldBindUri(&params); err != nil {
+		c.JSON(http.StatusBadRequest, NewErrorResponse(models.CodeInvalidRequest, err))
+
+		return
+	}
+
+	result, err := h.subscriptionService.Test(c.Request.Context(), params.ID)
+	if errors.As(err, &models.ResourceNotFoundError{}) {
+		c.JSON(http.StatusNotFound, NewErrorResponse(models.CodeResourceNotFound, err))
+
+		return
+	}
+
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, NewErrorResponse(models.CodeInternalServerError, err))
+
+		return
+	}
+
+	c.JSON(http.StatusOK, NewTestDeliveryResponse(*result))
+}
+
+func (h *SubscriptionHandler) TestURL(c *gin.Context) {
+	var params TestDeliveryRequest
+	if err := c.ShouldBindJSON(&params); err != nil {
+		c.JSON(http.StatusBadRequest, NewErrorResponse(models.CodeInvalidRequest, err))
+
+		return
+	}
+
+	result, err := h.subscriptionService.TestURL(c.Request.Context(), params.ToDomain())
+	if errors.As(err, &models.UnprocessableContentError{}) {
+		c.JSON(http.StatusUnprocessableEntity, NewErrorResponse(models.CodeUnprocessableContent, err))
+
+		return
+	}
+
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, NewErrorResponse(models.CodeInternalServerError, err))
+
+		return
+	}
+
+	c.JSON(http.StatusOK, NewTestDeliveryResponse(*result))
+}
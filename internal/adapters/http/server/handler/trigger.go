@@ -3,6 +3,7 @@ package handler
 import (
 	"errors"
 	"net/http"
+	"time"
 
 	"github.com/andrewshostak/result-service/internal/app/models"
 	"github.com/gin-gonic/gin"
@@ -11,12 +12,27 @@ import (
 type TriggerHandler struct {
 	checkResultService        ResultCheckerService
 	subscriberNotifierService SubscriberNotifierService
+	kickoffReminderService    KickoffReminderService
+	reconcilerService         ReconcilerService
+	outboxDispatcherService   OutboxDispatcherService
+	plannerService            PlannerService
 }
 
-func NewTriggerHandler(checkResultService ResultCheckerService, subscriberNotifierService SubscriberNotifierService) *TriggerHandler {
+func NewTriggerHandler(
+	checkResultService ResultCheckerService,
+	subscriberNotifierService SubscriberNotifierService,
+	kickoffReminderService KickoffReminderService,
+	reconcilerService ReconcilerService,
+	outboxDispatcherService OutboxDispatcherService,
+	plannerService PlannerService,
+) *TriggerHandler {
 	return &TriggerHandler{
 		checkResultService:        checkResultService,
 		subscriberNotifierService: subscriberNotifierService,
+		kickoffReminderService:    kickoffReminderService,
+		reconcilerService:         reconcilerService,
+		outboxDispatcherService:   outboxDispatcherService,
+		plannerService:            plannerService,
 	}
 }
 
@@ -44,6 +60,30 @@ func (h *TriggerHandler) CheckResult(c *gin.Context) {
 	c.Status(http.StatusNoContent)
 }
 
+func (h *TriggerHandler) CheckLive(c *gin.Context) {
+	var params TriggerLiveCheckRequest
+	if err := c.ShouldBindJSON(&params); err != nil {
+		c.JSON(http.StatusBadRequest, NewErrorResponse(models.CodeInvalidRequest, err))
+
+		return
+	}
+
+	err := h.checkResultService.CheckLive(c.Request.Context(), params.MatchID, params.Sequence)
+	if errors.As(err, &models.ResourceNotFoundError{}) {
+		c.JSON(http.StatusBadRequest, NewErrorResponse(models.CodeResourceNotFound, err))
+
+		return
+	}
+
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, NewErrorResponse(models.CodeInternalServerError, err))
+
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
 func (h *TriggerHandler) NotifySubscriber(c *gin.Context) {
 	var params TriggerSubscriptionNotificationRequest
 	if err := c.ShouldBindJSON(&params); err != nil {
@@ -66,3 +106,105 @@ func (h *TriggerHandler) NotifySubscriber(c *gin.Context) {
 
 	c.Status(http.StatusNoContent)
 }
+
+func (h *TriggerHandler) NotifyEvent(c *gin.Context) {
+	var params TriggerEventDeliveryRequest
+	if err := c.ShouldBindJSON(&params); err != nil {
+		c.JSON(http.StatusBadRequest, NewErrorResponse(models.CodeInvalidRequest, err))
+		return
+	}
+
+	err := h.subscriberNotifierService.NotifyEvent(c.Request.Context(), params.EventDeliveryID)
+	if errors.As(err, &models.ResourceNotFoundError{}) {
+		c.JSON(http.StatusBadRequest, NewErrorResponse(models.CodeResourceNotFound, err))
+
+		return
+	}
+
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, NewErrorResponse(models.CodeInternalServerError, err))
+
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+func (h *TriggerHandler) RemindKickoff(c *gin.Context) {
+	var params TriggerKickoffReminderRequest
+	if err := c.ShouldBindJSON(&params); err != nil {
+		c.JSON(http.StatusBadRequest, NewErrorResponse(models.CodeInvalidRequest, err))
+		return
+	}
+
+	err := h.kickoffReminderService.Remind(c.Request.Context(), params.SubscriptionID, time.Unix(params.Kickoff, 0))
+	if errors.As(err, &models.ResourceNotFoundError{}) {
+		c.JSON(http.StatusBadRequest, NewErrorResponse(models.CodeResourceNotFound, err))
+
+		return
+	}
+
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, NewErrorResponse(models.CodeInternalServerError, err))
+
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+func (h *TriggerHandler) NotifyBatch(c *gin.Context) {
+	var params TriggerNotificationBatchRequest
+	if err := c.ShouldBindJSON(&params); err != nil {
+		c.JSON(http.StatusBadRequest, NewErrorResponse(models.CodeInvalidRequest, err))
+		return
+	}
+
+	err := h.subscriberNotifierService.NotifyBatch(c.Request.Context(), params.NotificationBatchID)
+	if errors.As(err, &models.ResourceNotFoundError{}) {
+		c.JSON(http.StatusBadRequest, NewErrorResponse(models.CodeResourceNotFound, err))
+
+		return
+	}
+
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, NewErrorResponse(models.CodeInternalServerError, err))
+
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+func (h *TriggerHandler) Reconcile(c *gin.Context) {
+	report, err := h.reconcilerService.Reconcile(c.Request.Context())
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, NewErrorResponse(models.CodeInternalServerError, err))
+
+		return
+	}
+
+	c.JSON(http.StatusOK, NewReconciliationReportResponse(*report))
+}
+
+func (h *TriggerHandler) DispatchOutbox(c *gin.Context) {
+	err := h.outboxDispatcherService.DispatchPending(c.Request.Context())
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, NewErrorResponse(models.CodeInternalServerError, err))
+
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+func (h *TriggerHandler) PlanFixtures(c *gin.Context) {
+	report, err := h.plannerService.Plan(c.Request.Context())
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, NewErrorResponse(models.CodeInternalServerError, err))
+
+		return
+	}
+
+	c.JSON(http.StatusOK, NewPlanningReportResponse(*report))
+}
@@ -19,7 +19,7 @@ func NewAliasRepository(db *gorm.DB) *AliasRepository {
 func (r *AliasRepository) Find(ctx context.Context, alias string) (*models.Alias, error) {
 	var a Alias
 
-	result := r.db.WithContext(ctx).Joins("ExternalTeam").Where("alias ILIKE ?", alias).First(&a)
+	result := conn(ctx, r.db).Joins("ExternalTeam").Where("alias ILIKE ?", alias).First(&a)
 	if result.Error != nil {
 		if result.Error == gorm.ErrRecordNotFound {
 			return nil, models.NewResourceNotFoundError(fmt.Errorf("alias %s not found: %w", alias, result.Error))
@@ -33,8 +33,26 @@ func (r *AliasRepository) Find(ctx context.Context, alias string) (*models.Alias
 	return &domain, nil
 }
 
+// FindByExternalTeamID returns the first alias of the team which is linked to the team of the external API.
+func (r *AliasRepository) FindByExternalTeamID(ctx context.Context, externalTeamID uint) (*models.Alias, error) {
+	var a Alias
+
+	result := conn(ctx, r.db).Joins("ExternalTeam").Where(`"ExternalTeam".id = ?`, externalTeamID).Order("aliases.id").First(&a)
+	if result.Error != nil {
+		if result.Error == gorm.ErrRecordNotFound {
+			return nil, models.NewResourceNotFoundError(fmt.Errorf("alias of external team %d not found: %w", externalTeamID, result.Error))
+		}
+
+		return nil, fmt.Errorf("failed to find alias by external team id: %w", result.Error)
+	}
+
+	domain := toDomainAlias(a)
+
+	return &domain, nil
+}
+
 func (r *AliasRepository) SaveInTrx(ctx context.Context, alias string, externalTeamID uint) error {
-	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+	return conn(ctx, r.db).Transaction(func(tx *gorm.DB) error {
 		team := Team{}
 		if err := tx.Create(&team).Error; err != nil {
 			return fmt.Errorf("failed to create team: %w", err)
@@ -55,7 +73,7 @@ func (r *AliasRepository) SaveInTrx(ctx context.Context, alias string, externalT
 
 func (r *AliasRepository) Search(ctx context.Context, alias string) ([]models.Alias, error) {
 	var aliases []Alias
-	result := r.db.WithContext(ctx).Where("alias ILIKE ?", "%"+alias+"%").Limit(10).Find(&aliases)
+	result := conn(ctx, r.db).Where("alias ILIKE ?", "%"+alias+"%").Limit(10).Find(&aliases)
 
 	if result.Error != nil {
 		return nil, fmt.Errorf("failed to search aliases: %w", result.Error)
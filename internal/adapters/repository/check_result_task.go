@@ -24,7 +24,7 @@ func (r *CheckResultTaskRepository) Save(ctx context.Context, checkResultTask mo
 		AttemptNumber: checkResultTask.AttemptNumber,
 		ExecuteAt:     checkResultTask.ExecuteAt,
 	}
-	result := r.db.WithContext(ctx).Clauses(clause.OnConflict{
+	result := conn(ctx, r.db).Clauses(clause.OnConflict{
 		Columns:   []clause.Column{{Name: "match_id"}},
 		DoUpdates: clause.AssignmentColumns([]string{"name", "attempt_number", "execute_at"}),
 	}).Create(&task)
@@ -0,0 +1,11 @@
+package repository
+
+// Cipher encrypts subscriber secrets stored in the database and hashes them for lookups.
+type Cipher interface {
+	Encrypt(plaintext string) (string, error)
+	Decrypt(value string) (string, error)
+	Reencrypt(value string) (string, error)
+	IsEncrypted(value string) bool
+	CurrentPrefix() string
+	Hash(plaintext string) string
+}
@@ -0,0 +1,98 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/andrewshostak/result-service/internal/app/models"
+	"gorm.io/gorm"
+)
+
+type EventDeliveryRepository struct {
+	db *gorm.DB
+}
+
+func NewEventDeliveryRepository(db *gorm.DB) *EventDeliveryRepository {
+	return &EventDeliveryRepository{db: db}
+}
+
+func (r *EventDeliveryRepository) Create(ctx context.Context, delivery models.EventDelivery) (*models.EventDelivery, error) {
+	toCreate := EventDelivery{
+		MatchEventID:   delivery.MatchEventID,
+		SubscriptionID: delivery.SubscriptionID,
+		DeliveryID:     delivery.DeliveryID,
+	}
+
+	if err := conn(ctx, r.db).Create(&toCreate).Error; err != nil {
+		if isDuplicateError(err) {
+			return nil, models.NewResourceAlreadyExistsError(fmt.Errorf("event delivery already exists: %w", err))
+		}
+
+		return nil, fmt.Errorf("failed to create event delivery: %w", err)
+	}
+
+	domain := toDomainEventDelivery(toCreate)
+	return &domain, nil
+}
+
+func (r *EventDeliveryRepository) Get(ctx context.Context, id uint) (*models.EventDelivery, error) {
+	var delivery EventDelivery
+	result := conn(ctx, r.db).
+		Preload("MatchEvent").
+		Where("id = ?", id).
+		First(&delivery)
+
+	if result.Error != nil {
+		if result.Error == gorm.ErrRecordNotFound {
+			return nil, models.NewResourceNotFoundError(fmt.Errorf("event delivery with id %d not found: %w", id, result.Error))
+		}
+
+		return nil, fmt.Errorf("failed to get event delivery by id: %w", result.Error)
+	}
+
+	domain := toDomainEventDelivery(delivery)
+	return &domain, nil
+}
+
+func (r *EventDeliveryRepository) ListByStatus(ctx context.Context, status models.SubscriptionStatus) ([]models.EventDelivery, error) {
+	var deliveries []EventDelivery
+	result := conn(ctx, r.db).
+		Preload("MatchEvent").
+		Where("status = ?", status).
+		Order("id").
+		Find(&deliveries)
+
+	if result.Error != nil {
+		return nil, fmt.Errorf("failed to list event deliveries by status: %w", result.Error)
+	}
+
+	return toDomainEventDeliveries(deliveries), nil
+}
+
+// Update writes the status and the subscriber error of the event delivery, so a status change without an error clears it.
+// Notification time and delivery attempts are written only when they are set, so a status change keeps them.
+func (r *EventDeliveryRepository) Update(ctx context.Context, id uint, delivery models.EventDelivery) error {
+	d := EventDelivery{ID: id}
+	toUpdate := EventDelivery{
+		Status:           string(delivery.Status),
+		DeliveryAttempts: delivery.DeliveryAttempts,
+		SubscriberError:  delivery.SubscriberError,
+		NotifiedAt:       delivery.NotifiedAt,
+	}
+
+	fields := []string{"Status", "SubscriberError"}
+	if delivery.NotifiedAt != nil {
+		fields = append(fields, "NotifiedAt")
+	}
+
+	if delivery.DeliveryAttempts != 0 {
+		fields = append(fields, "DeliveryAttempts")
+	}
+
+	result := conn(ctx, r.db).Model(&d).Select(fields).Updates(toUpdate)
+	if result.Error != nil {
+		return fmt.Errorf("failed to update event delivery: %w", result.Error)
+	}
+
+	return nil
+}
@@ -17,18 +17,25 @@ func NewExternalMatchRepository(db *gorm.DB) *ExternalMatchRepository {
 }
 
 func (r *ExternalMatchRepository) Save(ctx context.Context, id *uint, externalMatch models.ExternalMatch) (*models.ExternalMatch, error) {
+	var finishType *string
+	if externalMatch.FinishType != nil {
+		mapped := string(*externalMatch.FinishType)
+		finishType = &mapped
+	}
+
 	toSave := ExternalMatch{
-		ID:        externalMatch.ID,
-		MatchID:   externalMatch.MatchID,
-		HomeScore: externalMatch.HomeScore,
-		AwayScore: externalMatch.AwayScore,
-		Status:    string(externalMatch.Status),
+		ID:         externalMatch.ID,
+		MatchID:    externalMatch.MatchID,
+		HomeScore:  externalMatch.HomeScore,
+		AwayScore:  externalMatch.AwayScore,
+		Status:     string(externalMatch.Status),
+		FinishType: finishType,
 	}
 	if id != nil {
 		toSave.ID = *id
 	}
 
-	result := r.db.WithContext(ctx).Save(&toSave)
+	result := conn(ctx, r.db).Save(&toSave)
 	if result.Error != nil {
 		return nil, fmt.Errorf("failed to save external match: %w", result.Error)
 	}
@@ -19,7 +19,7 @@ func NewMatchRepository(db *gorm.DB) *MatchRepository {
 }
 
 func (r *MatchRepository) Delete(ctx context.Context, id uint) error {
-	result := r.db.WithContext(ctx).Delete(&Match{}, id)
+	result := conn(ctx, r.db).Delete(&Match{}, id)
 	if result.Error != nil {
 		return fmt.Errorf("failed to delete match: %w", result.Error)
 	}
@@ -34,9 +34,11 @@ func (r *MatchRepository) Delete(ctx context.Context, id uint) error {
 func (r *MatchRepository) One(ctx context.Context, search models.Match) (*models.Match, error) {
 	var match Match
 
-	query := r.db.WithContext(ctx).
+	query := conn(ctx, r.db).
 		Preload("ExternalMatch").
-		Preload("CheckResultTask")
+		Preload("CheckResultTask").
+		Preload("HomeTeam.Aliases").
+		Preload("AwayTeam.Aliases")
 
 	if search.ID != 0 {
 		query = query.Where(&Match{ID: search.ID})
@@ -69,6 +71,24 @@ func (r *MatchRepository) One(ctx context.Context, search models.Match) (*models
 	return &domain, nil
 }
 
+func (r *MatchRepository) ListScheduledBefore(ctx context.Context, executeAt time.Time) ([]models.Match, error) {
+	var matches []Match
+
+	result := conn(ctx, r.db).
+		Preload("ExternalMatch").
+		Preload("CheckResultTask").
+		Joins("JOIN check_result_tasks ON check_result_tasks.match_id = matches.id").
+		Where("matches.result_status = ?", models.Scheduled).
+		Where("check_result_tasks.execute_at < ?", executeAt).
+		Find(&matches)
+
+	if result.Error != nil {
+		return nil, fmt.Errorf("failed to list scheduled matches: %w", result.Error)
+	}
+
+	return toDomainMatches(matches), nil
+}
+
 func (r *MatchRepository) Save(ctx context.Context, id *uint, match models.Match) (*models.Match, error) {
 	toSave := Match{
 		ID:           match.ID,
@@ -81,7 +101,7 @@ func (r *MatchRepository) Save(ctx context.Context, id *uint, match models.Match
 		toSave.ID = *id
 	}
 
-	result := r.db.WithContext(ctx).Save(&toSave)
+	result := conn(ctx, r.db).Save(&toSave)
 	if result.Error != nil {
 		return nil, fmt.Errorf("failed to save match: %w", result.Error)
 	}
@@ -90,13 +110,65 @@ func (r *MatchRepository) Save(ctx context.Context, id *uint, match models.Match
 	return &domain, nil
 }
 
-func (r *MatchRepository) Update(ctx context.Context, id uint, resultStatus models.ResultStatus) (*models.Match, error) {
-	match := Match{ID: id}
-	result := r.db.WithContext(ctx).Model(&match).Updates(Match{ResultStatus: string(resultStatus)})
+// UpdateStartsAt moves the kickoff of the match, other fields are left as is.
+func (r *MatchRepository) UpdateStartsAt(ctx context.Context, id uint, startsAt time.Time) error {
+	result := conn(ctx, r.db).Model(&Match{ID: id}).Update("starts_at", startsAt)
 	if result.Error != nil {
-		return nil, fmt.Errorf("failed to update match: %w", result.Error)
+		return fmt.Errorf("failed to update match starts at: %w", result.Error)
+	}
+
+	return nil
+}
+
+// Update changes result status of the match and records the transition in the status history.
+// The match is updated only if its current status equals to the transition source status.
+func (r *MatchRepository) Update(ctx context.Context, transition models.MatchStatusTransition) (*models.Match, error) {
+	match := Match{ID: transition.MatchID}
+
+	err := conn(ctx, r.db).Transaction(func(tx *gorm.DB) error {
+		result := tx.Model(&match).
+			Where("result_status = ?", transition.From).
+			Updates(Match{ResultStatus: string(transition.To)})
+		if result.Error != nil {
+			return fmt.Errorf("failed to update match: %w", result.Error)
+		}
+
+		if result.RowsAffected == 0 {
+			return models.NewUnprocessableContentError(fmt.Errorf("match with id %d and result status %s doesn't exist", transition.MatchID, transition.From))
+		}
+
+		history := MatchStatusHistory{
+			MatchID:       transition.MatchID,
+			FromStatus:    string(transition.From),
+			ToStatus:      string(transition.To),
+			Reason:        transition.Reason,
+			AttemptNumber: transition.AttemptNumber,
+		}
+		if err := tx.Create(&history).Error; err != nil {
+			return fmt.Errorf("failed to create match status history: %w", err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
 	}
 
 	domain := toDomainMatch(match)
 	return &domain, nil
 }
+
+func (r *MatchRepository) ListStatusHistory(ctx context.Context, matchID uint) ([]models.MatchStatusTransition, error) {
+	var history []MatchStatusHistory
+
+	result := conn(ctx, r.db).
+		Where("match_id = ?", matchID).
+		Order("created_at, id").
+		Find(&history)
+
+	if result.Error != nil {
+		return nil, fmt.Errorf("failed to list match status history: %w", result.Error)
+	}
+
+	return toDomainMatchStatusTransitions(history), nil
+}
@@ -0,0 +1,40 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/andrewshostak/result-service/internal/app/models"
+	"gorm.io/gorm"
+)
+
+type MatchEventRepository struct {
+	db *gorm.DB
+}
+
+func NewMatchEventRepository(db *gorm.DB) *MatchEventRepository {
+	return &MatchEventRepository{db: db}
+}
+
+// Create stores the event. An event with the same key already stored for the match results in already exists error.
+func (r *MatchEventRepository) Create(ctx context.Context, event models.MatchEvent) (*models.MatchEvent, error) {
+	toCreate := MatchEvent{
+		MatchID:   event.MatchID,
+		EventType: string(event.Type),
+		Key:       event.Key,
+		HomeScore: event.HomeScore,
+		AwayScore: event.AwayScore,
+		StartsAt:  event.StartsAt,
+	}
+
+	if err := conn(ctx, r.db).Create(&toCreate).Error; err != nil {
+		if isDuplicateError(err) {
+			return nil, models.NewResourceAlreadyExistsError(fmt.Errorf("match event %s already exists: %w", event.Key, err))
+		}
+
+		return nil, fmt.Errorf("failed to create match event: %w", err)
+	}
+
+	domain := toDomainMatchEvent(toCreate)
+	return &domain, nil
+}
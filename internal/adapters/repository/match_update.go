@@ -0,0 +1,75 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/andrewshostak/result-service/internal/app/models"
+	"gorm.io/gorm"
+)
+
+type MatchUpdateRepository struct {
+	db *gorm.DB
+}
+
+func NewMatchUpdateRepository(db *gorm.DB) *MatchUpdateRepository {
+	return &MatchUpdateRepository{db: db}
+}
+
+func (r *MatchUpdateRepository) Create(ctx context.Context, update models.MatchUpdate) (*models.MatchUpdate, error) {
+	toCreate := MatchUpdate{
+		MatchID:   update.MatchID,
+		Status:    string(update.Status),
+		HomeScore: update.HomeScore,
+		AwayScore: update.AwayScore,
+	}
+
+	// same as for result events, ids are taken under the lock, so a stream can't skip an update committed late
+	err := conn(ctx, r.db).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Exec("SELECT pg_advisory_xact_lock(hashtext('match_updates'))").Error; err != nil {
+			return err
+		}
+
+		return tx.Create(&toCreate).Error
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create match update: %w", err)
+	}
+
+	domain := toDomainMatchUpdate(toCreate)
+	return &domain, nil
+}
+
+// List returns updates created after the update with the given id in the order of creation.
+func (r *MatchUpdateRepository) List(ctx context.Context, filter models.MatchUpdateFilter) ([]models.MatchUpdate, error) {
+	var updates []MatchUpdate
+
+	query := conn(ctx, r.db).Where("id > ?", filter.AfterID)
+	if len(filter.MatchIDs) > 0 {
+		query = query.Where("match_id IN ?", filter.MatchIDs)
+	}
+
+	result := query.Order("id").Limit(int(filter.Limit)).Find(&updates)
+	if result.Error != nil {
+		return nil, fmt.Errorf("failed to list match updates: %w", result.Error)
+	}
+
+	domain := make([]models.MatchUpdate, 0, len(updates))
+	for i := range updates {
+		domain = append(domain, toDomainMatchUpdate(updates[i]))
+	}
+
+	return domain, nil
+}
+
+// LastID returns the id of the latest update, or zero when there are no updates.
+func (r *MatchUpdateRepository) LastID(ctx context.Context) (uint, error) {
+	var lastID uint
+
+	result := conn(ctx, r.db).Model(&MatchUpdate{}).Select("coalesce(max(id), 0)").Scan(&lastID)
+	if result.Error != nil {
+		return 0, fmt.Errorf("failed to get last match update id: %w", result.Error)
+	}
+
+	return lastID, nil
+}
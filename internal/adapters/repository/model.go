@@ -39,28 +39,122 @@ type Match struct {
 }
 
 type ExternalMatch struct {
-	ID        uint   `gorm:"column:id;primaryKey" db:"id"`
-	MatchID   uint   `gorm:"column:match_id" db:"match_id"`
-	HomeScore int    `gorm:"column:home_score" db:"home_score"`
-	AwayScore int    `gorm:"column:away_score" db:"away_score"`
-	Status    string `gorm:"column:status" db:"status"`
+	ID         uint    `gorm:"column:id;primaryKey" db:"id"`
+	MatchID    uint    `gorm:"column:match_id" db:"match_id"`
+	HomeScore  int     `gorm:"column:home_score" db:"home_score"`
+	AwayScore  int     `gorm:"column:away_score" db:"away_score"`
+	Status     string  `gorm:"column:status" db:"status"`
+	FinishType *string `gorm:"column:finish_type" db:"finish_type"`
 
 	Match *Match `gorm:"foreignKey:MatchID"`
 }
 
 type Subscription struct {
-	ID              uint       `gorm:"column:id;primaryKey" db:"id"`
-	Url             string     `gorm:"column:url;unique" db:"url"`
-	MatchID         uint       `gorm:"column:match_id" db:"match_id"`
-	Key             string     `gorm:"column:key;unique" db:"key"`
-	CreatedAt       time.Time  `gorm:"column:created_at" db:"created_at"`
-	Status          string     `gorm:"column:status;default:pending" db:"status"`
-	SubscriberError *string    `gorm:"column:subscriber_error" db:"subscriber_error"`
-	NotifiedAt      *time.Time `gorm:"column:notified_at" db:"notified_at"`
+	ID                  uint       `gorm:"column:id;primaryKey" db:"id"`
+	Url                 string     `gorm:"column:url" db:"url"`
+	MatchID             uint       `gorm:"column:match_id" db:"match_id"`
+	Key                 string     `gorm:"column:key" db:"key"` // encrypted
+	KeyHash             *string    `gorm:"column:key_hash" db:"key_hash"`
+	PreviousKey         *string    `gorm:"column:previous_key" db:"previous_key"`
+	PreviousKeyTill     *time.Time `gorm:"column:previous_key_till" db:"previous_key_till"`
+	PayloadVersion      string     `gorm:"column:payload_version;default:v1" db:"payload_version"`
+	DeliveryFormat      string     `gorm:"column:delivery_format;default:webhook" db:"delivery_format"`
+	Channel             string     `gorm:"column:channel;default:webhook" db:"channel"`
+	HTTPMethod          string     `gorm:"column:http_method;default:PATCH" db:"http_method"`
+	AuthHeader          *string    `gorm:"column:auth_header" db:"auth_header"`
+	AuthScheme          *string    `gorm:"column:auth_scheme" db:"auth_scheme"`
+	BatchURL            *string    `gorm:"column:batch_url" db:"batch_url"`
+	NotificationBatchID *uint      `gorm:"column:notification_batch_id" db:"notification_batch_id"`
+	RemindBefore        *uint      `gorm:"column:remind_before" db:"remind_before"`
+	DeliveryID          string     `gorm:"column:delivery_id;default:gen_random_uuid()" db:"delivery_id"`
+	CreatedAt           time.Time  `gorm:"column:created_at" db:"created_at"`
+	Status              string     `gorm:"column:status;default:pending" db:"status"`
+	SubscriberError     *string    `gorm:"column:subscriber_error" db:"subscriber_error"`
+	DeliveryAttempts    uint       `gorm:"column:delivery_attempts" db:"delivery_attempts"`
+	NotifiedAt          *time.Time `gorm:"column:notified_at" db:"notified_at"`
+
+	Match      *Match                  `gorm:"foreignKey:MatchID"`
+	EventTypes []SubscriptionEventType `gorm:"foreignKey:SubscriptionID"`
+	Headers    []SubscriptionHeader    `gorm:"foreignKey:SubscriptionID"`
+}
+
+type SubscriptionEventType struct {
+	SubscriptionID uint   `gorm:"column:subscription_id;primaryKey" db:"subscription_id"`
+	EventType      string `gorm:"column:event_type;primaryKey" db:"event_type"`
+}
+
+type SubscriptionHeader struct {
+	SubscriptionID uint   `gorm:"column:subscription_id;primaryKey" db:"subscription_id"`
+	Name           string `gorm:"column:name;primaryKey" db:"name"`
+	Value          string `gorm:"column:value" db:"value"` // encrypted
+}
+
+type MatchEvent struct {
+	ID        uint      `gorm:"column:id;primaryKey" db:"id"`
+	MatchID   uint      `gorm:"column:match_id" db:"match_id"`
+	EventType string    `gorm:"column:event_type" db:"event_type"`
+	Key       string    `gorm:"column:key" db:"key"`
+	HomeScore int       `gorm:"column:home_score" db:"home_score"`
+	AwayScore int       `gorm:"column:away_score" db:"away_score"`
+	StartsAt  time.Time `gorm:"column:starts_at" db:"starts_at"`
+	CreatedAt time.Time `gorm:"column:created_at" db:"created_at"`
 
 	Match *Match `gorm:"foreignKey:MatchID"`
 }
 
+type ResultEvent struct {
+	ID         uint      `gorm:"column:id;primaryKey" db:"id"`
+	MatchID    uint      `gorm:"column:match_id" db:"match_id"`
+	EventType  string    `gorm:"column:event_type" db:"event_type"`
+	HomeTeamID uint      `gorm:"column:home_team_id" db:"home_team_id"`
+	AwayTeamID uint      `gorm:"column:away_team_id" db:"away_team_id"`
+	StartsAt   time.Time `gorm:"column:starts_at" db:"starts_at"`
+	HomeScore  int       `gorm:"column:home_score" db:"home_score"`
+	AwayScore  int       `gorm:"column:away_score" db:"away_score"`
+	FinishType *string   `gorm:"column:finish_type" db:"finish_type"`
+	CreatedAt  time.Time `gorm:"column:created_at" db:"created_at"`
+}
+
+type MatchUpdate struct {
+	ID         uint      `gorm:"column:id;primaryKey" db:"id"`
+	MatchID    uint      `gorm:"column:match_id" db:"match_id"`
+	Status     string    `gorm:"column:status" db:"status"`
+	HomeScore  int       `gorm:"column:home_score" db:"home_score"`
+	AwayScore  int       `gorm:"column:away_score" db:"away_score"`
+	ObservedAt time.Time `gorm:"column:observed_at;default:now()" db:"observed_at"`
+}
+
+type EventDelivery struct {
+	ID               uint       `gorm:"column:id;primaryKey" db:"id"`
+	MatchEventID     uint       `gorm:"column:match_event_id" db:"match_event_id"`
+	SubscriptionID   uint       `gorm:"column:subscription_id" db:"subscription_id"`
+	DeliveryID       string     `gorm:"column:delivery_id" db:"delivery_id"`
+	Status           string     `gorm:"column:status;default:pending" db:"status"`
+	DeliveryAttempts uint       `gorm:"column:delivery_attempts" db:"delivery_attempts"`
+	SubscriberError  *string    `gorm:"column:subscriber_error" db:"subscriber_error"`
+	NotifiedAt       *time.Time `gorm:"column:notified_at" db:"notified_at"`
+	CreatedAt        time.Time  `gorm:"column:created_at" db:"created_at"`
+
+	MatchEvent   *MatchEvent   `gorm:"foreignKey:MatchEventID"`
+	Subscription *Subscription `gorm:"foreignKey:SubscriptionID"`
+}
+
+type StandingSubscription struct {
+	ID             uint      `gorm:"column:id;primaryKey" db:"id"`
+	TeamID         *uint     `gorm:"column:team_id" db:"team_id"`
+	LeagueName     *string   `gorm:"column:league_name" db:"league_name"`
+	CountryCode    *string   `gorm:"column:country_code" db:"country_code"`
+	URLTemplate    string    `gorm:"column:url_template;unique" db:"url_template"`
+	Key            string    `gorm:"column:key" db:"key"`
+	PayloadVersion string    `gorm:"column:payload_version;default:v1" db:"payload_version"`
+	DeliveryFormat string    `gorm:"column:delivery_format;default:webhook" db:"delivery_format"`
+	AuthHeader     *string   `gorm:"column:auth_header" db:"auth_header"`
+	AuthScheme     *string   `gorm:"column:auth_scheme" db:"auth_scheme"`
+	CreatedAt      time.Time `gorm:"column:created_at" db:"created_at"`
+
+	ExternalTeam *ExternalTeam `gorm:"foreignKey:TeamID;references:TeamID"`
+}
+
 type CheckResultTask struct {
 	ID            uint      `gorm:"column:id;primaryKey" db:"id"`
 	MatchID       uint      `gorm:"column:match_id;unique" db:"match_id"`
@@ -72,6 +166,90 @@ type CheckResultTask struct {
 	Match *Match `gorm:"foreignKey:MatchID"`
 }
 
+type MatchStatusHistory struct {
+	ID            uint      `gorm:"column:id;primaryKey" db:"id"`
+	MatchID       uint      `gorm:"column:match_id" db:"match_id"`
+	FromStatus    string    `gorm:"column:from_status" db:"from_status"`
+	ToStatus      string    `gorm:"column:to_status" db:"to_status"`
+	Reason        string    `gorm:"column:reason" db:"reason"`
+	AttemptNumber *uint     `gorm:"column:attempt_number" db:"attempt_number"`
+	CreatedAt     time.Time `gorm:"column:created_at" db:"created_at"`
+
+	Match *Match `gorm:"foreignKey:MatchID"`
+}
+
+func (MatchStatusHistory) TableName() string {
+	return "match_status_history"
+}
+
+type ResultCheckAttempt struct {
+	ID             uint       `gorm:"column:id;primaryKey" db:"id"`
+	MatchID        uint       `gorm:"column:match_id" db:"match_id"`
+	AttemptNumber  uint       `gorm:"column:attempt_number" db:"attempt_number"`
+	ScheduledAt    *time.Time `gorm:"column:scheduled_at" db:"scheduled_at"`
+	ExecutedAt     time.Time  `gorm:"column:executed_at" db:"executed_at"`
+	ProviderStatus *string    `gorm:"column:provider_status" db:"provider_status"`
+	HomeScore      *int       `gorm:"column:home_score" db:"home_score"`
+	AwayScore      *int       `gorm:"column:away_score" db:"away_score"`
+	Outcome        string     `gorm:"column:outcome" db:"outcome"`
+	ErrorMessage   *string    `gorm:"column:error_message" db:"error_message"`
+	Snapshot       []byte     `gorm:"column:snapshot" db:"snapshot"` // gzip compressed
+	CreatedAt      time.Time  `gorm:"column:created_at" db:"created_at"`
+
+	Match *Match `gorm:"foreignKey:MatchID"`
+}
+
+type NotificationAttempt struct {
+	ID             uint      `gorm:"column:id;primaryKey" db:"id"`
+	SubscriptionID uint      `gorm:"column:subscription_id" db:"subscription_id"`
+	DeliveryID     string    `gorm:"column:delivery_id" db:"delivery_id"`
+	EventType      string    `gorm:"column:event_type" db:"event_type"`
+	ExecutedAt     time.Time `gorm:"column:executed_at" db:"executed_at"`
+	RequestPayload *string   `gorm:"column:request_payload" db:"request_payload"`
+	ResponseStatus *int      `gorm:"column:response_status" db:"response_status"`
+	ResponseBody   *string   `gorm:"column:response_body" db:"response_body"`
+	LatencyMs      int64     `gorm:"column:latency_ms" db:"latency_ms"`
+	ErrorMessage   *string   `gorm:"column:error_message" db:"error_message"`
+	CreatedAt      time.Time `gorm:"column:created_at" db:"created_at"`
+
+	Subscription *Subscription `gorm:"foreignKey:SubscriptionID"`
+}
+
+type OutboxTask struct {
+	ID            uint       `gorm:"column:id;primaryKey" db:"id"`
+	Kind          string     `gorm:"column:kind" db:"kind"`
+	MatchID       uint       `gorm:"column:match_id" db:"match_id"`
+	AttemptNumber uint       `gorm:"column:attempt_number" db:"attempt_number"`
+	ExecuteAt     time.Time  `gorm:"column:execute_at" db:"execute_at"`
+	Status        string     `gorm:"column:status;default:pending" db:"status"`
+	Error         *string    `gorm:"column:error" db:"error"`
+	CreatedAt     time.Time  `gorm:"column:created_at" db:"created_at"`
+	DispatchedAt  *time.Time `gorm:"column:dispatched_at" db:"dispatched_at"`
+
+	Match *Match `gorm:"foreignKey:MatchID"`
+}
+
+type NotificationBatch struct {
+	ID        uint      `gorm:"column:id;primaryKey" db:"id"`
+	BatchURL  string    `gorm:"column:batch_url" db:"batch_url"`
+	KeyHash   *string   `gorm:"column:key_hash" db:"key_hash"`
+	Status    string    `gorm:"column:status;default:open" db:"status"`
+	DeliverAt time.Time `gorm:"column:deliver_at" db:"deliver_at"`
+	CreatedAt time.Time `gorm:"column:created_at" db:"created_at"`
+	UpdatedAt time.Time `gorm:"column:updated_at" db:"updated_at"`
+}
+
+type SubscriberHost struct {
+	Host                string     `gorm:"column:host;primaryKey" db:"host"`
+	ConsecutiveFailures uint       `gorm:"column:consecutive_failures" db:"consecutive_failures"`
+	LastError           *string    `gorm:"column:last_error" db:"last_error"`
+	LastFailureAt       *time.Time `gorm:"column:last_failure_at" db:"last_failure_at"`
+	LastSuccessAt       *time.Time `gorm:"column:last_success_at" db:"last_success_at"`
+	SuspendedAt         *time.Time `gorm:"column:suspended_at" db:"suspended_at"`
+	CreatedAt           time.Time  `gorm:"column:created_at" db:"created_at"`
+	UpdatedAt           time.Time  `gorm:"column:updated_at" db:"updated_at"`
+}
+
 func toDomainAlias(a Alias) models.Alias {
 	var externalTeam *models.ExternalTeam
 
@@ -105,12 +283,31 @@ func toDomainExternalTeam(t ExternalTeam) models.ExternalTeam {
 }
 
 func toDomainExternalMatch(f ExternalMatch) models.ExternalMatch {
+	var finishType *models.FinishType
+	if f.FinishType != nil {
+		mapped := models.FinishType(*f.FinishType)
+		finishType = &mapped
+	}
+
 	return models.ExternalMatch{
-		ID:        f.ID,
-		MatchID:   f.MatchID,
-		HomeScore: f.HomeScore,
-		AwayScore: f.AwayScore,
-		Status:    models.ExternalMatchStatus(f.Status),
+		ID:         f.ID,
+		MatchID:    f.MatchID,
+		HomeScore:  f.HomeScore,
+		AwayScore:  f.AwayScore,
+		Status:     models.ExternalMatchStatus(f.Status),
+		FinishType: finishType,
+	}
+}
+
+func toDomainTeam(t Team) models.Team {
+	aliases := make([]string, 0, len(t.Aliases))
+	for _, alias := range t.Aliases {
+		aliases = append(aliases, alias.Alias)
+	}
+
+	return models.Team{
+		ID:      t.ID,
+		Aliases: aliases,
 	}
 }
 
@@ -120,6 +317,7 @@ func toDomainCheckResultTask(t CheckResultTask) models.CheckResultTask {
 		MatchID:       t.MatchID,
 		Name:          t.Name,
 		AttemptNumber: t.AttemptNumber,
+		ExecuteAt:     t.ExecuteAt,
 	}
 }
 
@@ -142,9 +340,49 @@ func toDomainMatch(m Match) models.Match {
 		match.CheckResultTask = &checkResultTask
 	}
 
+	if m.HomeTeam != nil {
+		homeTeam := toDomainTeam(*m.HomeTeam)
+		match.HomeTeam = &homeTeam
+	}
+
+	if m.AwayTeam != nil {
+		awayTeam := toDomainTeam(*m.AwayTeam)
+		match.AwayTeam = &awayTeam
+	}
+
 	return match
 }
 
+func toDomainMatches(m []Match) []models.Match {
+	matches := make([]models.Match, 0, len(m))
+	for i := range m {
+		matches = append(matches, toDomainMatch(m[i]))
+	}
+
+	return matches
+}
+
+func toDomainMatchStatusTransition(h MatchStatusHistory) models.MatchStatusTransition {
+	return models.MatchStatusTransition{
+		ID:            h.ID,
+		MatchID:       h.MatchID,
+		From:          models.ResultStatus(h.FromStatus),
+		To:            models.ResultStatus(h.ToStatus),
+		Reason:        h.Reason,
+		AttemptNumber: h.AttemptNumber,
+		CreatedAt:     h.CreatedAt,
+	}
+}
+
+func toDomainMatchStatusTransitions(h []MatchStatusHistory) []models.MatchStatusTransition {
+	transitions := make([]models.MatchStatusTransition, 0, len(h))
+	for i := range h {
+		transitions = append(transitions, toDomainMatchStatusTransition(h[i]))
+	}
+
+	return transitions
+}
+
 func toDomainSubscription(s Subscription) models.Subscription {
 	var match models.Match
 
@@ -152,23 +390,186 @@ func toDomainSubscription(s Subscription) models.Subscription {
 		match = toDomainMatch(*s.Match)
 	}
 
+	var eventTypes []models.NotificationEventType
+	for _, eventType := range s.EventTypes {
+		eventTypes = append(eventTypes, models.NotificationEventType(eventType.EventType))
+	}
+
+	var headers map[string]string
+	if len(s.Headers) > 0 {
+		headers = make(map[string]string, len(s.Headers))
+		for _, header := range s.Headers {
+			headers[header.Name] = header.Value
+		}
+	}
+
 	return models.Subscription{
-		ID:         s.ID,
-		Url:        s.Url,
-		MatchID:    s.MatchID,
-		Key:        s.Key,
-		CreatedAt:  s.CreatedAt,
-		Status:     models.SubscriptionStatus(s.Status),
-		NotifiedAt: s.NotifiedAt,
-		Match:      &match,
+		ID:                  s.ID,
+		Url:                 s.Url,
+		MatchID:             s.MatchID,
+		Key:                 s.Key,
+		PreviousKey:         s.PreviousKey,
+		PreviousKeyTill:     s.PreviousKeyTill,
+		PayloadVersion:      models.PayloadVersion(s.PayloadVersion),
+		DeliveryFormat:      models.DeliveryFormat(s.DeliveryFormat),
+		EventTypes:          eventTypes,
+		Channel:             models.NotificationChannel(s.Channel),
+		HTTPMethod:          s.HTTPMethod,
+		Headers:             headers,
+		AuthHeader:          s.AuthHeader,
+		AuthScheme:          s.AuthScheme,
+		BatchURL:            s.BatchURL,
+		NotificationBatchID: s.NotificationBatchID,
+		RemindBefore:        s.RemindBefore,
+		DeliveryID:          s.DeliveryID,
+		CreatedAt:           s.CreatedAt,
+		Status:              models.SubscriptionStatus(s.Status),
+		NotifiedAt:          s.NotifiedAt,
+		DeliveryAttempts:    s.DeliveryAttempts,
+		Match:               &match,
+	}
+}
+
+func toDomainMatchEvent(e MatchEvent) models.MatchEvent {
+	return models.MatchEvent{
+		ID:        e.ID,
+		MatchID:   e.MatchID,
+		Type:      models.NotificationEventType(e.EventType),
+		Key:       e.Key,
+		HomeScore: e.HomeScore,
+		AwayScore: e.AwayScore,
+		StartsAt:  e.StartsAt,
+		CreatedAt: e.CreatedAt,
 	}
 }
 
-func toDomainSubscriptions(s []Subscription) []models.Subscription {
-	subscriptions := make([]models.Subscription, 0, len(s))
-	for i := range s {
-		subscriptions = append(subscriptions, toDomainSubscription(s[i]))
+func toDomainResultEvent(e ResultEvent) models.ResultEvent {
+	var finishType *models.FinishType
+	if e.FinishType != nil {
+		mapped := models.FinishType(*e.FinishType)
+		finishType = &mapped
 	}
 
-	return subscriptions
+	return models.ResultEvent{
+		ID:         e.ID,
+		MatchID:    e.MatchID,
+		Type:       models.NotificationEventType(e.EventType),
+		HomeTeamID: e.HomeTeamID,
+		AwayTeamID: e.AwayTeamID,
+		StartsAt:   e.StartsAt,
+		HomeScore:  e.HomeScore,
+		AwayScore:  e.AwayScore,
+		FinishType: finishType,
+		CreatedAt:  e.CreatedAt,
+	}
+}
+
+func toDomainMatchUpdate(u MatchUpdate) models.MatchUpdate {
+	return models.MatchUpdate{
+		ID:         u.ID,
+		MatchID:    u.MatchID,
+		Status:     models.ExternalMatchStatus(u.Status),
+		HomeScore:  u.HomeScore,
+		AwayScore:  u.AwayScore,
+		ObservedAt: u.ObservedAt,
+	}
+}
+
+func toDomainStandingSubscription(s StandingSubscription) models.StandingSubscription {
+	var league *models.League
+	if s.LeagueName != nil && s.CountryCode != nil {
+		league = &models.League{Name: *s.LeagueName, CountryCode: *s.CountryCode}
+	}
+
+	var externalTeam *models.ExternalTeam
+	if s.ExternalTeam != nil {
+		mapped := toDomainExternalTeam(*s.ExternalTeam)
+		externalTeam = &mapped
+	}
+
+	return models.StandingSubscription{
+		ID:             s.ID,
+		TeamID:         s.TeamID,
+		League:         league,
+		URLTemplate:    s.URLTemplate,
+		Key:            s.Key,
+		PayloadVersion: models.PayloadVersion(s.PayloadVersion),
+		DeliveryFormat: models.DeliveryFormat(s.DeliveryFormat),
+		AuthHeader:     s.AuthHeader,
+		AuthScheme:     s.AuthScheme,
+		CreatedAt:      s.CreatedAt,
+		ExternalTeam:   externalTeam,
+	}
+}
+
+func toDomainEventDelivery(d EventDelivery) models.EventDelivery {
+	delivery := models.EventDelivery{
+		ID:               d.ID,
+		MatchEventID:     d.MatchEventID,
+		SubscriptionID:   d.SubscriptionID,
+		DeliveryID:       d.DeliveryID,
+		Status:           models.SubscriptionStatus(d.Status),
+		DeliveryAttempts: d.DeliveryAttempts,
+		SubscriberError:  d.SubscriberError,
+		NotifiedAt:       d.NotifiedAt,
+	}
+
+	if d.MatchEvent != nil {
+		event := toDomainMatchEvent(*d.MatchEvent)
+		delivery.MatchEvent = &event
+	}
+
+	return delivery
+}
+
+func toDomainEventDeliveries(d []EventDelivery) []models.EventDelivery {
+	deliveries := make([]models.EventDelivery, 0, len(d))
+	for i := range d {
+		deliveries = append(deliveries, toDomainEventDelivery(d[i]))
+	}
+
+	return deliveries
+}
+
+func toDomainOutboxTask(t OutboxTask) models.OutboxTask {
+	return models.OutboxTask{
+		ID:            t.ID,
+		Kind:          models.OutboxTaskKind(t.Kind),
+		MatchID:       t.MatchID,
+		AttemptNumber: t.AttemptNumber,
+		ExecuteAt:     t.ExecuteAt,
+		Status:        models.OutboxTaskStatus(t.Status),
+		Error:         t.Error,
+		CreatedAt:     t.CreatedAt,
+		DispatchedAt:  t.DispatchedAt,
+	}
+}
+
+func toDomainOutboxTasks(t []OutboxTask) []models.OutboxTask {
+	tasks := make([]models.OutboxTask, 0, len(t))
+	for i := range t {
+		tasks = append(tasks, toDomainOutboxTask(t[i]))
+	}
+
+	return tasks
+}
+
+func toDomainNotificationBatch(b NotificationBatch) models.NotificationBatch {
+	return models.NotificationBatch{
+		ID:        b.ID,
+		BatchURL:  b.BatchURL,
+		Status:    models.NotificationBatchStatus(b.Status),
+		DeliverAt: b.DeliverAt,
+	}
+}
+
+func toDomainSubscriberHost(h SubscriberHost) models.SubscriberHost {
+	return models.SubscriberHost{
+		Host:                h.Host,
+		ConsecutiveFailures: h.ConsecutiveFailures,
+		LastError:           h.LastError,
+		LastFailureAt:       h.LastFailureAt,
+		LastSuccessAt:       h.LastSuccessAt,
+		SuspendedAt:         h.SuspendedAt,
+	}
 }
@@ -0,0 +1,86 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/andrewshostak/result-service/internal/app/models"
+	"gorm.io/gorm"
+)
+
+type NotificationAttemptRepository struct {
+	db *gorm.DB
+}
+
+func NewNotificationAttemptRepository(db *gorm.DB) *NotificationAttemptRepository {
+	return &NotificationAttemptRepository{db: db}
+}
+
+func (r *NotificationAttemptRepository) Create(ctx context.Context, attempt models.NotificationAttempt) (*models.NotificationAttempt, error) {
+	var requestPayload *string
+	if len(attempt.RequestPayload) > 0 {
+		payload := string(attempt.RequestPayload)
+		requestPayload = &payload
+	}
+
+	toCreate := NotificationAttempt{
+		SubscriptionID: attempt.SubscriptionID,
+		DeliveryID:     attempt.DeliveryID,
+		EventType:      string(attempt.EventType),
+		ExecutedAt:     attempt.ExecutedAt,
+		RequestPayload: requestPayload,
+		ResponseStatus: attempt.ResponseStatus,
+		ResponseBody:   attempt.ResponseBody,
+		LatencyMs:      attempt.Latency.Milliseconds(),
+		ErrorMessage:   attempt.ErrorMessage,
+	}
+
+	if err := conn(ctx, r.db).Create(&toCreate).Error; err != nil {
+		return nil, fmt.Errorf("failed to create notification attempt: %w", err)
+	}
+
+	attempt.ID = toCreate.ID
+
+	return &attempt, nil
+}
+
+func (r *NotificationAttemptRepository) ListBySubscription(ctx context.Context, subscriptionID uint) ([]models.NotificationAttempt, error) {
+	var attempts []NotificationAttempt
+
+	result := conn(ctx, r.db).
+		Where("subscription_id = ?", subscriptionID).
+		Order("executed_at, id").
+		Find(&attempts)
+
+	if result.Error != nil {
+		return nil, fmt.Errorf("failed to list notification attempts: %w", result.Error)
+	}
+
+	domain := make([]models.NotificationAttempt, 0, len(attempts))
+	for i := range attempts {
+		domain = append(domain, toDomainNotificationAttempt(attempts[i]))
+	}
+
+	return domain, nil
+}
+
+func toDomainNotificationAttempt(a NotificationAttempt) models.NotificationAttempt {
+	var requestPayload []byte
+	if a.RequestPayload != nil {
+		requestPayload = []byte(*a.RequestPayload)
+	}
+
+	return models.NotificationAttempt{
+		ID:             a.ID,
+		SubscriptionID: a.SubscriptionID,
+		DeliveryID:     a.DeliveryID,
+		EventType:      models.NotificationEventType(a.EventType),
+		ExecutedAt:     a.ExecutedAt,
+		RequestPayload: requestPayload,
+		ResponseStatus: a.ResponseStatus,
+		ResponseBody:   a.ResponseBody,
+		Latency:        time.Duration(a.LatencyMs) * time.Millisecond,
+		ErrorMessage:   a.ErrorMessage,
+	}
+}
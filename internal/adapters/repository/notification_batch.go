@@ -0,0 +1,65 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/andrewshostak/result-service/internal/app/models"
+	"gorm.io/gorm"
+)
+
+type NotificationBatchRepository struct {
+	db *gorm.DB
+}
+
+func NewNotificationBatchRepository(db *gorm.DB) *NotificationBatchRepository {
+	return &NotificationBatchRepository{db: db}
+}
+
+// Join adds the subscription to the open batch of its batch url and key, the batch is opened when there is none.
+// The open batch is locked by the upsert until the subscription joins it, so a concurrently closed batch isn't joined.
+func (r *NotificationBatchRepository) Join(ctx context.Context, subscriptionID uint, deliverAt time.Time) (*models.NotificationBatch, error) {
+	var batches []NotificationBatch
+	result := conn(ctx, r.db).Raw(`
+		WITH batch AS (
+			INSERT INTO notification_batches (batch_url, key_hash, deliver_at)
+			SELECT batch_url, key_hash, @deliver_at FROM subscriptions WHERE id = @subscription_id AND batch_url IS NOT NULL
+			ON CONFLICT (batch_url, key_hash) WHERE status = 'open' DO UPDATE SET updated_at = now()
+			RETURNING *
+		), joined AS (
+			UPDATE subscriptions SET notification_batch_id = batch.id FROM batch WHERE subscriptions.id = @subscription_id
+		)
+		SELECT * FROM batch`,
+		map[string]any{"subscription_id": subscriptionID, "deliver_at": deliverAt},
+	).Scan(&batches)
+	if result.Error != nil {
+		return nil, fmt.Errorf("failed to join notification batch: %w", result.Error)
+	}
+
+	if len(batches) == 0 {
+		return nil, models.NewResourceNotFoundError(fmt.Errorf("subscription with id %d and batch url not found", subscriptionID))
+	}
+
+	domain := toDomainNotificationBatch(batches[0])
+	return &domain, nil
+}
+
+// Close closes the batch for the delivery, subscriptions join a new batch afterward.
+func (r *NotificationBatchRepository) Close(ctx context.Context, id uint) (*models.NotificationBatch, error) {
+	var batch NotificationBatch
+	result := conn(ctx, r.db).Raw(
+		"UPDATE notification_batches SET status = ?, updated_at = now() WHERE id = ? RETURNING *",
+		models.BatchClosed, id,
+	).Scan(&batch)
+	if result.Error != nil {
+		return nil, fmt.Errorf("failed to close notification batch: %w", result.Error)
+	}
+
+	if result.RowsAffected == 0 {
+		return nil, models.NewResourceNotFoundError(fmt.Errorf("notification batch with id %d not found: %w", id, gorm.ErrRecordNotFound))
+	}
+
+	domain := toDomainNotificationBatch(batch)
+	return &domain, nil
+}
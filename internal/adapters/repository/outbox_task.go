@@ -0,0 +1,114 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/andrewshostak/result-service/internal/app/models"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type OutboxTaskRepository struct {
+	db *gorm.DB
+}
+
+func NewOutboxTaskRepository(db *gorm.DB) *OutboxTaskRepository {
+	return &OutboxTaskRepository{db: db}
+}
+
+// Create saves a pending outbox task. A match has at most one pending outbox task of a kind, so when it already exists,
+// it gets the attempt number and the execution time of the new one instead.
+func (r *OutboxTaskRepository) Create(ctx context.Context, outboxTask models.OutboxTask) (*models.OutboxTask, error) {
+	toCreate := OutboxTask{
+		Kind:          string(outboxTask.Kind),
+		MatchID:       outboxTask.MatchID,
+		AttemptNumber: outboxTask.AttemptNumber,
+		ExecuteAt:     outboxTask.ExecuteAt,
+	}
+
+	err := conn(ctx, r.db).Clauses(clause.OnConflict{
+		Columns:     []clause.Column{{Name: "match_id"}, {Name: "kind"}},
+		TargetWhere: clause.Where{Exprs: []clause.Expression{clause.Expr{SQL: "status = 'pending'"}}},
+		DoUpdates:   clause.AssignmentColumns([]string{"attempt_number", "execute_at"}),
+	}).Create(&toCreate).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to create outbox task: %w", err)
+	}
+
+	domain := toDomainOutboxTask(toCreate)
+	return &domain, nil
+}
+
+// Lock finds a pending outbox task and locks it until the end of the current transaction.
+// Tasks that are already locked by another transaction are skipped and reported as not found.
+func (r *OutboxTaskRepository) Lock(ctx context.Context, id uint) (*models.OutboxTask, error) {
+	var outboxTask OutboxTask
+
+	result := conn(ctx, r.db).
+		Clauses(clause.Locking{Strength: "UPDATE", Options: "SKIP LOCKED"}).
+		Where("id = ?", id).
+		Where("status = ?", models.OutboxPending).
+		First(&outboxTask)
+
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return nil, models.NewResourceNotFoundError(fmt.Errorf("pending outbox task with id %d not found: %w", id, result.Error))
+		}
+
+		return nil, fmt.Errorf("failed to lock outbox task: %w", result.Error)
+	}
+
+	domain := toDomainOutboxTask(outboxTask)
+	return &domain, nil
+}
+
+// HasPending reports whether the match has a pending outbox task of the kind.
+func (r *OutboxTaskRepository) HasPending(ctx context.Context, matchID uint, kind models.OutboxTaskKind) (bool, error) {
+	var count int64
+
+	result := conn(ctx, r.db).
+		Model(&OutboxTask{}).
+		Where("match_id = ?", matchID).
+		Where("kind = ?", kind).
+		Where("status = ?", models.OutboxPending).
+		Count(&count)
+
+	if result.Error != nil {
+		return false, fmt.Errorf("failed to count pending outbox tasks: %w", result.Error)
+	}
+
+	return count > 0, nil
+}
+
+func (r *OutboxTaskRepository) ListPending(ctx context.Context, createdBefore time.Time) ([]models.OutboxTask, error) {
+	var outboxTasks []OutboxTask
+
+	result := conn(ctx, r.db).
+		Where("status = ?", models.OutboxPending).
+		Where("created_at < ?", createdBefore).
+		Order("created_at, id").
+		Find(&outboxTasks)
+
+	if result.Error != nil {
+		return nil, fmt.Errorf("failed to list pending outbox tasks: %w", result.Error)
+	}
+
+	return toDomainOutboxTasks(outboxTasks), nil
+}
+
+func (r *OutboxTaskRepository) Update(ctx context.Context, id uint, outboxTask models.OutboxTask) error {
+	result := conn(ctx, r.db).Model(&OutboxTask{ID: id}).Updates(OutboxTask{
+		Status:       string(outboxTask.Status),
+		Error:        outboxTask.Error,
+		DispatchedAt: outboxTask.DispatchedAt,
+	})
+
+	if result.Error != nil {
+		return fmt.Errorf("failed to update outbox task: %w", result.Error)
+	}
+
+	return nil
+}
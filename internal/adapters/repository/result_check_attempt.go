@@ -0,0 +1,139 @@
+package repository
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"fmt"
+	"io"
+
+	"github.com/andrewshostak/result-service/internal/app/models"
+	"gorm.io/gorm"
+)
+
+type ResultCheckAttemptRepository struct {
+	db *gorm.DB
+}
+
+func NewResultCheckAttemptRepository(db *gorm.DB) *ResultCheckAttemptRepository {
+	return &ResultCheckAttemptRepository{db: db}
+}
+
+func (r *ResultCheckAttemptRepository) Create(ctx context.Context, attempt models.ResultCheckAttempt) (*models.ResultCheckAttempt, error) {
+	snapshot, err := compress(attempt.Snapshot)
+	if err != nil {
+		return nil, fmt.Errorf("failed to compress snapshot: %w", err)
+	}
+
+	var providerStatus *string
+	if attempt.ProviderStatus != nil {
+		status := string(*attempt.ProviderStatus)
+		providerStatus = &status
+	}
+
+	toCreate := ResultCheckAttempt{
+		MatchID:        attempt.MatchID,
+		AttemptNumber:  attempt.AttemptNumber,
+		ScheduledAt:    attempt.ScheduledAt,
+		ExecutedAt:     attempt.ExecutedAt,
+		ProviderStatus: providerStatus,
+		HomeScore:      attempt.HomeScore,
+		AwayScore:      attempt.AwayScore,
+		Outcome:        string(attempt.Outcome),
+		ErrorMessage:   attempt.ErrorMessage,
+		Snapshot:       snapshot,
+	}
+
+	if err := conn(ctx, r.db).Create(&toCreate).Error; err != nil {
+		return nil, fmt.Errorf("failed to create result check attempt: %w", err)
+	}
+
+	attempt.ID = toCreate.ID
+
+	return &attempt, nil
+}
+
+func (r *ResultCheckAttemptRepository) ListByMatch(ctx context.Context, matchID uint) ([]models.ResultCheckAttempt, error) {
+	var attempts []ResultCheckAttempt
+
+	result := conn(ctx, r.db).
+		Where("match_id = ?", matchID).
+		Order("executed_at, id").
+		Find(&attempts)
+
+	if result.Error != nil {
+		return nil, fmt.Errorf("failed to list result check attempts: %w", result.Error)
+	}
+
+	domain := make([]models.ResultCheckAttempt, 0, len(attempts))
+	for i := range attempts {
+		attempt, err := toDomainResultCheckAttempt(attempts[i])
+		if err != nil {
+			return nil, fmt.Errorf("failed to map result check attempt with id %d: %w", attempts[i].ID, err)
+		}
+
+		domain = append(domain, *attempt)
+	}
+
+	return domain, nil
+}
+
+func toDomainResultCheckAttempt(a ResultCheckAttempt) (*models.ResultCheckAttempt, error) {
+	snapshot, err := decompress(a.Snapshot)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decompress snapshot: %w", err)
+	}
+
+	var providerStatus *models.ExternalMatchStatus
+	if a.ProviderStatus != nil {
+		status := models.ExternalMatchStatus(*a.ProviderStatus)
+		providerStatus = &status
+	}
+
+	return &models.ResultCheckAttempt{
+		ID:             a.ID,
+		MatchID:        a.MatchID,
+		AttemptNumber:  a.AttemptNumber,
+		ScheduledAt:    a.ScheduledAt,
+		ExecutedAt:     a.ExecutedAt,
+		ProviderStatus: providerStatus,
+		HomeScore:      a.HomeScore,
+		AwayScore:      a.AwayScore,
+		Outcome:        models.ResultCheckOutcome(a.Outcome),
+		ErrorMessage:   a.ErrorMessage,
+		Snapshot:       snapshot,
+	}, nil
+}
+
+func compress(data []byte) ([]byte, error) {
+	if len(data) == 0 {
+		return nil, nil
+	}
+
+	var buf bytes.Buffer
+	writer := gzip.NewWriter(&buf)
+	if _, err := writer.Write(data); err != nil {
+		return nil, err
+	}
+
+	if err := writer.Close(); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+func decompress(data []byte) ([]byte, error) {
+	if len(data) == 0 {
+		return nil, nil
+	}
+
+	reader, err := gzip.NewReader(bytes.NewReader(data))
+	if err != nil {
+		return nil, err
+	}
+
+	defer reader.Close()
+
+	return io.ReadAll(reader)
+}
@@ -0,0 +1,80 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/andrewshostak/result-service/internal/app/models"
+	"gorm.io/gorm"
+)
+
+type ResultEventRepository struct {
+	db *gorm.DB
+}
+
+func NewResultEventRepository(db *gorm.DB) *ResultEventRepository {
+	return &ResultEventRepository{db: db}
+}
+
+// Create appends the event to the feed. A finished or cancelled event already stored for the match results in already exists error,
+// corrected events aren't unique as the score of a match can be corrected several times.
+func (r *ResultEventRepository) Create(ctx context.Context, event models.ResultEvent) (*models.ResultEvent, error) {
+	var finishType *string
+	if event.FinishType != nil {
+		mapped := string(*event.FinishType)
+		finishType = &mapped
+	}
+
+	toCreate := ResultEvent{
+		MatchID:    event.MatchID,
+		EventType:  string(event.Type),
+		HomeTeamID: event.HomeTeamID,
+		AwayTeamID: event.AwayTeamID,
+		StartsAt:   event.StartsAt,
+		HomeScore:  event.HomeScore,
+		AwayScore:  event.AwayScore,
+		FinishType: finishType,
+	}
+
+	// ids are taken under the lock, so events are committed in the order of ids and a consumer
+	// which has seen an event can't miss an earlier one committed after it
+	err := conn(ctx, r.db).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Exec("SELECT pg_advisory_xact_lock(hashtext('result_events'))").Error; err != nil {
+			return err
+		}
+
+		return tx.Create(&toCreate).Error
+	})
+	if err != nil {
+		if isDuplicateError(err) {
+			return nil, models.NewResourceAlreadyExistsError(fmt.Errorf("result event %s of match %d already exists: %w", event.Type, event.MatchID, err))
+		}
+
+		return nil, fmt.Errorf("failed to create result event: %w", err)
+	}
+
+	domain := toDomainResultEvent(toCreate)
+	return &domain, nil
+}
+
+// List returns events created after the event with the given id in the order of creation.
+func (r *ResultEventRepository) List(ctx context.Context, filter models.ResultEventFilter) ([]models.ResultEvent, error) {
+	var events []ResultEvent
+
+	result := conn(ctx, r.db).
+		Where("id > ?", filter.AfterID).
+		Order("id").
+		Limit(int(filter.Limit)).
+		Find(&events)
+
+	if result.Error != nil {
+		return nil, fmt.Errorf("failed to list result events: %w", result.Error)
+	}
+
+	domain := make([]models.ResultEvent, 0, len(events))
+	for i := range events {
+		domain = append(domain, toDomainResultEvent(events[i]))
+	}
+
+	return domain, nil
+}
@@ -0,0 +1,125 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/andrewshostak/result-service/internal/app/models"
+	"gorm.io/gorm"
+)
+
+type StandingSubscriptionRepository struct {
+	db     *gorm.DB
+	cipher Cipher
+}
+
+func NewStandingSubscriptionRepository(db *gorm.DB, cipher Cipher) *StandingSubscriptionRepository {
+	return &StandingSubscriptionRepository{db: db, cipher: cipher}
+}
+
+func (r *StandingSubscriptionRepository) Create(ctx context.Context, subscription models.StandingSubscription) (*models.StandingSubscription, error) {
+	key, err := r.cipher.Encrypt(subscription.Key)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encrypt standing subscription key: %w", err)
+	}
+
+	s := StandingSubscription{
+		TeamID:         subscription.TeamID,
+		URLTemplate:    subscription.URLTemplate,
+		Key:            key,
+		PayloadVersion: string(subscription.PayloadVersion),
+		DeliveryFormat: string(subscription.DeliveryFormat),
+		AuthHeader:     subscription.AuthHeader,
+		AuthScheme:     subscription.AuthScheme,
+	}
+
+	if subscription.League != nil {
+		s.LeagueName = &subscription.League.Name
+		s.CountryCode = &subscription.League.CountryCode
+	}
+
+	result := conn(ctx, r.db).Create(&s)
+	if result.Error != nil {
+		if isDuplicateError(result.Error) {
+			return nil, models.NewResourceAlreadyExistsError(fmt.Errorf("standing subscription already exists: %w", result.Error))
+		}
+
+		return nil, fmt.Errorf("failed to create standing subscription: %w", result.Error)
+	}
+
+	return r.toDomain(s)
+}
+
+func (r *StandingSubscriptionRepository) List(ctx context.Context) ([]models.StandingSubscription, error) {
+	var subscriptions []StandingSubscription
+	result := conn(ctx, r.db).Preload("ExternalTeam").Order("id").Find(&subscriptions)
+	if result.Error != nil {
+		return nil, fmt.Errorf("failed to list standing subscriptions: %w", result.Error)
+	}
+
+	mapped := make([]models.StandingSubscription, 0, len(subscriptions))
+	for _, subscription := range subscriptions {
+		domain, err := r.toDomain(subscription)
+		if err != nil {
+			return nil, err
+		}
+
+		mapped = append(mapped, *domain)
+	}
+
+	return mapped, nil
+}
+
+func (r *StandingSubscriptionRepository) Delete(ctx context.Context, id uint) error {
+	result := conn(ctx, r.db).Delete(&StandingSubscription{}, id)
+	if result.Error != nil {
+		return fmt.Errorf("failed to delete standing subscription: %w", result.Error)
+	}
+
+	if result.RowsAffected == 0 {
+		return models.NewResourceNotFoundError(errors.New("standing subscription doesn't exist"))
+	}
+
+	return nil
+}
+
+// ReencryptKeys encrypts the keys which are not encrypted with the current encryption key.
+func (r *StandingSubscriptionRepository) ReencryptKeys(ctx context.Context) (int64, error) {
+	var subscriptions []StandingSubscription
+	result := conn(ctx, r.db).
+		Select("id", "key").
+		Where("key NOT LIKE ?", escapeLike(r.cipher.CurrentPrefix())+"%").
+		Order("id").
+		Find(&subscriptions)
+	if result.Error != nil {
+		return 0, fmt.Errorf("failed to list standing subscriptions to re-encrypt: %w", result.Error)
+	}
+
+	for _, subscription := range subscriptions {
+		key, err := r.cipher.Reencrypt(subscription.Key)
+		if err != nil {
+			return 0, fmt.Errorf("failed to re-encrypt key of standing subscription %d: %w", subscription.ID, err)
+		}
+
+		result := conn(ctx, r.db).Model(&StandingSubscription{ID: subscription.ID}).Update("key", key)
+		if result.Error != nil {
+			return 0, fmt.Errorf("failed to update key of standing subscription %d: %w", subscription.ID, result.Error)
+		}
+	}
+
+	return int64(len(subscriptions)), nil
+}
+
+// toDomain maps the standing subscription with the decrypted key.
+func (r *StandingSubscriptionRepository) toDomain(s StandingSubscription) (*models.StandingSubscription, error) {
+	key, err := r.cipher.Decrypt(s.Key)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decrypt key of standing subscription %d: %w", s.ID, err)
+	}
+
+	s.Key = key
+
+	domain := toDomainStandingSubscription(s)
+	return &domain, nil
+}
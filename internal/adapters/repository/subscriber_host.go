@@ -0,0 +1,102 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/andrewshostak/result-service/internal/app/models"
+	"gorm.io/gorm"
+)
+
+type SubscriberHostRepository struct {
+	db *gorm.DB
+}
+
+func NewSubscriberHostRepository(db *gorm.DB) *SubscriberHostRepository {
+	return &SubscriberHostRepository{db: db}
+}
+
+func (r *SubscriberHostRepository) Get(ctx context.Context, host string) (*models.SubscriberHost, error) {
+	var subscriberHost SubscriberHost
+	result := conn(ctx, r.db).Where("host = ?", host).First(&subscriberHost)
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return nil, models.NewResourceNotFoundError(fmt.Errorf("subscriber host %s not found: %w", host, result.Error))
+		}
+
+		return nil, fmt.Errorf("failed to get subscriber host: %w", result.Error)
+	}
+
+	domain := toDomainSubscriberHost(subscriberHost)
+	return &domain, nil
+}
+
+func (r *SubscriberHostRepository) List(ctx context.Context) ([]models.SubscriberHost, error) {
+	var subscriberHosts []SubscriberHost
+	if result := conn(ctx, r.db).Order("host").Find(&subscriberHosts); result.Error != nil {
+		return nil, fmt.Errorf("failed to list subscriber hosts: %w", result.Error)
+	}
+
+	domain := make([]models.SubscriberHost, 0, len(subscriberHosts))
+	for i := range subscriberHosts {
+		domain = append(domain, toDomainSubscriberHost(subscriberHosts[i]))
+	}
+
+	return domain, nil
+}
+
+// RecordSuccess resets the consecutive failures of the host.
+func (r *SubscriberHostRepository) RecordSuccess(ctx context.Context, host string) error {
+	result := conn(ctx, r.db).Exec(`
+		INSERT INTO subscriber_hosts (host, last_success_at) VALUES (?, now())
+		ON CONFLICT (host) DO UPDATE SET consecutive_failures = 0, last_success_at = now(), updated_at = now()`,
+		host,
+	)
+	if result.Error != nil {
+		return fmt.Errorf("failed to record success of subscriber host: %w", result.Error)
+	}
+
+	return nil
+}
+
+// RecordFailure increments the consecutive failures of the host and suspends it once they reach the threshold.
+// The increment is atomic, so concurrent deliveries to the same host are all counted.
+func (r *SubscriberHostRepository) RecordFailure(ctx context.Context, host string, errMessage string, threshold uint) (*models.SubscriberHost, error) {
+	var subscriberHost SubscriberHost
+	result := conn(ctx, r.db).Raw(`
+		INSERT INTO subscriber_hosts (host, consecutive_failures, last_error, last_failure_at, suspended_at)
+		VALUES (@host, 1, @error, now(), CASE WHEN @threshold <= 1 THEN now() END)
+		ON CONFLICT (host) DO UPDATE SET
+			consecutive_failures = subscriber_hosts.consecutive_failures + 1,
+			last_error = excluded.last_error,
+			last_failure_at = now(),
+			suspended_at = COALESCE(subscriber_hosts.suspended_at, CASE WHEN subscriber_hosts.consecutive_failures + 1 >= @threshold THEN now() END),
+			updated_at = now()
+		RETURNING *`,
+		map[string]any{"host": host, "error": errMessage, "threshold": threshold},
+	).Scan(&subscriberHost)
+	if result.Error != nil {
+		return nil, fmt.Errorf("failed to record failure of subscriber host: %w", result.Error)
+	}
+
+	domain := toDomainSubscriberHost(subscriberHost)
+	return &domain, nil
+}
+
+// Enable lifts the suspension of the host and resets its consecutive failures.
+func (r *SubscriberHostRepository) Enable(ctx context.Context, host string) error {
+	result := conn(ctx, r.db).
+		Model(&SubscriberHost{}).
+		Where("host = ?", host).
+		Updates(map[string]any{"consecutive_failures": 0, "suspended_at": nil})
+	if result.Error != nil {
+		return fmt.Errorf("failed to enable subscriber host: %w", result.Error)
+	}
+
+	if result.RowsAffected == 0 {
+		return models.NewResourceNotFoundError(fmt.Errorf("subscriber host %s not found", host))
+	}
+
+	return nil
+}
@@ -5,26 +5,64 @@ import (
 	"errors"
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/andrewshostak/result-service/internal/app/models"
 	"gorm.io/gorm"
 )
 
+// urlHostPattern captures the host of an http url.
+const urlHostPattern = `(?i)^https?://(\[[^]]*\]|[^/:?#]*)`
+
 type SubscriptionRepository struct {
-	db *gorm.DB
+	db     *gorm.DB
+	cipher Cipher
 }
 
-func NewSubscriptionRepository(db *gorm.DB) *SubscriptionRepository {
-	return &SubscriptionRepository{db: db}
+func NewSubscriptionRepository(db *gorm.DB, cipher Cipher) *SubscriptionRepository {
+	return &SubscriptionRepository{db: db, cipher: cipher}
 }
 
 func (r *SubscriptionRepository) Create(ctx context.Context, subscription models.Subscription) (*models.Subscription, error) {
+	eventTypes := make([]SubscriptionEventType, 0, len(subscription.EventTypes))
+	for _, eventType := range subscription.EventTypes {
+		eventTypes = append(eventTypes, SubscriptionEventType{EventType: string(eventType)})
+	}
+
+	headers := make([]SubscriptionHeader, 0, len(subscription.Headers))
+	for name, value := range subscription.Headers {
+		encrypted, err := r.cipher.Encrypt(value)
+		if err != nil {
+			return nil, fmt.Errorf("failed to encrypt subscription header %s: %w", name, err)
+		}
+
+		headers = append(headers, SubscriptionHeader{Name: name, Value: encrypted})
+	}
+
+	key, err := r.cipher.Encrypt(subscription.Key)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encrypt subscription key: %w", err)
+	}
+
+	keyHash := r.cipher.Hash(subscription.Key)
 	s := Subscription{
-		Url:     subscription.Url,
-		MatchID: subscription.MatchID,
-		Key:     subscription.Key,
+		Url:            subscription.Url,
+		MatchID:        subscription.MatchID,
+		Key:            key,
+		KeyHash:        &keyHash,
+		PayloadVersion: string(subscription.PayloadVersion),
+		DeliveryFormat: string(subscription.DeliveryFormat),
+		Channel:        string(subscription.Channel),
+		HTTPMethod:     subscription.HTTPMethod,
+		AuthHeader:     subscription.AuthHeader,
+		AuthScheme:     subscription.AuthScheme,
+		BatchURL:       subscription.BatchURL,
+		RemindBefore:   subscription.RemindBefore,
+		Status:         string(subscription.Status),
+		EventTypes:     eventTypes,
+		Headers:        headers,
 	}
-	result := r.db.WithContext(ctx).Create(&s)
+	result := conn(ctx, r.db).Create(&s)
 	if result.Error != nil {
 		if errors.Is(result.Error, gorm.ErrForeignKeyViolated) {
 			return nil, models.NewUnprocessableContentError(fmt.Errorf("match id does not exist: %w", result.Error))
@@ -36,18 +74,17 @@ func (r *SubscriptionRepository) Create(ctx context.Context, subscription models
 		return nil, fmt.Errorf("failed to create subscription: %w", result.Error)
 	}
 
-	domain := toDomainSubscription(s)
-	return &domain, nil
+	return r.toDomain(s)
 }
 
 func (r *SubscriptionRepository) Delete(ctx context.Context, id uint) error {
-	result := r.db.WithContext(ctx).Delete(&Subscription{}, id)
+	result := conn(ctx, r.db).Delete(&Subscription{}, id)
 	if result.Error != nil {
 		return fmt.Errorf("failed to delete subscription: %w", result.Error)
 	}
 
 	if result.RowsAffected == 0 {
-		return errors.New("subscription doesn't exist")
+		return models.NewResourceNotFoundError(errors.New("subscription doesn't exist"))
 	}
 
 	return nil
@@ -55,7 +92,9 @@ func (r *SubscriptionRepository) Delete(ctx context.Context, id uint) error {
 
 func (r *SubscriptionRepository) Get(ctx context.Context, id uint) (*models.Subscription, error) {
 	var subscription Subscription
-	result := r.db.WithContext(ctx).
+	result := conn(ctx, r.db).
+		Preload("EventTypes").
+		Preload("Headers").
 		Where("id = ?", id).
 		First(&subscription)
 
@@ -66,16 +105,15 @@ func (r *SubscriptionRepository) Get(ctx context.Context, id uint) (*models.Subs
 		return nil, fmt.Errorf("failed to get subscription by id: %w", result.Error)
 	}
 
-	domain := toDomainSubscription(subscription)
-	return &domain, nil
+	return r.toDomain(subscription)
 }
 
 func (r *SubscriptionRepository) One(ctx context.Context, matchID uint, key string, baseURL string) (*models.Subscription, error) {
 	var subscription Subscription
-	result := r.db.WithContext(ctx).
+	result := conn(ctx, r.db).
 		Where("match_id = ?", matchID).
 		Where("url LIKE ?", baseURL+"%").
-		Where("key = ?", key).
+		Where("key_hash = ?", r.cipher.Hash(key)).
 		First(&subscription)
 
 	if result.Error != nil {
@@ -86,13 +124,12 @@ func (r *SubscriptionRepository) One(ctx context.Context, matchID uint, key stri
 		return nil, fmt.Errorf("failed to find subscription: %w", result.Error)
 	}
 
-	domain := toDomainSubscription(subscription)
-	return &domain, nil
+	return r.toDomain(subscription)
 }
 
 func (r *SubscriptionRepository) List(ctx context.Context, matchID uint) ([]models.Subscription, error) {
 	var subscriptions []Subscription
-	result := r.db.WithContext(ctx).
+	result := conn(ctx, r.db).
 		Where("match_id = ?", matchID).
 		Find(&subscriptions)
 
@@ -100,12 +137,48 @@ func (r *SubscriptionRepository) List(ctx context.Context, matchID uint) ([]mode
 		return nil, fmt.Errorf("failed to list subscriptions by match id: %w", result.Error)
 	}
 
-	return toDomainSubscriptions(subscriptions), nil
+	return r.toDomainList(subscriptions)
+}
+
+func (r *SubscriptionRepository) Search(ctx context.Context, filter models.SubscriptionFilter) ([]models.Subscription, error) {
+	query := conn(ctx, r.db).Preload("EventTypes").Preload("Headers")
+
+	if filter.MatchID != nil {
+		query = query.Where("match_id = ?", *filter.MatchID)
+	}
+
+	if filter.NotificationBatchID != nil {
+		query = query.Where("notification_batch_id = ?", *filter.NotificationBatchID)
+	}
+
+	if filter.Status != nil {
+		query = query.Where("status = ?", *filter.Status)
+	}
+
+	if filter.URL != "" {
+		query = query.Where("url = ?", filter.URL)
+	}
+
+	if filter.URLPrefix != "" {
+		query = query.Where("url LIKE ?", escapeLike(filter.URLPrefix)+"%")
+	}
+
+	if filter.Host != "" {
+		// urls carry no credentials, so the host follows the scheme, ipv6 hosts are compared without brackets
+		query = query.Where("trim(both '[]' from lower(substring(url from ?))) = ?", urlHostPattern, strings.ToLower(filter.Host))
+	}
+
+	var subscriptions []Subscription
+	if result := query.Order("id").Find(&subscriptions); result.Error != nil {
+		return nil, fmt.Errorf("failed to search subscriptions: %w", result.Error)
+	}
+
+	return r.toDomainList(subscriptions)
 }
 
 func (r *SubscriptionRepository) ListByMatchAndStatus(ctx context.Context, matchID uint, status models.SubscriptionStatus) ([]models.Subscription, error) {
 	var subscriptions []Subscription
-	result := r.db.WithContext(ctx).
+	result := conn(ctx, r.db).
 		Where("status = ?", status).
 		Where("match_id = ?", matchID).
 		Find(&subscriptions)
@@ -114,17 +187,76 @@ func (r *SubscriptionRepository) ListByMatchAndStatus(ctx context.Context, match
 		return nil, fmt.Errorf("failed to list subscriptions by match id and status: %w", result.Error)
 	}
 
-	return toDomainSubscriptions(subscriptions), nil
+	return r.toDomainList(subscriptions)
+}
+
+// ListByMatchAndEventType returns subscriptions of the match which have chosen the event type.
+func (r *SubscriptionRepository) ListByMatchAndEventType(ctx context.Context, matchID uint, eventType models.NotificationEventType) ([]models.Subscription, error) {
+	var subscriptions []Subscription
+	result := conn(ctx, r.db).
+		Joins("JOIN subscription_event_types ON subscription_event_types.subscription_id = subscriptions.id").
+		Where("subscriptions.match_id = ?", matchID).
+		Where("subscription_event_types.event_type = ?", eventType).
+		Find(&subscriptions)
+
+	if result.Error != nil {
+		return nil, fmt.Errorf("failed to list subscriptions by match id and event type: %w", result.Error)
+	}
+
+	return r.toDomainList(subscriptions)
+}
+
+// ListWithReminderByMatch returns subscriptions of the match which have asked to be reminded about the kickoff.
+func (r *SubscriptionRepository) ListWithReminderByMatch(ctx context.Context, matchID uint) ([]models.Subscription, error) {
+	var subscriptions []Subscription
+	result := conn(ctx, r.db).
+		Where("match_id = ?", matchID).
+		Where("remind_before IS NOT NULL").
+		Find(&subscriptions)
+
+	if result.Error != nil {
+		return nil, fmt.Errorf("failed to list subscriptions with reminder by match id: %w", result.Error)
+	}
+
+	return r.toDomainList(subscriptions)
 }
 
+func (r *SubscriptionRepository) ListByStatusAndMatchStatus(ctx context.Context, status models.SubscriptionStatus, resultStatus models.ResultStatus) ([]models.Subscription, error) {
+	var subscriptions []Subscription
+	result := conn(ctx, r.db).
+		Joins("Match").
+		Where("subscriptions.status = ?", status).
+		Where(`"Match".result_status = ?`, resultStatus).
+		Find(&subscriptions)
+
+	if result.Error != nil {
+		return nil, fmt.Errorf("failed to list subscriptions by status and match result status: %w", result.Error)
+	}
+
+	return r.toDomainList(subscriptions)
+}
+
+// Update writes the status and the subscriber error of the subscription, so a status change without an error clears it.
+// Notification time and delivery attempts are written only when they are set, so a status change keeps them.
 func (r *SubscriptionRepository) Update(ctx context.Context, id uint, subscription models.Subscription) error {
 	sub := Subscription{ID: id}
 	s := Subscription{
-		Status:          string(subscription.Status),
-		SubscriberError: subscription.SubscriberError,
-		NotifiedAt:      subscription.NotifiedAt,
+		Status:           string(subscription.Status),
+		SubscriberError:  subscription.SubscriberError,
+		NotifiedAt:       subscription.NotifiedAt,
+		DeliveryAttempts: subscription.DeliveryAttempts,
 	}
-	result := r.db.WithContext(ctx).Model(&sub).Select("Status", "NotifiedAt", "SubscriberError").Updates(s)
+
+	fields := []string{"Status", "SubscriberError"}
+	if subscription.NotifiedAt != nil {
+		fields = append(fields, "NotifiedAt")
+	}
+
+	if subscription.DeliveryAttempts != 0 {
+		fields = append(fields, "DeliveryAttempts")
+	}
+
+	result := conn(ctx, r.db).Model(&sub).Select(fields).Updates(s)
 	if result.Error != nil {
 		return fmt.Errorf("failed to update subscription: %w", result.Error)
 	}
@@ -132,6 +264,172 @@ func (r *SubscriptionRepository) Update(ctx context.Context, id uint, subscripti
 	return nil
 }
 
+// RotateKey replaces the key of all subscriptions having it. The replaced key is kept as the previous one
+// and is used for signing deliveries alongside the new key until previousKeyTill.
+func (r *SubscriptionRepository) RotateKey(ctx context.Context, key string, newKey string, previousKeyTill time.Time) (int64, error) {
+	encrypted, err := r.cipher.Encrypt(newKey)
+	if err != nil {
+		return 0, fmt.Errorf("failed to encrypt subscription key: %w", err)
+	}
+
+	result := conn(ctx, r.db).
+		Model(&Subscription{}).
+		Where("key_hash = ?", r.cipher.Hash(key)).
+		Updates(map[string]any{
+			"key":               encrypted,
+			"key_hash":          r.cipher.Hash(newKey),
+			"previous_key":      gorm.Expr("key"),
+			"previous_key_till": previousKeyTill,
+		})
+	if result.Error != nil {
+		return 0, fmt.Errorf("failed to rotate subscriptions key: %w", result.Error)
+	}
+
+	return result.RowsAffected, nil
+}
+
+func (r *SubscriptionRepository) UpdateStatusByMatch(ctx context.Context, matchID uint, from models.SubscriptionStatus, to models.SubscriptionStatus) error {
+	result := conn(ctx, r.db).
+		Model(&Subscription{}).
+		Where("match_id = ?", matchID).
+		Where("status = ?", from).
+		Update("status", to)
+	if result.Error != nil {
+		return fmt.Errorf("failed to update subscriptions status by match: %w", result.Error)
+	}
+
+	return nil
+}
+
+// ReencryptKeys encrypts the keys which are not encrypted with the current encryption key.
+// Plaintext keys of subscriptions created before the encryption are encrypted and hashed, encrypted keys are re-wrapped.
+func (r *SubscriptionRepository) ReencryptKeys(ctx context.Context) (int64, error) {
+	pattern := escapeLike(r.cipher.CurrentPrefix()) + "%"
+
+	var subscriptions []Subscription
+	result := conn(ctx, r.db).
+		Select("id", "key", "previous_key").
+		Where("key NOT LIKE ? OR previous_key NOT LIKE ?", pattern, pattern).
+		Order("id").
+		Find(&subscriptions)
+	if result.Error != nil {
+		return 0, fmt.Errorf("failed to list subscriptions to re-encrypt: %w", result.Error)
+	}
+
+	for _, subscription := range subscriptions {
+		updates := map[string]any{}
+
+		key, err := r.cipher.Reencrypt(subscription.Key)
+		if err != nil {
+			return 0, fmt.Errorf("failed to re-encrypt key of subscription %d: %w", subscription.ID, err)
+		}
+
+		updates["key"] = key
+		if !r.cipher.IsEncrypted(subscription.Key) {
+			updates["key_hash"] = r.cipher.Hash(subscription.Key)
+		}
+
+		if subscription.PreviousKey != nil {
+			previousKey, err := r.cipher.Reencrypt(*subscription.PreviousKey)
+			if err != nil {
+				return 0, fmt.Errorf("failed to re-encrypt previous key of subscription %d: %w", subscription.ID, err)
+			}
+
+			updates["previous_key"] = previousKey
+		}
+
+		result := conn(ctx, r.db).Model(&Subscription{ID: subscription.ID}).Updates(updates)
+		if result.Error != nil {
+			return 0, fmt.Errorf("failed to update keys of subscription %d: %w", subscription.ID, result.Error)
+		}
+	}
+
+	return int64(len(subscriptions)), nil
+}
+
+// ReencryptHeaders encrypts the custom header values which are not encrypted with the current encryption key.
+// Values of headers stored before the encryption are encrypted, encrypted values are re-wrapped.
+func (r *SubscriptionRepository) ReencryptHeaders(ctx context.Context) (int64, error) {
+	var headers []SubscriptionHeader
+	result := conn(ctx, r.db).
+		Where("value NOT LIKE ?", escapeLike(r.cipher.CurrentPrefix())+"%").
+		Order("subscription_id").
+		Find(&headers)
+	if result.Error != nil {
+		return 0, fmt.Errorf("failed to list subscription headers to re-encrypt: %w", result.Error)
+	}
+
+	for _, header := range headers {
+		value, err := r.cipher.Reencrypt(header.Value)
+		if err != nil {
+			return 0, fmt.Errorf("failed to re-encrypt header %s of subscription %d: %w", header.Name, header.SubscriptionID, err)
+		}
+
+		result := conn(ctx, r.db).
+			Model(&SubscriptionHeader{SubscriptionID: header.SubscriptionID, Name: header.Name}).
+			Update("value", value)
+		if result.Error != nil {
+			return 0, fmt.Errorf("failed to update header %s of subscription %d: %w", header.Name, header.SubscriptionID, result.Error)
+		}
+	}
+
+	return int64(len(headers)), nil
+}
+
+// toDomain maps the subscription with decrypted keys and header values.
+func (r *SubscriptionRepository) toDomain(s Subscription) (*models.Subscription, error) {
+	key, err := r.cipher.Decrypt(s.Key)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decrypt key of subscription %d: %w", s.ID, err)
+	}
+
+	s.Key = key
+
+	if s.PreviousKey != nil {
+		previousKey, err := r.cipher.Decrypt(*s.PreviousKey)
+		if err != nil {
+			return nil, fmt.Errorf("failed to decrypt previous key of subscription %d: %w", s.ID, err)
+		}
+
+		s.PreviousKey = &previousKey
+	}
+
+	headers := make([]SubscriptionHeader, 0, len(s.Headers))
+	for _, header := range s.Headers {
+		value, err := r.cipher.Decrypt(header.Value)
+		if err != nil {
+			return nil, fmt.Errorf("failed to decrypt header %s of subscription %d: %w", header.Name, s.ID, err)
+		}
+
+		header.Value = value
+		headers = append(headers, header)
+	}
+
+	s.Headers = headers
+
+	domain := toDomainSubscription(s)
+	return &domain, nil
+}
+
+func (r *SubscriptionRepository) toDomainList(s []Subscription) ([]models.Subscription, error) {
+	subscriptions := make([]models.Subscription, 0, len(s))
+	for i := range s {
+		subscription, err := r.toDomain(s[i])
+		if err != nil {
+			return nil, err
+		}
+
+		subscriptions = append(subscriptions, *subscription)
+	}
+
+	return subscriptions, nil
+}
+
+// escapeLike escapes the wildcards of a LIKE pattern, so the value is matched literally.
+func escapeLike(value string) string {
+	return strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`).Replace(value)
+}
+
 func isDuplicateError(err error) bool {
 	if errors.Is(err, gorm.ErrDuplicatedKey) {
 		return true
@@ -8,14 +8,21 @@ import (
 	"github.com/rs/zerolog"
 )
 
+type UnitOfWork interface {
+	Do(ctx context.Context, fn func(ctx context.Context) error) error
+}
+
 type AliasRepository interface {
 	Find(ctx context.Context, alias string) (*models.Alias, error)
 }
 
 type MatchRepository interface {
 	One(ctx context.Context, search models.Match) (*models.Match, error)
+	ListScheduledBefore(ctx context.Context, executeAt time.Time) ([]models.Match, error)
 	Save(ctx context.Context, id *uint, match models.Match) (*models.Match, error)
-	Update(ctx context.Context, id uint, resultStatus models.ResultStatus) (*models.Match, error)
+	Update(ctx context.Context, transition models.MatchStatusTransition) (*models.Match, error)
+	UpdateStartsAt(ctx context.Context, id uint, startsAt time.Time) error
+	ListStatusHistory(ctx context.Context, matchID uint) ([]models.MatchStatusTransition, error)
 }
 
 type ExternalMatchRepository interface {
@@ -26,9 +33,46 @@ type CheckResultTaskRepository interface {
 	Save(ctx context.Context, checkResultTask models.CheckResultTask) (*models.CheckResultTask, error)
 }
 
+type OutboxTaskRepository interface {
+	Create(ctx context.Context, outboxTask models.OutboxTask) (*models.OutboxTask, error)
+	Lock(ctx context.Context, id uint) (*models.OutboxTask, error)
+	ListPending(ctx context.Context, createdBefore time.Time) ([]models.OutboxTask, error)
+	Update(ctx context.Context, id uint, outboxTask models.OutboxTask) error
+}
+
+type ResultCheckAttemptRepository interface {
+	Create(ctx context.Context, attempt models.ResultCheckAttempt) (*models.ResultCheckAttempt, error)
+	ListByMatch(ctx context.Context, matchID uint) ([]models.ResultCheckAttempt, error)
+}
+
+type ResultEventRepository interface {
+	Create(ctx context.Context, event models.ResultEvent) (*models.ResultEvent, error)
+	List(ctx context.Context, filter models.ResultEventFilter) ([]models.ResultEvent, error)
+}
+
+type MatchUpdateRepository interface {
+	Create(ctx context.Context, update models.MatchUpdate) (*models.MatchUpdate, error)
+	List(ctx context.Context, filter models.MatchUpdateFilter) ([]models.MatchUpdate, error)
+	LastID(ctx context.Context) (uint, error)
+}
+
+type MatchEventRepository interface {
+	Create(ctx context.Context, event models.MatchEvent) (*models.MatchEvent, error)
+}
+
+type EventDeliveryRepository interface {
+	Create(ctx context.Context, delivery models.EventDelivery) (*models.EventDelivery, error)
+	ListByStatus(ctx context.Context, status models.SubscriptionStatus) ([]models.EventDelivery, error)
+	Update(ctx context.Context, id uint, delivery models.EventDelivery) error
+}
+
 type SubscriptionRepository interface {
+	ListByMatchAndEventType(ctx context.Context, matchID uint, eventType models.NotificationEventType) ([]models.Subscription, error)
 	ListByMatchAndStatus(ctx context.Context, matchID uint, status models.SubscriptionStatus) ([]models.Subscription, error)
+	ListByStatusAndMatchStatus(ctx context.Context, status models.SubscriptionStatus, resultStatus models.ResultStatus) ([]models.Subscription, error)
+	ListWithReminderByMatch(ctx context.Context, matchID uint) ([]models.Subscription, error)
 	Update(ctx context.Context, id uint, subscription models.Subscription) error
+	UpdateStatusByMatch(ctx context.Context, matchID uint, from models.SubscriptionStatus, to models.SubscriptionStatus) error
 }
 
 type ExternalAPIClient interface {
@@ -38,7 +82,19 @@ type ExternalAPIClient interface {
 type TaskClient interface {
 	GetResultCheckTask(ctx context.Context, matchID uint, attempt uint) (*models.Task, error)
 	ScheduleResultCheck(ctx context.Context, matchID uint, attempt uint, scheduleAt time.Time) (*models.Task, error)
-	ScheduleSubscriberNotification(ctx context.Context, subscriptionID uint) error
+	ScheduleLiveCheck(ctx context.Context, matchID uint, sequence uint, scheduleAt time.Time) error
+	GetSubscriberNotificationTask(ctx context.Context, subscriptionID uint) (*models.Task, error)
+	ScheduleSubscriberNotification(ctx context.Context, subscriptionID uint, redeliveryID string) error
+	ScheduleEventDelivery(ctx context.Context, eventDeliveryID uint, attempt uint, scheduleAt time.Time) error
+	ScheduleKickoffReminder(ctx context.Context, subscriptionID uint, kickoff time.Time, scheduleAt time.Time) error
+}
+
+type OutboxDispatcher interface {
+	Dispatch(ctx context.Context, id uint) error
+}
+
+type EventPublisher interface {
+	Publish(ctx context.Context, event models.MatchEvent) error
 }
 
 type Logger interface {
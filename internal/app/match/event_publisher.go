@@ -0,0 +1,107 @@
+package match
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/andrewshostak/result-service/internal/app/models"
+	"github.com/google/uuid"
+)
+
+// EventPublisherService stores match events observed by the result checker and fans them out to the subscriptions
+// which have chosen the event type. The event and its deliveries are saved in one transaction, and an event that is
+// already stored is skipped, so an event observed by several checks is delivered only once.
+type EventPublisherService struct {
+	unitOfWork              UnitOfWork
+	matchEventRepository    MatchEventRepository
+	eventDeliveryRepository EventDeliveryRepository
+	subscriptionRepository  SubscriptionRepository
+	taskClient              TaskClient
+	logger                  Logger
+}
+
+func NewEventPublisherService(
+	unitOfWork UnitOfWork,
+	matchEventRepository MatchEventRepository,
+	eventDeliveryRepository EventDeliveryRepository,
+	subscriptionRepository SubscriptionRepository,
+	taskClient TaskClient,
+	logger Logger,
+) *EventPublisherService {
+	return &EventPublisherService{
+		unitOfWork:              unitOfWork,
+		matchEventRepository:    matchEventRepository,
+		eventDeliveryRepository: eventDeliveryRepository,
+		subscriptionRepository:  subscriptionRepository,
+		taskClient:              taskClient,
+		logger:                  logger,
+	}
+}
+
+func (s *EventPublisherService) Publish(ctx context.Context, event models.MatchEvent) error {
+	var deliveries []models.EventDelivery
+
+	err := s.unitOfWork.Do(ctx, func(ctx context.Context) error {
+		created, err := s.matchEventRepository.Create(ctx, event)
+		if errors.As(err, &models.ResourceAlreadyExistsError{}) {
+			s.logger.Debug().Uint("match_id", event.MatchID).Str("key", event.Key).Msg("match event is already published")
+			return nil
+		}
+
+		if err != nil {
+			return fmt.Errorf("failed to create match event: %w", err)
+		}
+
+		subscriptions, err := s.subscriptionRepository.ListByMatchAndEventType(ctx, event.MatchID, event.Type)
+		if err != nil {
+			return fmt.Errorf("failed to get subscriptions: %w", err)
+		}
+
+		for _, subscription := range subscriptions {
+			// endpoints which aren't verified yet and unsubscribed ones don't receive events
+			if subscription.Status == models.UnverifiedSub || subscription.Status == models.UnsubscribedSub {
+				continue
+			}
+
+			delivery, err := s.eventDeliveryRepository.Create(ctx, models.EventDelivery{
+				MatchEventID:   created.ID,
+				SubscriptionID: subscription.ID,
+				DeliveryID:     uuid.NewString(),
+			})
+			if err != nil {
+				return fmt.Errorf("failed to create event delivery: %w", err)
+			}
+
+			deliveries = append(deliveries, *delivery)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("failed to publish match event %s: %w", event.Key, err)
+	}
+
+	for _, delivery := range deliveries {
+		s.scheduleDelivery(ctx, delivery)
+	}
+
+	return nil
+}
+
+// scheduleDelivery creates the task of the first delivery attempt.
+// The event is already stored, so a scheduling failure is recorded in the delivery instead of failing the publishing.
+func (s *EventPublisherService) scheduleDelivery(ctx context.Context, delivery models.EventDelivery) {
+	err := s.taskClient.ScheduleEventDelivery(ctx, delivery.ID, 0, time.Now())
+	if err == nil || errors.As(err, &models.ResourceAlreadyExistsError{}) {
+		return
+	}
+
+	s.logger.Error().Err(err).Uint("event_delivery_id", delivery.ID).Msg("failed to schedule event delivery task")
+
+	errUpdate := s.eventDeliveryRepository.Update(ctx, delivery.ID, models.EventDelivery{Status: models.SchedulingErrorSub})
+	if errUpdate != nil {
+		s.logger.Error().Err(errUpdate).Uint("event_delivery_id", delivery.ID).Msg(fmt.Sprintf("failed to update event delivery status to: %s", string(models.SchedulingErrorSub)))
+	}
+}
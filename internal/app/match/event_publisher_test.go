@@ -0,0 +1,218 @@
+package match_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/andrewshostak/result-service/internal/app/match"
+	"github.com/andrewshostak/result-service/internal/app/match/mocks"
+	"github.com/andrewshostak/result-service/internal/app/models"
+	loggerinternal "github.com/andrewshostak/result-service/internal/infra/logger"
+	"github.com/andrewshostak/result-service/testutils"
+	"github.com/brianvoe/gofakeit/v6"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestEventPublisherService_Publish(t *testing.T) {
+	ctx := context.Background()
+	unexpectedErr := errors.New("unexpected error")
+	matchID := uint(gofakeit.Uint8())
+
+	event := models.MatchEvent{
+		MatchID:   matchID,
+		Type:      models.EventMatchGoal,
+		Key:       "goal-1-0",
+		HomeScore: 1,
+		StartsAt:  time.Now(),
+	}
+
+	createdEvent := event
+	createdEvent.ID = uint(gofakeit.Uint16())
+
+	subscription := testutils.FakeSubscription(func(r *models.Subscription) {
+		r.MatchID = matchID
+		r.Status = models.PendingSub
+	})
+
+	unverifiedSubscription := testutils.FakeSubscription(func(r *models.Subscription) {
+		r.ID = subscription.ID + 1
+		r.MatchID = matchID
+		r.Status = models.UnverifiedSub
+	})
+
+	delivery := models.EventDelivery{
+		ID:             uint(gofakeit.Uint16()),
+		MatchEventID:   createdEvent.ID,
+		SubscriptionID: subscription.ID,
+	}
+
+	deliveryMatcher := mock.MatchedBy(func(actual models.EventDelivery) bool {
+		return actual.MatchEventID == createdEvent.ID && actual.SubscriptionID == subscription.ID && actual.DeliveryID != ""
+	})
+
+	tests := []struct {
+		name                    string
+		expectedErr             error
+		matchEventRepository    func(t *testing.T) *mocks.MatchEventRepository
+		eventDeliveryRepository func(t *testing.T) *mocks.EventDeliveryRepository
+		subscriptionRepository  func(t *testing.T) *mocks.SubscriptionRepository
+		taskClient              func(t *testing.T) *mocks.TaskClient
+	}{
+		{
+			name: "success - it skips the event which is already published",
+			matchEventRepository: func(t *testing.T) *mocks.MatchEventRepository {
+				t.Helper()
+				m := mocks.NewMatchEventRepository(t)
+				m.On("Create", ctx, event).Return(nil, models.NewResourceAlreadyExistsError(unexpectedErr)).Once()
+				return m
+			},
+		},
+		{
+			name: "it returns an error when event creation fails",
+			matchEventRepository: func(t *testing.T) *mocks.MatchEventRepository {
+				t.Helper()
+				m := mocks.NewMatchEventRepository(t)
+				m.On("Create", ctx, event).Return(nil, unexpectedErr).Once()
+				return m
+			},
+			expectedErr: fmt.Errorf("failed to publish match event %s: %w", event.Key, fmt.Errorf("failed to create match event: %w", unexpectedErr)),
+		},
+		{
+			name: "it returns an error when subscriptions retrieval fails",
+			matchEventRepository: func(t *testing.T) *mocks.MatchEventRepository {
+				t.Helper()
+				m := mocks.NewMatchEventRepository(t)
+				m.On("Create", ctx, event).Return(&createdEvent, nil).Once()
+				return m
+			},
+			subscriptionRepository: func(t *testing.T) *mocks.SubscriptionRepository {
+				t.Helper()
+				m := mocks.NewSubscriptionRepository(t)
+				m.On("ListByMatchAndEventType", ctx, matchID, event.Type).Return(nil, unexpectedErr).Once()
+				return m
+			},
+			expectedErr: fmt.Errorf("failed to get subscriptions: %w", unexpectedErr),
+		},
+		{
+			name: "it returns an error when event delivery creation fails",
+			matchEventRepository: func(t *testing.T) *mocks.MatchEventRepository {
+				t.Helper()
+				m := mocks.NewMatchEventRepository(t)
+				m.On("Create", ctx, event).Return(&createdEvent, nil).Once()
+				return m
+			},
+			subscriptionRepository: func(t *testing.T) *mocks.SubscriptionRepository {
+				t.Helper()
+				m := mocks.NewSubscriptionRepository(t)
+				m.On("ListByMatchAndEventType", ctx, matchID, event.Type).Return([]models.Subscription{subscription}, nil).Once()
+				return m
+			},
+			eventDeliveryRepository: func(t *testing.T) *mocks.EventDeliveryRepository {
+				t.Helper()
+				m := mocks.NewEventDeliveryRepository(t)
+				m.On("Create", ctx, deliveryMatcher).Return(nil, unexpectedErr).Once()
+				return m
+			},
+			expectedErr: fmt.Errorf("failed to create event delivery: %w", unexpectedErr),
+		},
+		{
+			name: "success - it records scheduling error when delivery task creation fails",
+			matchEventRepository: func(t *testing.T) *mocks.MatchEventRepository {
+				t.Helper()
+				m := mocks.NewMatchEventRepository(t)
+				m.On("Create", ctx, event).Return(&createdEvent, nil).Once()
+				return m
+			},
+			subscriptionRepository: func(t *testing.T) *mocks.SubscriptionRepository {
+				t.Helper()
+				m := mocks.NewSubscriptionRepository(t)
+				m.On("ListByMatchAndEventType", ctx, matchID, event.Type).Return([]models.Subscription{subscription}, nil).Once()
+				return m
+			},
+			eventDeliveryRepository: func(t *testing.T) *mocks.EventDeliveryRepository {
+				t.Helper()
+				m := mocks.NewEventDeliveryRepository(t)
+				m.On("Create", ctx, deliveryMatcher).Return(&delivery, nil).Once()
+				m.On("Update", ctx, delivery.ID, models.EventDelivery{Status: models.SchedulingErrorSub}).Return(nil).Once()
+				return m
+			},
+			taskClient: func(t *testing.T) *mocks.TaskClient {
+				t.Helper()
+				m := mocks.NewTaskClient(t)
+				m.On("ScheduleEventDelivery", ctx, delivery.ID, uint(0), mock.Anything).Return(unexpectedErr).Once()
+				return m
+			},
+		},
+		{
+			name: "success - it creates deliveries of the event to verified subscriptions and schedules them",
+			matchEventRepository: func(t *testing.T) *mocks.MatchEventRepository {
+				t.Helper()
+				m := mocks.NewMatchEventRepository(t)
+				m.On("Create", ctx, event).Return(&createdEvent, nil).Once()
+				return m
+			},
+			subscriptionRepository: func(t *testing.T) *mocks.SubscriptionRepository {
+				t.Helper()
+				m := mocks.NewSubscriptionRepository(t)
+				m.On("ListByMatchAndEventType", ctx, matchID, event.Type).Return([]models.Subscription{subscription, unverifiedSubscription}, nil).Once()
+				return m
+			},
+			eventDeliveryRepository: func(t *testing.T) *mocks.EventDeliveryRepository {
+				t.Helper()
+				m := mocks.NewEventDeliveryRepository(t)
+				m.On("Create", ctx, deliveryMatcher).Return(&delivery, nil).Once()
+				return m
+			},
+			taskClient: func(t *testing.T) *mocks.TaskClient {
+				t.Helper()
+				m := mocks.NewTaskClient(t)
+				m.On("ScheduleEventDelivery", ctx, delivery.ID, uint(0), mock.Anything).Return(nil).Once()
+				return m
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var matchEventRepository *mocks.MatchEventRepository
+			if tt.matchEventRepository != nil {
+				matchEventRepository = tt.matchEventRepository(t)
+			}
+
+			var eventDeliveryRepository *mocks.EventDeliveryRepository
+			if tt.eventDeliveryRepository != nil {
+				eventDeliveryRepository = tt.eventDeliveryRepository(t)
+			}
+
+			var subscriptionRepository *mocks.SubscriptionRepository
+			if tt.subscriptionRepository != nil {
+				subscriptionRepository = tt.subscriptionRepository(t)
+			}
+
+			var taskClient *mocks.TaskClient
+			if tt.taskClient != nil {
+				taskClient = tt.taskClient(t)
+			}
+
+			eps := match.NewEventPublisherService(
+				passThroughUnitOfWork(t),
+				matchEventRepository,
+				eventDeliveryRepository,
+				subscriptionRepository,
+				taskClient,
+				loggerinternal.SetupLogger(),
+			)
+
+			err := eps.Publish(ctx, event)
+			if tt.expectedErr != nil {
+				assert.ErrorContains(t, err, tt.expectedErr.Error())
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
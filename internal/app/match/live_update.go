@@ -0,0 +1,83 @@
+package match
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/andrewshostak/result-service/config"
+	"github.com/andrewshostak/result-service/internal/app/models"
+)
+
+// LiveUpdateService streams changes of the status and the score observed by result checks to live viewers.
+type LiveUpdateService struct {
+	config                config.LiveUpdates
+	matchUpdateRepository MatchUpdateRepository
+}
+
+func NewLiveUpdateService(config config.LiveUpdates, matchUpdateRepository MatchUpdateRepository) *LiveUpdateService {
+	return &LiveUpdateService{
+		config:                config,
+		matchUpdateRepository: matchUpdateRepository,
+	}
+}
+
+// Watch sends the match updates in the order of their ids until the context is done or send fails.
+// An idle stream gets send called without updates at the heartbeat interval, so the connection is kept alive.
+func (s *LiveUpdateService) Watch(ctx context.Context, request models.WatchMatchUpdatesRequest, send func([]models.MatchUpdate) error) error {
+	filter := models.MatchUpdateFilter{MatchIDs: request.MatchIDs, Limit: s.config.BatchSize}
+
+	if request.LastEventID != nil {
+		filter.AfterID = *request.LastEventID
+	} else {
+		lastID, err := s.matchUpdateRepository.LastID(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to get last match update id: %w", err)
+		}
+
+		filter.AfterID = lastID
+	}
+
+	ticker := time.NewTicker(s.config.PollInterval)
+	defer ticker.Stop()
+
+	lastSentAt := time.Now()
+
+	for {
+		updates, err := s.matchUpdateRepository.List(ctx, filter)
+		if err != nil {
+			if ctx.Err() != nil {
+				return nil
+			}
+
+			return fmt.Errorf("failed to list match updates: %w", err)
+		}
+
+		switch {
+		case len(updates) > 0:
+			if err := send(updates); err != nil {
+				return fmt.Errorf("failed to send match updates: %w", err)
+			}
+
+			filter.AfterID = updates[len(updates)-1].ID
+			lastSentAt = time.Now()
+
+			// there can be more updates than fit in one read
+			if uint(len(updates)) == filter.Limit {
+				continue
+			}
+		case time.Since(lastSentAt) >= s.config.HeartbeatInterval:
+			if err := send(nil); err != nil {
+				return fmt.Errorf("failed to send heartbeat: %w", err)
+			}
+
+			lastSentAt = time.Now()
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
@@ -0,0 +1,135 @@
+package match_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/andrewshostak/result-service/config"
+	"github.com/andrewshostak/result-service/internal/app/match"
+	"github.com/andrewshostak/result-service/internal/app/match/mocks"
+	"github.com/andrewshostak/result-service/internal/app/models"
+	"github.com/brianvoe/gofakeit/v6"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestLiveUpdateService_Watch(t *testing.T) {
+	unexpectedErr := errors.New("unexpected error")
+
+	cfg := config.LiveUpdates{PollInterval: 10 * time.Millisecond, HeartbeatInterval: 30 * time.Millisecond, BatchSize: 2}
+
+	lastID := uint(gofakeit.Uint16())
+	matchID := uint(gofakeit.Uint8())
+	updates := []models.MatchUpdate{
+		{ID: lastID + 1, MatchID: matchID, Status: models.StatusMatchInProgress},
+		{ID: lastID + 2, MatchID: matchID, Status: models.StatusMatchInProgress, HomeScore: 1},
+		{ID: lastID + 4, MatchID: matchID, Status: models.StatusMatchFinished, HomeScore: 1},
+	}
+
+	tests := []struct {
+		name                  string
+		input                 models.WatchMatchUpdatesRequest
+		matchUpdateRepository func(t *testing.T) *mocks.MatchUpdateRepository
+		sendErr               error
+		stopAfter             int // the number of sends after which the stream is closed
+		expectedSent          [][]models.MatchUpdate
+		expectedErr           error
+	}{
+		{
+			name:  "it returns an error when last match update id retrieval fails",
+			input: models.WatchMatchUpdatesRequest{},
+			matchUpdateRepository: func(t *testing.T) *mocks.MatchUpdateRepository {
+				t.Helper()
+				m := mocks.NewMatchUpdateRepository(t)
+				m.On("LastID", mock.Anything).Return(uint(0), unexpectedErr).Once()
+				return m
+			},
+			expectedErr: fmt.Errorf("failed to get last match update id: %w", unexpectedErr),
+		},
+		{
+			name:  "it returns an error when match updates listing fails",
+			input: models.WatchMatchUpdatesRequest{LastEventID: &lastID},
+			matchUpdateRepository: func(t *testing.T) *mocks.MatchUpdateRepository {
+				t.Helper()
+				m := mocks.NewMatchUpdateRepository(t)
+				m.On("List", mock.Anything, models.MatchUpdateFilter{AfterID: lastID, Limit: 2}).Return(nil, unexpectedErr).Once()
+				return m
+			},
+			expectedErr: fmt.Errorf("failed to list match updates: %w", unexpectedErr),
+		},
+		{
+			name:  "it returns an error when sending fails",
+			input: models.WatchMatchUpdatesRequest{MatchIDs: []uint{matchID}},
+			matchUpdateRepository: func(t *testing.T) *mocks.MatchUpdateRepository {
+				t.Helper()
+				m := mocks.NewMatchUpdateRepository(t)
+				m.On("LastID", mock.Anything).Return(lastID, nil).Once()
+				m.On("List", mock.Anything, models.MatchUpdateFilter{AfterID: lastID, MatchIDs: []uint{matchID}, Limit: 2}).Return(updates[2:], nil).Once()
+				return m
+			},
+			sendErr:      unexpectedErr,
+			expectedSent: [][]models.MatchUpdate{updates[2:]},
+			expectedErr:  fmt.Errorf("failed to send match updates: %w", unexpectedErr),
+		},
+		{
+			name:  "success - it sends updates after the last event id and reads the next ones without waiting when the batch is full",
+			input: models.WatchMatchUpdatesRequest{MatchIDs: []uint{matchID}, LastEventID: &lastID},
+			matchUpdateRepository: func(t *testing.T) *mocks.MatchUpdateRepository {
+				t.Helper()
+				m := mocks.NewMatchUpdateRepository(t)
+				m.On("List", mock.Anything, models.MatchUpdateFilter{AfterID: lastID, MatchIDs: []uint{matchID}, Limit: 2}).Return(updates[:2], nil).Once()
+				m.On("List", mock.Anything, models.MatchUpdateFilter{AfterID: lastID + 2, MatchIDs: []uint{matchID}, Limit: 2}).Return(updates[2:], nil).Once()
+				return m
+			},
+			stopAfter:    2,
+			expectedSent: [][]models.MatchUpdate{updates[:2], updates[2:]},
+		},
+		{
+			name:  "success - it sends heartbeat when there are no updates",
+			input: models.WatchMatchUpdatesRequest{},
+			matchUpdateRepository: func(t *testing.T) *mocks.MatchUpdateRepository {
+				t.Helper()
+				m := mocks.NewMatchUpdateRepository(t)
+				m.On("LastID", mock.Anything).Return(lastID, nil).Once()
+				m.On("List", mock.Anything, models.MatchUpdateFilter{AfterID: lastID, Limit: 2}).Return([]models.MatchUpdate{}, nil)
+				return m
+			},
+			stopAfter:    1,
+			expectedSent: [][]models.MatchUpdate{nil},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+			defer cancel()
+
+			var sent [][]models.MatchUpdate
+			send := func(updates []models.MatchUpdate) error {
+				sent = append(sent, updates)
+				if tt.sendErr != nil {
+					return tt.sendErr
+				}
+
+				if len(sent) == tt.stopAfter {
+					cancel()
+				}
+
+				return nil
+			}
+
+			lus := match.NewLiveUpdateService(cfg, tt.matchUpdateRepository(t))
+
+			err := lus.Watch(ctx, tt.input, send)
+			if tt.expectedErr != nil {
+				assert.EqualError(t, err, tt.expectedErr.Error())
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, tt.expectedSent, sent)
+		})
+	}
+}
@@ -10,35 +10,41 @@ import (
 )
 
 type MatchService struct {
-	config                    config.ResultCheck
-	aliasRepository           AliasRepository
-	matchRepository           MatchRepository
-	externalMatchRepository   ExternalMatchRepository
-	checkResultTaskRepository CheckResultTaskRepository
-	externalAPIClient         ExternalAPIClient
-	taskClient                TaskClient
-	logger                    Logger
+	config                       config.ResultCheck
+	unitOfWork                   UnitOfWork
+	aliasRepository              AliasRepository
+	matchRepository              MatchRepository
+	externalMatchRepository      ExternalMatchRepository
+	outboxTaskRepository         OutboxTaskRepository
+	resultCheckAttemptRepository ResultCheckAttemptRepository
+	externalAPIClient            ExternalAPIClient
+	outboxDispatcher             OutboxDispatcher
+	logger                       Logger
 }
 
 func NewMatchService(
 	config config.ResultCheck,
+	unitOfWork UnitOfWork,
 	aliasRepository AliasRepository,
 	matchRepository MatchRepository,
 	externalMatchRepository ExternalMatchRepository,
-	checkResultTaskRepository CheckResultTaskRepository,
+	outboxTaskRepository OutboxTaskRepository,
+	resultCheckAttemptRepository ResultCheckAttemptRepository,
 	externalAPIClient ExternalAPIClient,
-	taskClient TaskClient,
+	outboxDispatcher OutboxDispatcher,
 	logger Logger,
 ) *MatchService {
 	return &MatchService{
-		config:                    config,
-		aliasRepository:           aliasRepository,
-		matchRepository:           matchRepository,
-		externalMatchRepository:   externalMatchRepository,
-		checkResultTaskRepository: checkResultTaskRepository,
-		externalAPIClient:         externalAPIClient,
-		taskClient:                taskClient,
-		logger:                    logger,
+		config:                       config,
+		unitOfWork:                   unitOfWork,
+		aliasRepository:              aliasRepository,
+		matchRepository:              matchRepository,
+		externalMatchRepository:      externalMatchRepository,
+		outboxTaskRepository:         outboxTaskRepository,
+		resultCheckAttemptRepository: resultCheckAttemptRepository,
+		externalAPIClient:            externalAPIClient,
+		outboxDispatcher:             outboxDispatcher,
+		logger:                       logger,
 	}
 }
 
@@ -91,53 +97,80 @@ func (s *MatchService) Create(ctx context.Context, request models.CreateMatchReq
 		matchID = &match.ID
 	}
 
-	match, err = s.matchRepository.Save(ctx, matchID, models.Match{
-		HomeTeamID:   aliasHome.TeamID,
-		AwayTeamID:   aliasAway.TeamID,
-		StartsAt:     externalMatch.Time,
-		ResultStatus: models.NotScheduled,
+	var outboxTask *models.OutboxTask
+	err = s.unitOfWork.Do(ctx, func(ctx context.Context) error {
+		savedMatch, err := s.matchRepository.Save(ctx, matchID, models.Match{
+			HomeTeamID:   aliasHome.TeamID,
+			AwayTeamID:   aliasAway.TeamID,
+			StartsAt:     externalMatch.Time,
+			ResultStatus: models.NotScheduled,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to save match with team ids %d and %d starting at %s: %w", aliasHome.TeamID, aliasAway.TeamID, externalMatch.Time, err)
+		}
+
+		externalMatchID := externalMatch.ID
+		_, err = s.externalMatchRepository.Save(ctx, &externalMatchID, externalMatch.ToExternalMatch(savedMatch.ID))
+		if err != nil {
+			return fmt.Errorf("failed to save external match with id %d and match id %d: %w", externalMatchID, savedMatch.ID, err)
+		}
+
+		outboxTask, err = s.outboxTaskRepository.Create(ctx, models.OutboxTask{
+			Kind:          models.OutboxKindResultCheck,
+			MatchID:       savedMatch.ID,
+			AttemptNumber: 1,
+			ExecuteAt:     savedMatch.StartsAt.Add(s.config.FirstAttemptDelay),
+		})
+		if err != nil {
+			return fmt.Errorf("failed to create result check outbox task: %w", err)
+		}
+
+		match = savedMatch
+
+		return nil
 	})
 	if err != nil {
-		return 0, fmt.Errorf("failed to save match with team ids %d and %d starting at %s: %w", aliasHome.TeamID, aliasAway.TeamID, externalMatch.Time, err)
+		return 0, err
 	}
 
-	externalMatchID := externalMatch.ID
-	_, err = s.externalMatchRepository.Save(ctx, &externalMatchID, externalMatch.ToExternalMatch(match.ID))
-	if err != nil {
-		return 0, fmt.Errorf("failed to save external match with id %d and match id %d: %w", externalMatchID, match.ID, err)
-	}
+	s.logger.Debug().Uint("match_id", match.ID).Uint("outbox_task_id", outboxTask.ID).Msg("match saved & result check outbox task created")
 
-	task, err := s.taskClient.ScheduleResultCheck(ctx, match.ID, 1, match.StartsAt.Add(s.config.FirstAttemptDelay))
-	if err != nil && !errors.As(err, &models.ResourceAlreadyExistsError{}) {
-		return 0, fmt.Errorf("failed to schedule result check task: %w", err)
+	// when the immediate dispatch fails, the outbox task stays pending and is dispatched by the outbox dispatch trigger.
+	// The match accepts subscriptions while its result check outbox task is pending
+	if err := s.outboxDispatcher.Dispatch(ctx, outboxTask.ID); err != nil {
+		s.logger.Error().Err(err).Uint("match_id", match.ID).Msg("failed to dispatch result check outbox task")
 	}
 
-	if errors.As(err, &models.ResourceAlreadyExistsError{}) {
-		foundTask, errGetTask := s.taskClient.GetResultCheckTask(ctx, match.ID, 1)
-		if errGetTask != nil {
-			return 0, fmt.Errorf("failed to get result check task: %w", errGetTask)
-		}
-		task = foundTask
+	return match.ID, nil
+}
+
+func (s *MatchService) Get(ctx context.Context, id uint) (*models.Match, error) {
+	match, err := s.matchRepository.One(ctx, models.Match{ID: id})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get match: %w", err)
 	}
 
-	_, err = s.checkResultTaskRepository.Save(ctx, models.CheckResultTask{
-		MatchID:       match.ID,
-		Name:          task.Name,
-		AttemptNumber: 1,
-		ExecuteAt:     task.ExecuteAt,
-	})
+	history, err := s.matchRepository.ListStatusHistory(ctx, id)
 	if err != nil {
-		return 0, fmt.Errorf("failed to save result-check task: %w", err)
+		return nil, fmt.Errorf("failed to get match status history: %w", err)
 	}
 
-	s.logger.Debug().Uint("match_id", match.ID).Time("execute_at", task.ExecuteAt).Msg("match saved & check result task scheduled")
+	match.StatusHistory = history
 
-	_, err = s.matchRepository.Update(ctx, match.ID, models.Scheduled)
+	return match, nil
+}
+
+func (s *MatchService) ListResultCheckAttempts(ctx context.Context, matchID uint) ([]models.ResultCheckAttempt, error) {
+	if _, err := s.matchRepository.One(ctx, models.Match{ID: matchID}); err != nil {
+		return nil, fmt.Errorf("failed to get match: %w", err)
+	}
+
+	attempts, err := s.resultCheckAttemptRepository.ListByMatch(ctx, matchID)
 	if err != nil {
-		return 0, fmt.Errorf("failed to update match status to %s: %w", models.Scheduled, err)
+		return nil, fmt.Errorf("failed to list result check attempts: %w", err)
 	}
 
-	return match.ID, nil
+	return attempts, nil
 }
 
 func (s *MatchService) findAlias(ctx context.Context, alias string) (*models.Alias, error) {
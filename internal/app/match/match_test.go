@@ -68,23 +68,19 @@ func TestMatchService_Create(t *testing.T) {
 		r.Status = models.StatusMatchNotStarted
 	})
 
-	createdTaskName := gofakeit.Word()
-	clientTask := testutils.FakeTask(func(t *models.Task) {
-		t.Name = createdTaskName
-		t.ExecuteAt = startsAt.Add(pollingFirstAttemptDelay)
-	})
+	outboxTaskID := uint(gofakeit.Uint32())
 
 	tests := []struct {
-		name                      string
-		input                     models.CreateMatchRequest
-		aliasRepository           func(t *testing.T) *mocks.AliasRepository
-		matchRepository           func(t *testing.T) *mocks.MatchRepository
-		externalAPIClient         func(t *testing.T) *mocks.ExternalAPIClient
-		externalMatchRepository   func(t *testing.T) *mocks.ExternalMatchRepository
-		checkResultTaskRepository func(t *testing.T) *mocks.CheckResultTaskRepository
-		taskClient                func(t *testing.T) *mocks.TaskClient
-		result                    uint
-		expectedErr               error
+		name                    string
+		input                   models.CreateMatchRequest
+		aliasRepository         func(t *testing.T) *mocks.AliasRepository
+		matchRepository         func(t *testing.T) *mocks.MatchRepository
+		externalAPIClient       func(t *testing.T) *mocks.ExternalAPIClient
+		externalMatchRepository func(t *testing.T) *mocks.ExternalMatchRepository
+		outboxTaskRepository    func(t *testing.T) *mocks.OutboxTaskRepository
+		outboxDispatcher        func(t *testing.T) *mocks.OutboxDispatcher
+		result                  uint
+		expectedErr             error
 	}{
 		{
 			name:  "it returns an error when home team alias finding fails",
@@ -373,126 +369,7 @@ func TestMatchService_Create(t *testing.T) {
 			expectedErr: fmt.Errorf("failed to save external match with id %d and match id %d: %w", externalMatchID, matchID, errUnexpected),
 		},
 		{
-			name:  "it returns an error when task scheduling results in unexpected error",
-			input: createMatchRequest,
-			aliasRepository: func(t *testing.T) *mocks.AliasRepository {
-				t.Helper()
-				m := mocks.NewAliasRepository(t)
-				m.On("Find", ctx, aliasHomeName).Return(&aliasHome, nil).Once()
-				m.On("Find", ctx, aliasAwayName).Return(&aliasAway, nil).Once()
-				return m
-			},
-			matchRepository: func(t *testing.T) *mocks.MatchRepository {
-				t.Helper()
-				m := mocks.NewMatchRepository(t)
-				savedMatch := testutils.FakeMatch(func(r *models.Match) {
-					r.ID = matchID
-					r.HomeTeamID = aliasHome.TeamID
-					r.AwayTeamID = aliasAway.TeamID
-					r.StartsAt = startsAt.UTC()
-					r.ResultStatus = models.NotScheduled
-				})
-				m.On("One", ctx, models.Match{
-					HomeTeamID: aliasHome.TeamID,
-					AwayTeamID: aliasAway.TeamID,
-					StartsAt:   startsAt.UTC(),
-				}).Return(nil, fmt.Errorf("match not found: %w", models.NewResourceNotFoundError(errors.New("not found")))).Once()
-				m.On("Save", ctx, (*uint)(nil), models.Match{
-					HomeTeamID:   aliasHome.TeamID,
-					AwayTeamID:   aliasAway.TeamID,
-					StartsAt:     startsAt.UTC(),
-					ResultStatus: models.NotScheduled,
-				}).Return(&savedMatch, nil).Once()
-				return m
-			},
-			externalAPIClient: func(t *testing.T) *mocks.ExternalAPIClient {
-				t.Helper()
-				m := mocks.NewExternalAPIClient(t)
-				m.On("GetMatches", ctx, startsAt.UTC()).Return([]models.ExternalAPIMatch{externalMatch}, nil).Once()
-				return m
-			},
-			externalMatchRepository: func(t *testing.T) *mocks.ExternalMatchRepository {
-				t.Helper()
-				m := mocks.NewExternalMatchRepository(t)
-				m.On("Save", ctx, &externalMatchID, models.ExternalMatch{
-					ID:        externalMatchID,
-					MatchID:   matchID,
-					HomeScore: externalMatch.HomeScore,
-					AwayScore: externalMatch.AwayScore,
-					Status:    models.StatusMatchNotStarted,
-				}).Return(&models.ExternalMatch{ID: externalMatchID}, nil).Once()
-				return m
-			},
-			taskClient: func(t *testing.T) *mocks.TaskClient {
-				t.Helper()
-				m := mocks.NewTaskClient(t)
-				m.On("ScheduleResultCheck", ctx, matchID, uint(1), startsAt.Add(pollingFirstAttemptDelay)).Return(nil, errUnexpected).Once()
-				return m
-			},
-			expectedErr: fmt.Errorf("failed to schedule result check task: %w", errUnexpected),
-		},
-		{
-			name:  "it returns an error when task scheduling results in error indicating that task already exists and then task retrieval fails",
-			input: createMatchRequest,
-			aliasRepository: func(t *testing.T) *mocks.AliasRepository {
-				t.Helper()
-				m := mocks.NewAliasRepository(t)
-				m.On("Find", ctx, aliasHomeName).Return(&aliasHome, nil).Once()
-				m.On("Find", ctx, aliasAwayName).Return(&aliasAway, nil).Once()
-				return m
-			},
-			matchRepository: func(t *testing.T) *mocks.MatchRepository {
-				t.Helper()
-				m := mocks.NewMatchRepository(t)
-				savedMatch := testutils.FakeMatch(func(r *models.Match) {
-					r.ID = matchID
-					r.HomeTeamID = aliasHome.TeamID
-					r.AwayTeamID = aliasAway.TeamID
-					r.StartsAt = startsAt.UTC()
-					r.ResultStatus = models.NotScheduled
-				})
-				m.On("One", ctx, models.Match{
-					HomeTeamID: aliasHome.TeamID,
-					AwayTeamID: aliasAway.TeamID,
-					StartsAt:   startsAt.UTC(),
-				}).Return(nil, fmt.Errorf("match not found: %w", models.NewResourceNotFoundError(errors.New("not found")))).Once()
-				m.On("Save", ctx, (*uint)(nil), models.Match{
-					HomeTeamID:   aliasHome.TeamID,
-					AwayTeamID:   aliasAway.TeamID,
-					StartsAt:     startsAt.UTC(),
-					ResultStatus: models.NotScheduled,
-				}).Return(&savedMatch, nil).Once()
-				return m
-			},
-			externalAPIClient: func(t *testing.T) *mocks.ExternalAPIClient {
-				t.Helper()
-				m := mocks.NewExternalAPIClient(t)
-				m.On("GetMatches", ctx, startsAt.UTC()).Return([]models.ExternalAPIMatch{externalMatch}, nil).Once()
-				return m
-			},
-			externalMatchRepository: func(t *testing.T) *mocks.ExternalMatchRepository {
-				t.Helper()
-				m := mocks.NewExternalMatchRepository(t)
-				m.On("Save", ctx, &externalMatchID, models.ExternalMatch{
-					ID:        externalMatchID,
-					MatchID:   matchID,
-					HomeScore: externalMatch.HomeScore,
-					AwayScore: externalMatch.AwayScore,
-					Status:    models.StatusMatchNotStarted,
-				}).Return(&models.ExternalMatch{ID: externalMatchID}, nil).Once()
-				return m
-			},
-			taskClient: func(t *testing.T) *mocks.TaskClient {
-				t.Helper()
-				m := mocks.NewTaskClient(t)
-				m.On("ScheduleResultCheck", ctx, matchID, uint(1), startsAt.Add(pollingFirstAttemptDelay)).Return(nil, fmt.Errorf("already exists: %w", models.NewResourceAlreadyExistsError(errors.New("task exists")))).Once()
-				m.On("GetResultCheckTask", ctx, matchID, uint(1)).Return(nil, errUnexpected).Once()
-				return m
-			},
-			expectedErr: fmt.Errorf("failed to get result check task: %w", errUnexpected),
-		},
-		{
-			name:  "it returns an error when check result task saving fails",
+			name:  "it returns an error when result check outbox task creation fails",
 			input: createMatchRequest,
 			aliasRepository: func(t *testing.T) *mocks.AliasRepository {
 				t.Helper()
@@ -527,7 +404,7 @@ func TestMatchService_Create(t *testing.T) {
 			externalAPIClient: func(t *testing.T) *mocks.ExternalAPIClient {
 				t.Helper()
 				m := mocks.NewExternalAPIClient(t)
-				m.On("GetMatches", ctx, startsAt.UTC()).Return([]models.ExternalAPIMatch{externalMatch}, nil).Once()
+				m.On("GetMatches", ctx, startsAt.UTC()).Return([]models.ExternalAPIMatch{externalMatch, testutils.FakeExternalAPIMatch()}, nil).Once()
 				return m
 			},
 			externalMatchRepository: func(t *testing.T) *mocks.ExternalMatchRepository {
@@ -536,27 +413,21 @@ func TestMatchService_Create(t *testing.T) {
 				m.On("Save", ctx, &externalMatchID, externalMatchSaved).Return(&models.ExternalMatch{ID: externalMatchID}, nil).Once()
 				return m
 			},
-			taskClient: func(t *testing.T) *mocks.TaskClient {
-				t.Helper()
-				m := mocks.NewTaskClient(t)
-				m.On("ScheduleResultCheck", ctx, matchID, uint(1), startsAt.Add(pollingFirstAttemptDelay)).Return(&clientTask, nil).Once()
-				return m
-			},
-			checkResultTaskRepository: func(t *testing.T) *mocks.CheckResultTaskRepository {
+			outboxTaskRepository: func(t *testing.T) *mocks.OutboxTaskRepository {
 				t.Helper()
-				m := mocks.NewCheckResultTaskRepository(t)
-				m.On("Save", ctx, models.CheckResultTask{
+				m := mocks.NewOutboxTaskRepository(t)
+				m.On("Create", ctx, models.OutboxTask{
+					Kind:          models.OutboxKindResultCheck,
 					MatchID:       matchID,
-					Name:          createdTaskName,
 					AttemptNumber: 1,
 					ExecuteAt:     startsAt.Add(pollingFirstAttemptDelay),
 				}).Return(nil, errUnexpected).Once()
 				return m
 			},
-			expectedErr: fmt.Errorf("failed to save result-check task: %w", errUnexpected),
+			expectedErr: fmt.Errorf("failed to create result check outbox task: %w", errUnexpected),
 		},
 		{
-			name:  "it returns an error when match update fails",
+			name:  "success - it creates match and dispatches result check outbox task",
 			input: createMatchRequest,
 			aliasRepository: func(t *testing.T) *mocks.AliasRepository {
 				t.Helper()
@@ -586,13 +457,12 @@ func TestMatchService_Create(t *testing.T) {
 					StartsAt:     startsAt.UTC(),
 					ResultStatus: models.NotScheduled,
 				}).Return(&savedMatch, nil).Once()
-				m.On("Update", ctx, matchID, models.Scheduled).Return(nil, errUnexpected).Once()
 				return m
 			},
 			externalAPIClient: func(t *testing.T) *mocks.ExternalAPIClient {
 				t.Helper()
 				m := mocks.NewExternalAPIClient(t)
-				m.On("GetMatches", ctx, startsAt.UTC()).Return([]models.ExternalAPIMatch{externalMatch}, nil).Once()
+				m.On("GetMatches", ctx, startsAt.UTC()).Return([]models.ExternalAPIMatch{externalMatch, testutils.FakeExternalAPIMatch()}, nil).Once()
 				return m
 			},
 			externalMatchRepository: func(t *testing.T) *mocks.ExternalMatchRepository {
@@ -601,94 +471,27 @@ func TestMatchService_Create(t *testing.T) {
 				m.On("Save", ctx, &externalMatchID, externalMatchSaved).Return(&models.ExternalMatch{ID: externalMatchID}, nil).Once()
 				return m
 			},
-			taskClient: func(t *testing.T) *mocks.TaskClient {
+			outboxTaskRepository: func(t *testing.T) *mocks.OutboxTaskRepository {
 				t.Helper()
-				m := mocks.NewTaskClient(t)
-				m.On("ScheduleResultCheck", ctx, matchID, uint(1), startsAt.Add(pollingFirstAttemptDelay)).Return(&clientTask, nil).Once()
-				return m
-			},
-			checkResultTaskRepository: func(t *testing.T) *mocks.CheckResultTaskRepository {
-				t.Helper()
-				m := mocks.NewCheckResultTaskRepository(t)
-				m.On("Save", ctx, models.CheckResultTask{
+				m := mocks.NewOutboxTaskRepository(t)
+				m.On("Create", ctx, models.OutboxTask{
+					Kind:          models.OutboxKindResultCheck,
 					MatchID:       matchID,
-					Name:          createdTaskName,
 					AttemptNumber: 1,
 					ExecuteAt:     startsAt.Add(pollingFirstAttemptDelay),
-				}).Return(&models.CheckResultTask{}, nil).Once()
-				return m
-			},
-			expectedErr: fmt.Errorf("failed to update match status to %s: %w", models.Scheduled, errUnexpected),
-		},
-		{
-			name:  "success - it creates match and schedules result check",
-			input: createMatchRequest,
-			aliasRepository: func(t *testing.T) *mocks.AliasRepository {
-				t.Helper()
-				m := mocks.NewAliasRepository(t)
-				m.On("Find", ctx, aliasHomeName).Return(&aliasHome, nil).Once()
-				m.On("Find", ctx, aliasAwayName).Return(&aliasAway, nil).Once()
-				return m
-			},
-			matchRepository: func(t *testing.T) *mocks.MatchRepository {
-				t.Helper()
-				m := mocks.NewMatchRepository(t)
-				savedMatch := testutils.FakeMatch(func(r *models.Match) {
-					r.ID = matchID
-					r.HomeTeamID = aliasHome.TeamID
-					r.AwayTeamID = aliasAway.TeamID
-					r.StartsAt = startsAt.UTC()
-					r.ResultStatus = models.NotScheduled
-				})
-				updatedMatch := savedMatch
-				updatedMatch.ResultStatus = models.Scheduled
-				m.On("One", ctx, models.Match{
-					HomeTeamID: aliasHome.TeamID,
-					AwayTeamID: aliasAway.TeamID,
-					StartsAt:   startsAt.UTC(),
-				}).Return(nil, fmt.Errorf("match not found: %w", models.NewResourceNotFoundError(errors.New("not found")))).Once()
-				m.On("Save", ctx, (*uint)(nil), models.Match{
-					HomeTeamID:   aliasHome.TeamID,
-					AwayTeamID:   aliasAway.TeamID,
-					StartsAt:     startsAt.UTC(),
-					ResultStatus: models.NotScheduled,
-				}).Return(&savedMatch, nil).Once()
-				m.On("Update", ctx, matchID, models.Scheduled).Return(&updatedMatch, nil).Once()
-				return m
-			},
-			externalAPIClient: func(t *testing.T) *mocks.ExternalAPIClient {
-				t.Helper()
-				m := mocks.NewExternalAPIClient(t)
-				m.On("GetMatches", ctx, startsAt.UTC()).Return([]models.ExternalAPIMatch{externalMatch, testutils.FakeExternalAPIMatch()}, nil).Once()
-				return m
-			},
-			externalMatchRepository: func(t *testing.T) *mocks.ExternalMatchRepository {
-				t.Helper()
-				m := mocks.NewExternalMatchRepository(t)
-				m.On("Save", ctx, &externalMatchID, externalMatchSaved).Return(&models.ExternalMatch{ID: externalMatchID}, nil).Once()
-				return m
-			},
-			taskClient: func(t *testing.T) *mocks.TaskClient {
-				t.Helper()
-				m := mocks.NewTaskClient(t)
-				m.On("ScheduleResultCheck", ctx, matchID, uint(1), startsAt.Add(pollingFirstAttemptDelay)).Return(&clientTask, nil).Once()
+				}).Return(&models.OutboxTask{ID: outboxTaskID}, nil).Once()
 				return m
 			},
-			checkResultTaskRepository: func(t *testing.T) *mocks.CheckResultTaskRepository {
+			outboxDispatcher: func(t *testing.T) *mocks.OutboxDispatcher {
 				t.Helper()
-				m := mocks.NewCheckResultTaskRepository(t)
-				m.On("Save", ctx, models.CheckResultTask{
-					MatchID:       matchID,
-					Name:          createdTaskName,
-					AttemptNumber: 1,
-					ExecuteAt:     startsAt.Add(pollingFirstAttemptDelay),
-				}).Return(&models.CheckResultTask{}, nil).Once()
+				m := mocks.NewOutboxDispatcher(t)
+				m.On("Dispatch", ctx, outboxTaskID).Return(nil).Once()
 				return m
 			},
 			result: matchID,
 		},
 		{
-			name:  "success - it creates match and schedules result check when task already exists",
+			name:  "success - it returns match id when outbox task dispatch fails",
 			input: createMatchRequest,
 			aliasRepository: func(t *testing.T) *mocks.AliasRepository {
 				t.Helper()
@@ -707,8 +510,6 @@ func TestMatchService_Create(t *testing.T) {
 					r.StartsAt = startsAt.UTC()
 					r.ResultStatus = models.NotScheduled
 				})
-				updatedMatch := savedMatch
-				updatedMatch.ResultStatus = models.Scheduled
 				m.On("One", ctx, models.Match{
 					HomeTeamID: aliasHome.TeamID,
 					AwayTeamID: aliasAway.TeamID,
@@ -720,7 +521,6 @@ func TestMatchService_Create(t *testing.T) {
 					StartsAt:     startsAt.UTC(),
 					ResultStatus: models.NotScheduled,
 				}).Return(&savedMatch, nil).Once()
-				m.On("Update", ctx, matchID, models.Scheduled).Return(&updatedMatch, nil).Once()
 				return m
 			},
 			externalAPIClient: func(t *testing.T) *mocks.ExternalAPIClient {
@@ -735,22 +535,21 @@ func TestMatchService_Create(t *testing.T) {
 				m.On("Save", ctx, &externalMatchID, externalMatchSaved).Return(&models.ExternalMatch{ID: externalMatchID}, nil).Once()
 				return m
 			},
-			taskClient: func(t *testing.T) *mocks.TaskClient {
+			outboxTaskRepository: func(t *testing.T) *mocks.OutboxTaskRepository {
 				t.Helper()
-				m := mocks.NewTaskClient(t)
-				m.On("ScheduleResultCheck", ctx, matchID, uint(1), startsAt.Add(pollingFirstAttemptDelay)).Return(nil, fmt.Errorf("already exists: %w", models.NewResourceAlreadyExistsError(errors.New("task exists")))).Once()
-				m.On("GetResultCheckTask", ctx, matchID, uint(1)).Return(&clientTask, nil).Once()
-				return m
-			},
-			checkResultTaskRepository: func(t *testing.T) *mocks.CheckResultTaskRepository {
-				t.Helper()
-				m := mocks.NewCheckResultTaskRepository(t)
-				m.On("Save", ctx, models.CheckResultTask{
+				m := mocks.NewOutboxTaskRepository(t)
+				m.On("Create", ctx, models.OutboxTask{
+					Kind:          models.OutboxKindResultCheck,
 					MatchID:       matchID,
-					Name:          createdTaskName,
 					AttemptNumber: 1,
 					ExecuteAt:     startsAt.Add(pollingFirstAttemptDelay),
-				}).Return(&models.CheckResultTask{}, nil).Once()
+				}).Return(&models.OutboxTask{ID: outboxTaskID}, nil).Once()
+				return m
+			},
+			outboxDispatcher: func(t *testing.T) *mocks.OutboxDispatcher {
+				t.Helper()
+				m := mocks.NewOutboxDispatcher(t)
+				m.On("Dispatch", ctx, outboxTaskID).Return(errUnexpected).Once()
 				return m
 			},
 			result: matchID,
@@ -779,14 +578,14 @@ func TestMatchService_Create(t *testing.T) {
 				externalMatchRepository = tt.externalMatchRepository(t)
 			}
 
-			var checkResultTaskRepository *mocks.CheckResultTaskRepository
-			if tt.checkResultTaskRepository != nil {
-				checkResultTaskRepository = tt.checkResultTaskRepository(t)
+			var outboxTaskRepository *mocks.OutboxTaskRepository
+			if tt.outboxTaskRepository != nil {
+				outboxTaskRepository = tt.outboxTaskRepository(t)
 			}
 
-			var taskClient *mocks.TaskClient
-			if tt.taskClient != nil {
-				taskClient = tt.taskClient(t)
+			var outboxDispatcher *mocks.OutboxDispatcher
+			if tt.outboxDispatcher != nil {
+				outboxDispatcher = tt.outboxDispatcher(t)
 			}
 
 			logger := loggerinternal.SetupLogger()
@@ -797,12 +596,14 @@ func TestMatchService_Create(t *testing.T) {
 					Interval:          pollingInterval,
 					FirstAttemptDelay: pollingFirstAttemptDelay,
 				},
+				passThroughUnitOfWork(t),
 				aliasRepository,
 				matchRepository,
 				externalMatchRepository,
-				checkResultTaskRepository,
+				outboxTaskRepository,
+				nil,
 				externalAPIClient,
-				taskClient,
+				outboxDispatcher,
 				logger,
 			)
 
@@ -816,3 +617,84 @@ func TestMatchService_Create(t *testing.T) {
 		})
 	}
 }
+
+func TestMatchService_Get(t *testing.T) {
+	ctx := context.Background()
+	errUnexpected := errors.New("unexpected error")
+
+	matchID := uint(gofakeit.Uint8())
+	foundMatch := testutils.FakeMatch(func(r *models.Match) {
+		r.ID = matchID
+		r.ResultStatus = models.Received
+	})
+
+	history := []models.MatchStatusTransition{
+		{MatchID: matchID, From: models.NotScheduled, To: models.Scheduled},
+		{MatchID: matchID, From: models.Scheduled, To: models.Received},
+	}
+
+	tests := []struct {
+		name            string
+		input           uint
+		matchRepository func(t *testing.T) *mocks.MatchRepository
+		result          *models.Match
+		expectedErr     error
+	}{
+		{
+			name:  "it returns an error when match retrieval fails",
+			input: matchID,
+			matchRepository: func(t *testing.T) *mocks.MatchRepository {
+				t.Helper()
+				m := mocks.NewMatchRepository(t)
+				m.On("One", ctx, models.Match{ID: matchID}).Return(nil, errUnexpected).Once()
+				return m
+			},
+			expectedErr: fmt.Errorf("failed to get match: %w", errUnexpected),
+		},
+		{
+			name:  "it returns an error when status history retrieval fails",
+			input: matchID,
+			matchRepository: func(t *testing.T) *mocks.MatchRepository {
+				t.Helper()
+				m := mocks.NewMatchRepository(t)
+				m.On("One", ctx, models.Match{ID: matchID}).Return(&foundMatch, nil).Once()
+				m.On("ListStatusHistory", ctx, matchID).Return(nil, errUnexpected).Once()
+				return m
+			},
+			expectedErr: fmt.Errorf("failed to get match status history: %w", errUnexpected),
+		},
+		{
+			name:  "success - it returns match with status history",
+			input: matchID,
+			matchRepository: func(t *testing.T) *mocks.MatchRepository {
+				t.Helper()
+				m := mocks.NewMatchRepository(t)
+				found := foundMatch
+				m.On("One", ctx, models.Match{ID: matchID}).Return(&found, nil).Once()
+				m.On("ListStatusHistory", ctx, matchID).Return(history, nil).Once()
+				return m
+			},
+			result: func() *models.Match {
+				expected := foundMatch
+				expected.StatusHistory = history
+				return &expected
+			}(),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			logger := loggerinternal.SetupLogger()
+
+			ms := match.NewMatchService(config.ResultCheck{}, nil, nil, tt.matchRepository(t), nil, nil, nil, nil, nil, logger)
+
+			actual, err := ms.Get(ctx, tt.input)
+			assert.Equal(t, tt.result, actual)
+			if tt.expectedErr != nil {
+				assert.ErrorContains(t, err, tt.expectedErr.Error())
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
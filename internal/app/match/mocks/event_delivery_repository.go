@@ -0,0 +1,108 @@
+// Code generated by mockery v2.53.3. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+
+	models "github.com/andrewshostak/result-service/internal/app/models"
+)
+
+// EventDeliveryRepository is an autogenerated mock type for the EventDeliveryRepository type
+type EventDeliveryRepository struct {
+	mock.Mock
+}
+
+// Create provides a mock function with given fields: ctx, delivery
+func (_m *EventDeliveryRepository) Create(ctx context.Context, delivery models.EventDelivery) (*models.EventDelivery, error) {
+	ret := _m.Called(ctx, delivery)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Create")
+	}
+
+	var r0 *models.EventDelivery
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, models.EventDelivery) (*models.EventDelivery, error)); ok {
+		return rf(ctx, delivery)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, models.EventDelivery) *models.EventDelivery); ok {
+		r0 = rf(ctx, delivery)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.EventDelivery)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, models.EventDelivery) error); ok {
+		r1 = rf(ctx, delivery)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListByStatus provides a mock function with given fields: ctx, status
+func (_m *EventDeliveryRepository) ListByStatus(ctx context.Context, status models.SubscriptionStatus) ([]models.EventDelivery, error) {
+	ret := _m.Called(ctx, status)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListByStatus")
+	}
+
+	var r0 []models.EventDelivery
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, models.SubscriptionStatus) ([]models.EventDelivery, error)); ok {
+		return rf(ctx, status)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, models.SubscriptionStatus) []models.EventDelivery); ok {
+		r0 = rf(ctx, status)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.EventDelivery)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, models.SubscriptionStatus) error); ok {
+		r1 = rf(ctx, status)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Update provides a mock function with given fields: ctx, id, delivery
+func (_m *EventDeliveryRepository) Update(ctx context.Context, id uint, delivery models.EventDelivery) error {
+	ret := _m.Called(ctx, id, delivery)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Update")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint, models.EventDelivery) error); ok {
+		r0 = rf(ctx, id, delivery)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// NewEventDeliveryRepository creates a new instance of EventDeliveryRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewEventDeliveryRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *EventDeliveryRepository {
+	mock := &EventDeliveryRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
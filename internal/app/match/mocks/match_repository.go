@@ -8,6 +8,8 @@ import (
 	mock "github.com/stretchr/testify/mock"
 
 	models "github.com/andrewshostak/result-service/internal/app/models"
+
+	time "time"
 )
 
 // MatchRepository is an autogenerated mock type for the MatchRepository type
@@ -15,6 +17,66 @@ type MatchRepository struct {
 	mock.Mock
 }
 
+// ListScheduledBefore provides a mock function with given fields: ctx, executeAt
+func (_m *MatchRepository) ListScheduledBefore(ctx context.Context, executeAt time.Time) ([]models.Match, error) {
+	ret := _m.Called(ctx, executeAt)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListScheduledBefore")
+	}
+
+	var r0 []models.Match
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time) ([]models.Match, error)); ok {
+		return rf(ctx, executeAt)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time) []models.Match); ok {
+		r0 = rf(ctx, executeAt)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.Match)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, time.Time) error); ok {
+		r1 = rf(ctx, executeAt)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListStatusHistory provides a mock function with given fields: ctx, matchID
+func (_m *MatchRepository) ListStatusHistory(ctx context.Context, matchID uint) ([]models.MatchStatusTransition, error) {
+	ret := _m.Called(ctx, matchID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListStatusHistory")
+	}
+
+	var r0 []models.MatchStatusTransition
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint) ([]models.MatchStatusTransition, error)); ok {
+		return rf(ctx, matchID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint) []models.MatchStatusTransition); ok {
+		r0 = rf(ctx, matchID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.MatchStatusTransition)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint) error); ok {
+		r1 = rf(ctx, matchID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // One provides a mock function with given fields: ctx, search
 func (_m *MatchRepository) One(ctx context.Context, search models.Match) (*models.Match, error) {
 	ret := _m.Called(ctx, search)
@@ -75,9 +137,9 @@ func (_m *MatchRepository) Save(ctx context.Context, id *uint, _a2 models.Match)
 	return r0, r1
 }
 
-// Update provides a mock function with given fields: ctx, id, resultStatus
-func (_m *MatchRepository) Update(ctx context.Context, id uint, resultStatus models.ResultStatus) (*models.Match, error) {
-	ret := _m.Called(ctx, id, resultStatus)
+// Update provides a mock function with given fields: ctx, transition
+func (_m *MatchRepository) Update(ctx context.Context, transition models.MatchStatusTransition) (*models.Match, error) {
+	ret := _m.Called(ctx, transition)
 
 	if len(ret) == 0 {
 		panic("no return value specified for Update")
@@ -85,19 +147,19 @@ func (_m *MatchRepository) Update(ctx context.Context, id uint, resultStatus mod
 
 	var r0 *models.Match
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, uint, models.ResultStatus) (*models.Match, error)); ok {
-		return rf(ctx, id, resultStatus)
+	if rf, ok := ret.Get(0).(func(context.Context, models.MatchStatusTransition) (*models.Match, error)); ok {
+		return rf(ctx, transition)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, uint, models.ResultStatus) *models.Match); ok {
-		r0 = rf(ctx, id, resultStatus)
+	if rf, ok := ret.Get(0).(func(context.Context, models.MatchStatusTransition) *models.Match); ok {
+		r0 = rf(ctx, transition)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*models.Match)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, uint, models.ResultStatus) error); ok {
-		r1 = rf(ctx, id, resultStatus)
+	if rf, ok := ret.Get(1).(func(context.Context, models.MatchStatusTransition) error); ok {
+		r1 = rf(ctx, transition)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -105,6 +167,24 @@ func (_m *MatchRepository) Update(ctx context.Context, id uint, resultStatus mod
 	return r0, r1
 }
 
+// UpdateStartsAt provides a mock function with given fields: ctx, id, startsAt
+func (_m *MatchRepository) UpdateStartsAt(ctx context.Context, id uint, startsAt time.Time) error {
+	ret := _m.Called(ctx, id, startsAt)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateStartsAt")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint, time.Time) error); ok {
+		r0 = rf(ctx, id, startsAt)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // NewMatchRepository creates a new instance of MatchRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewMatchRepository(t interface {
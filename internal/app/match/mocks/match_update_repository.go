@@ -0,0 +1,118 @@
+// Code generated by mockery v2.53.3. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+
+	models "github.com/andrewshostak/result-service/internal/app/models"
+)
+
+// MatchUpdateRepository is an autogenerated mock type for the MatchUpdateRepository type
+type MatchUpdateRepository struct {
+	mock.Mock
+}
+
+// Create provides a mock function with given fields: ctx, update
+func (_m *MatchUpdateRepository) Create(ctx context.Context, update models.MatchUpdate) (*models.MatchUpdate, error) {
+	ret := _m.Called(ctx, update)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Create")
+	}
+
+	var r0 *models.MatchUpdate
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, models.MatchUpdate) (*models.MatchUpdate, error)); ok {
+		return rf(ctx, update)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, models.MatchUpdate) *models.MatchUpdate); ok {
+		r0 = rf(ctx, update)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.MatchUpdate)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, models.MatchUpdate) error); ok {
+		r1 = rf(ctx, update)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// LastID provides a mock function with given fields: ctx
+func (_m *MatchUpdateRepository) LastID(ctx context.Context) (uint, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for LastID")
+	}
+
+	var r0 uint
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (uint, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) uint); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Get(0).(uint)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// List provides a mock function with given fields: ctx, filter
+func (_m *MatchUpdateRepository) List(ctx context.Context, filter models.MatchUpdateFilter) ([]models.MatchUpdate, error) {
+	ret := _m.Called(ctx, filter)
+
+	if len(ret) == 0 {
+		panic("no return value specified for List")
+	}
+
+	var r0 []models.MatchUpdate
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, models.MatchUpdateFilter) ([]models.MatchUpdate, error)); ok {
+		return rf(ctx, filter)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, models.MatchUpdateFilter) []models.MatchUpdate); ok {
+		r0 = rf(ctx, filter)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.MatchUpdate)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, models.MatchUpdateFilter) error); ok {
+		r1 = rf(ctx, filter)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NewMatchUpdateRepository creates a new instance of MatchUpdateRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMatchUpdateRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MatchUpdateRepository {
+	mock := &MatchUpdateRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,46 @@
+// Code generated by mockery v2.53.3. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// OutboxDispatcher is an autogenerated mock type for the OutboxDispatcher type
+type OutboxDispatcher struct {
+	mock.Mock
+}
+
+// Dispatch provides a mock function with given fields: ctx, id
+func (_m *OutboxDispatcher) Dispatch(ctx context.Context, id uint) error {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Dispatch")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint) error); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// NewOutboxDispatcher creates a new instance of OutboxDispatcher. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewOutboxDispatcher(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *OutboxDispatcher {
+	mock := &OutboxDispatcher{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
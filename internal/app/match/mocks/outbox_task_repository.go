@@ -0,0 +1,140 @@
+// Code generated by mockery v2.53.3. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+
+	models "github.com/andrewshostak/result-service/internal/app/models"
+
+	time "time"
+)
+
+// OutboxTaskRepository is an autogenerated mock type for the OutboxTaskRepository type
+type OutboxTaskRepository struct {
+	mock.Mock
+}
+
+// Create provides a mock function with given fields: ctx, outboxTask
+func (_m *OutboxTaskRepository) Create(ctx context.Context, outboxTask models.OutboxTask) (*models.OutboxTask, error) {
+	ret := _m.Called(ctx, outboxTask)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Create")
+	}
+
+	var r0 *models.OutboxTask
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, models.OutboxTask) (*models.OutboxTask, error)); ok {
+		return rf(ctx, outboxTask)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, models.OutboxTask) *models.OutboxTask); ok {
+		r0 = rf(ctx, outboxTask)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.OutboxTask)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, models.OutboxTask) error); ok {
+		r1 = rf(ctx, outboxTask)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListPending provides a mock function with given fields: ctx, createdBefore
+func (_m *OutboxTaskRepository) ListPending(ctx context.Context, createdBefore time.Time) ([]models.OutboxTask, error) {
+	ret := _m.Called(ctx, createdBefore)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListPending")
+	}
+
+	var r0 []models.OutboxTask
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time) ([]models.OutboxTask, error)); ok {
+		return rf(ctx, createdBefore)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time) []models.OutboxTask); ok {
+		r0 = rf(ctx, createdBefore)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.OutboxTask)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, time.Time) error); ok {
+		r1 = rf(ctx, createdBefore)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Lock provides a mock function with given fields: ctx, id
+func (_m *OutboxTaskRepository) Lock(ctx context.Context, id uint) (*models.OutboxTask, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Lock")
+	}
+
+	var r0 *models.OutboxTask
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint) (*models.OutboxTask, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint) *models.OutboxTask); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.OutboxTask)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Update provides a mock function with given fields: ctx, id, outboxTask
+func (_m *OutboxTaskRepository) Update(ctx context.Context, id uint, outboxTask models.OutboxTask) error {
+	ret := _m.Called(ctx, id, outboxTask)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Update")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint, models.OutboxTask) error); ok {
+		r0 = rf(ctx, id, outboxTask)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// NewOutboxTaskRepository creates a new instance of OutboxTaskRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewOutboxTaskRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *OutboxTaskRepository {
+	mock := &OutboxTaskRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,90 @@
+// Code generated by mockery v2.53.3. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+
+	models "github.com/andrewshostak/result-service/internal/app/models"
+)
+
+// ResultCheckAttemptRepository is an autogenerated mock type for the ResultCheckAttemptRepository type
+type ResultCheckAttemptRepository struct {
+	mock.Mock
+}
+
+// Create provides a mock function with given fields: ctx, attempt
+func (_m *ResultCheckAttemptRepository) Create(ctx context.Context, attempt models.ResultCheckAttempt) (*models.ResultCheckAttempt, error) {
+	ret := _m.Called(ctx, attempt)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Create")
+	}
+
+	var r0 *models.ResultCheckAttempt
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, models.ResultCheckAttempt) (*models.ResultCheckAttempt, error)); ok {
+		return rf(ctx, attempt)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, models.ResultCheckAttempt) *models.ResultCheckAttempt); ok {
+		r0 = rf(ctx, attempt)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.ResultCheckAttempt)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, models.ResultCheckAttempt) error); ok {
+		r1 = rf(ctx, attempt)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListByMatch provides a mock function with given fields: ctx, matchID
+func (_m *ResultCheckAttemptRepository) ListByMatch(ctx context.Context, matchID uint) ([]models.ResultCheckAttempt, error) {
+	ret := _m.Called(ctx, matchID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListByMatch")
+	}
+
+	var r0 []models.ResultCheckAttempt
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint) ([]models.ResultCheckAttempt, error)); ok {
+		return rf(ctx, matchID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint) []models.ResultCheckAttempt); ok {
+		r0 = rf(ctx, matchID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.ResultCheckAttempt)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint) error); ok {
+		r1 = rf(ctx, matchID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NewResultCheckAttemptRepository creates a new instance of ResultCheckAttemptRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewResultCheckAttemptRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *ResultCheckAttemptRepository {
+	mock := &ResultCheckAttemptRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
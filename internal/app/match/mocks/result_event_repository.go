@@ -0,0 +1,90 @@
+// Code generated by mockery v2.53.3. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+
+	models "github.com/andrewshostak/result-service/internal/app/models"
+)
+
+// ResultEventRepository is an autogenerated mock type for the ResultEventRepository type
+type ResultEventRepository struct {
+	mock.Mock
+}
+
+// Create provides a mock function with given fields: ctx, event
+func (_m *ResultEventRepository) Create(ctx context.Context, event models.ResultEvent) (*models.ResultEvent, error) {
+	ret := _m.Called(ctx, event)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Create")
+	}
+
+	var r0 *models.ResultEvent
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, models.ResultEvent) (*models.ResultEvent, error)); ok {
+		return rf(ctx, event)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, models.ResultEvent) *models.ResultEvent); ok {
+		r0 = rf(ctx, event)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.ResultEvent)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, models.ResultEvent) error); ok {
+		r1 = rf(ctx, event)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// List provides a mock function with given fields: ctx, filter
+func (_m *ResultEventRepository) List(ctx context.Context, filter models.ResultEventFilter) ([]models.ResultEvent, error) {
+	ret := _m.Called(ctx, filter)
+
+	if len(ret) == 0 {
+		panic("no return value specified for List")
+	}
+
+	var r0 []models.ResultEvent
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, models.ResultEventFilter) ([]models.ResultEvent, error)); ok {
+		return rf(ctx, filter)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, models.ResultEventFilter) []models.ResultEvent); ok {
+		r0 = rf(ctx, filter)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.ResultEvent)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, models.ResultEventFilter) error); ok {
+		r1 = rf(ctx, filter)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NewResultEventRepository creates a new instance of ResultEventRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewResultEventRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *ResultEventRepository {
+	mock := &ResultEventRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
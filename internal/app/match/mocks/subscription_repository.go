@@ -15,6 +15,36 @@ type SubscriptionRepository struct {
 	mock.Mock
 }
 
+// ListByMatchAndEventType provides a mock function with given fields: ctx, matchID, eventType
+func (_m *SubscriptionRepository) ListByMatchAndEventType(ctx context.Context, matchID uint, eventType models.NotificationEventType) ([]models.Subscription, error) {
+	ret := _m.Called(ctx, matchID, eventType)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListByMatchAndEventType")
+	}
+
+	var r0 []models.Subscription
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint, models.NotificationEventType) ([]models.Subscription, error)); ok {
+		return rf(ctx, matchID, eventType)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint, models.NotificationEventType) []models.Subscription); ok {
+		r0 = rf(ctx, matchID, eventType)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.Subscription)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint, models.NotificationEventType) error); ok {
+		r1 = rf(ctx, matchID, eventType)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // ListByMatchAndStatus provides a mock function with given fields: ctx, matchID, status
 func (_m *SubscriptionRepository) ListByMatchAndStatus(ctx context.Context, matchID uint, status models.SubscriptionStatus) ([]models.Subscription, error) {
 	ret := _m.Called(ctx, matchID, status)
@@ -45,6 +75,66 @@ func (_m *SubscriptionRepository) ListByMatchAndStatus(ctx context.Context, matc
 	return r0, r1
 }
 
+// ListByStatusAndMatchStatus provides a mock function with given fields: ctx, status, resultStatus
+func (_m *SubscriptionRepository) ListByStatusAndMatchStatus(ctx context.Context, status models.SubscriptionStatus, resultStatus models.ResultStatus) ([]models.Subscription, error) {
+	ret := _m.Called(ctx, status, resultStatus)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListByStatusAndMatchStatus")
+	}
+
+	var r0 []models.Subscription
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, models.SubscriptionStatus, models.ResultStatus) ([]models.Subscription, error)); ok {
+		return rf(ctx, status, resultStatus)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, models.SubscriptionStatus, models.ResultStatus) []models.Subscription); ok {
+		r0 = rf(ctx, status, resultStatus)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.Subscription)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, models.SubscriptionStatus, models.ResultStatus) error); ok {
+		r1 = rf(ctx, status, resultStatus)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListWithReminderByMatch provides a mock function with given fields: ctx, matchID
+func (_m *SubscriptionRepository) ListWithReminderByMatch(ctx context.Context, matchID uint) ([]models.Subscription, error) {
+	ret := _m.Called(ctx, matchID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListWithReminderByMatch")
+	}
+
+	var r0 []models.Subscription
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint) ([]models.Subscription, error)); ok {
+		return rf(ctx, matchID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint) []models.Subscription); ok {
+		r0 = rf(ctx, matchID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.Subscription)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint) error); ok {
+		r1 = rf(ctx, matchID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // Update provides a mock function with given fields: ctx, id, subscription
 func (_m *SubscriptionRepository) Update(ctx context.Context, id uint, subscription models.Subscription) error {
 	ret := _m.Called(ctx, id, subscription)
@@ -63,6 +153,24 @@ func (_m *SubscriptionRepository) Update(ctx context.Context, id uint, subscript
 	return r0
 }
 
+// UpdateStatusByMatch provides a mock function with given fields: ctx, matchID, from, to
+func (_m *SubscriptionRepository) UpdateStatusByMatch(ctx context.Context, matchID uint, from models.SubscriptionStatus, to models.SubscriptionStatus) error {
+	ret := _m.Called(ctx, matchID, from, to)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateStatusByMatch")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint, models.SubscriptionStatus, models.SubscriptionStatus) error); ok {
+		r0 = rf(ctx, matchID, from, to)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // NewSubscriptionRepository creates a new instance of SubscriptionRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewSubscriptionRepository(t interface {
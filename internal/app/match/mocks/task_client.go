@@ -47,6 +47,90 @@ func (_m *TaskClient) GetResultCheckTask(ctx context.Context, matchID uint, atte
 	return r0, r1
 }
 
+// GetSubscriberNotificationTask provides a mock function with given fields: ctx, subscriptionID
+func (_m *TaskClient) GetSubscriberNotificationTask(ctx context.Context, subscriptionID uint) (*models.Task, error) {
+	ret := _m.Called(ctx, subscriptionID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetSubscriberNotificationTask")
+	}
+
+	var r0 *models.Task
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint) (*models.Task, error)); ok {
+		return rf(ctx, subscriptionID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint) *models.Task); ok {
+		r0 = rf(ctx, subscriptionID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.Task)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint) error); ok {
+		r1 = rf(ctx, subscriptionID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ScheduleEventDelivery provides a mock function with given fields: ctx, eventDeliveryID, attempt, scheduleAt
+func (_m *TaskClient) ScheduleEventDelivery(ctx context.Context, eventDeliveryID uint, attempt uint, scheduleAt time.Time) error {
+	ret := _m.Called(ctx, eventDeliveryID, attempt, scheduleAt)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ScheduleEventDelivery")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint, uint, time.Time) error); ok {
+		r0 = rf(ctx, eventDeliveryID, attempt, scheduleAt)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ScheduleKickoffReminder provides a mock function with given fields: ctx, subscriptionID, kickoff, scheduleAt
+func (_m *TaskClient) ScheduleKickoffReminder(ctx context.Context, subscriptionID uint, kickoff time.Time, scheduleAt time.Time) error {
+	ret := _m.Called(ctx, subscriptionID, kickoff, scheduleAt)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ScheduleKickoffReminder")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint, time.Time, time.Time) error); ok {
+		r0 = rf(ctx, subscriptionID, kickoff, scheduleAt)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ScheduleLiveCheck provides a mock function with given fields: ctx, matchID, sequence, scheduleAt
+func (_m *TaskClient) ScheduleLiveCheck(ctx context.Context, matchID uint, sequence uint, scheduleAt time.Time) error {
+	ret := _m.Called(ctx, matchID, sequence, scheduleAt)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ScheduleLiveCheck")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint, uint, time.Time) error); ok {
+		r0 = rf(ctx, matchID, sequence, scheduleAt)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // ScheduleResultCheck provides a mock function with given fields: ctx, matchID, attempt, scheduleAt
 func (_m *TaskClient) ScheduleResultCheck(ctx context.Context, matchID uint, attempt uint, scheduleAt time.Time) (*models.Task, error) {
 	ret := _m.Called(ctx, matchID, attempt, scheduleAt)
@@ -77,17 +161,17 @@ func (_m *TaskClient) ScheduleResultCheck(ctx context.Context, matchID uint, att
 	return r0, r1
 }
 
-// ScheduleSubscriberNotification provides a mock function with given fields: ctx, subscriptionID
-func (_m *TaskClient) ScheduleSubscriberNotification(ctx context.Context, subscriptionID uint) error {
-	ret := _m.Called(ctx, subscriptionID)
+// ScheduleSubscriberNotification provides a mock function with given fields: ctx, subscriptionID, redeliveryID
+func (_m *TaskClient) ScheduleSubscriberNotification(ctx context.Context, subscriptionID uint, redeliveryID string) error {
+	ret := _m.Called(ctx, subscriptionID, redeliveryID)
 
 	if len(ret) == 0 {
 		panic("no return value specified for ScheduleSubscriberNotification")
 	}
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, uint) error); ok {
-		r0 = rf(ctx, subscriptionID)
+	if rf, ok := ret.Get(0).(func(context.Context, uint, string) error); ok {
+		r0 = rf(ctx, subscriptionID, redeliveryID)
 	} else {
 		r0 = ret.Error(0)
 	}
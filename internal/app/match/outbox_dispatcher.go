@@ -0,0 +1,159 @@
+package match
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/andrewshostak/result-service/config"
+	"github.com/andrewshostak/result-service/internal/app/models"
+)
+
+// OutboxDispatcherService creates cloud tasks for the outbox tasks. An outbox task is dispatched within a transaction,
+// so it is marked as dispatched only together with the state changes that follow the cloud task creation.
+// A cloud task that was created by a rolled back dispatch is found by its deterministic name on the next dispatch.
+type OutboxDispatcherService struct {
+	config                    config.Outbox
+	unitOfWork                UnitOfWork
+	outboxTaskRepository      OutboxTaskRepository
+	matchRepository           MatchRepository
+	checkResultTaskRepository CheckResultTaskRepository
+	taskClient                TaskClient
+	logger                    Logger
+}
+
+func NewOutboxDispatcherService(
+	config config.Outbox,
+	unitOfWork UnitOfWork,
+	outboxTaskRepository OutboxTaskRepository,
+	matchRepository MatchRepository,
+	checkResultTaskRepository CheckResultTaskRepository,
+	taskClient TaskClient,
+	logger Logger,
+) *OutboxDispatcherService {
+	return &OutboxDispatcherService{
+		config:                    config,
+		unitOfWork:                unitOfWork,
+		outboxTaskRepository:      outboxTaskRepository,
+		matchRepository:           matchRepository,
+		checkResultTaskRepository: checkResultTaskRepository,
+		taskClient:                taskClient,
+		logger:                    logger,
+	}
+}
+
+// Dispatch creates a cloud task for the pending outbox task. Outbox tasks that are already dispatched or are being
+// dispatched concurrently are skipped.
+func (s *OutboxDispatcherService) Dispatch(ctx context.Context, id uint) error {
+	err := s.unitOfWork.Do(ctx, func(ctx context.Context) error {
+		outboxTask, err := s.outboxTaskRepository.Lock(ctx, id)
+		if errors.As(err, &models.ResourceNotFoundError{}) {
+			s.logger.Debug().Uint("outbox_task_id", id).Msg("outbox task is not pending or is locked")
+			return nil
+		}
+
+		if err != nil {
+			return fmt.Errorf("failed to lock outbox task: %w", err)
+		}
+
+		if err := s.dispatch(ctx, *outboxTask); err != nil {
+			return err
+		}
+
+		dispatchedAt := time.Now()
+		if err := s.outboxTaskRepository.Update(ctx, id, models.OutboxTask{Status: models.OutboxDispatched, DispatchedAt: &dispatchedAt}); err != nil {
+			return fmt.Errorf("failed to update outbox task status to %s: %w", models.OutboxDispatched, err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		errMessage := err.Error()
+		if errUpdate := s.outboxTaskRepository.Update(ctx, id, models.OutboxTask{Error: &errMessage}); errUpdate != nil {
+			s.logger.Error().Err(errUpdate).Uint("outbox_task_id", id).Msg("failed to save outbox task error")
+		}
+
+		return fmt.Errorf("failed to dispatch outbox task with id %d: %w", id, err)
+	}
+
+	return nil
+}
+
+// DispatchPending dispatches outbox tasks that were not dispatched right after their creation.
+func (s *OutboxDispatcherService) DispatchPending(ctx context.Context) error {
+	outboxTasks, err := s.outboxTaskRepository.ListPending(ctx, time.Now().Add(-s.config.DispatchDelay))
+	if err != nil {
+		return fmt.Errorf("failed to list pending outbox tasks: %w", err)
+	}
+
+	var errs []error
+	for _, outboxTask := range outboxTasks {
+		if err := s.Dispatch(ctx, outboxTask.ID); err != nil {
+			s.logger.Error().Err(err).Uint("outbox_task_id", outboxTask.ID).Msg("failed to dispatch outbox task")
+			errs = append(errs, err)
+		}
+	}
+
+	s.logger.Info().Int("number_of_tasks", len(outboxTasks)).Int("number_of_failures", len(errs)).Msg("pending outbox tasks dispatched")
+
+	return errors.Join(errs...)
+}
+
+func (s *OutboxDispatcherService) dispatch(ctx context.Context, outboxTask models.OutboxTask) error {
+	switch outboxTask.Kind {
+	case models.OutboxKindResultCheck:
+		return s.scheduleResultCheck(ctx, outboxTask)
+	default:
+		return fmt.Errorf("unknown outbox task kind %s", outboxTask.Kind)
+	}
+}
+
+func (s *OutboxDispatcherService) scheduleResultCheck(ctx context.Context, outboxTask models.OutboxTask) error {
+	task, err := s.taskClient.ScheduleResultCheck(ctx, outboxTask.MatchID, outboxTask.AttemptNumber, outboxTask.ExecuteAt)
+	if err != nil && !errors.As(err, &models.ResourceAlreadyExistsError{}) {
+		return fmt.Errorf("failed to schedule result check task: %w", err)
+	}
+
+	if errors.As(err, &models.ResourceAlreadyExistsError{}) {
+		foundTask, errGetTask := s.taskClient.GetResultCheckTask(ctx, outboxTask.MatchID, outboxTask.AttemptNumber)
+		if errGetTask != nil {
+			return fmt.Errorf("failed to get result check task: %w", errGetTask)
+		}
+		task = foundTask
+	}
+
+	_, err = s.checkResultTaskRepository.Save(ctx, models.CheckResultTask{
+		MatchID:       outboxTask.MatchID,
+		Name:          task.Name,
+		AttemptNumber: outboxTask.AttemptNumber,
+		ExecuteAt:     task.ExecuteAt,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to save result-check task: %w", err)
+	}
+
+	match, err := s.matchRepository.One(ctx, models.Match{ID: outboxTask.MatchID})
+	if err != nil {
+		return fmt.Errorf("failed to get match: %w", err)
+	}
+
+	s.logger.Debug().Uint("match_id", match.ID).Time("execute_at", task.ExecuteAt).Msg("check result task scheduled")
+
+	// the match is already scheduled when a duplicate outbox task was created by a repeated match creation
+	if match.ResultStatus != models.NotScheduled {
+		return nil
+	}
+
+	transition, err := models.NewMatchStatusTransition(*match, models.Scheduled, "result check task is scheduled")
+	if err != nil {
+		return fmt.Errorf("failed to update match status to %s: %w", models.Scheduled, err)
+	}
+
+	_, err = s.matchRepository.Update(ctx, *transition)
+	if err != nil {
+		return fmt.Errorf("failed to update match status to %s: %w", models.Scheduled, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,294 @@
+package match_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/andrewshostak/result-service/config"
+	"github.com/andrewshostak/result-service/internal/app/match"
+	"github.com/andrewshostak/result-service/internal/app/match/mocks"
+	"github.com/andrewshostak/result-service/internal/app/models"
+	loggerinternal "github.com/andrewshostak/result-service/internal/infra/logger"
+	"github.com/andrewshostak/result-service/testutils"
+	"github.com/brianvoe/gofakeit/v6"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestOutboxDispatcherService_Dispatch(t *testing.T) {
+	ctx := context.Background()
+	errUnexpected := errors.New("unexpected error")
+
+	outboxTaskID := uint(gofakeit.Uint32())
+	matchID := uint(gofakeit.Uint8())
+	executeAt := time.Now().Add(time.Hour)
+
+	outboxTask := models.OutboxTask{
+		ID:            outboxTaskID,
+		Kind:          models.OutboxKindResultCheck,
+		MatchID:       matchID,
+		AttemptNumber: 1,
+		ExecuteAt:     executeAt,
+		Status:        models.OutboxPending,
+	}
+
+	clientTask := testutils.FakeTask(func(t *models.Task) {
+		t.ExecuteAt = executeAt
+	})
+
+	checkResultTask := models.CheckResultTask{
+		MatchID:       matchID,
+		Name:          clientTask.Name,
+		AttemptNumber: 1,
+		ExecuteAt:     executeAt,
+	}
+
+	notScheduledMatch := testutils.FakeMatch(func(r *models.Match) {
+		r.ID = matchID
+		r.ResultStatus = models.NotScheduled
+		r.CheckResultTask = &checkResultTask
+	})
+
+	scheduledMatch := notScheduledMatch
+	scheduledMatch.ResultStatus = models.Scheduled
+
+	dispatched := mock.MatchedBy(func(actual models.OutboxTask) bool {
+		return actual.Status == models.OutboxDispatched && actual.DispatchedAt != nil
+	})
+
+	withError := mock.MatchedBy(func(actual models.OutboxTask) bool {
+		return actual.Status == "" && actual.Error != nil
+	})
+
+	tests := []struct {
+		name                      string
+		outboxTaskRepository      func(t *testing.T) *mocks.OutboxTaskRepository
+		matchRepository           func(t *testing.T) *mocks.MatchRepository
+		checkResultTaskRepository func(t *testing.T) *mocks.CheckResultTaskRepository
+		taskClient                func(t *testing.T) *mocks.TaskClient
+		expectedErr               error
+	}{
+		{
+			name: "it returns an error when outbox task locking fails",
+			outboxTaskRepository: func(t *testing.T) *mocks.OutboxTaskRepository {
+				t.Helper()
+				m := mocks.NewOutboxTaskRepository(t)
+				m.On("Lock", ctx, outboxTaskID).Return(nil, errUnexpected).Once()
+				m.On("Update", ctx, outboxTaskID, withError).Return(nil).Once()
+				return m
+			},
+			expectedErr: fmt.Errorf("failed to lock outbox task: %w", errUnexpected),
+		},
+		{
+			name: "success - it skips outbox task that is not pending",
+			outboxTaskRepository: func(t *testing.T) *mocks.OutboxTaskRepository {
+				t.Helper()
+				m := mocks.NewOutboxTaskRepository(t)
+				m.On("Lock", ctx, outboxTaskID).Return(nil, models.NewResourceNotFoundError(errUnexpected)).Once()
+				return m
+			},
+		},
+		{
+			name: "it returns an error when task scheduling results in unexpected error",
+			outboxTaskRepository: func(t *testing.T) *mocks.OutboxTaskRepository {
+				t.Helper()
+				m := mocks.NewOutboxTaskRepository(t)
+				m.On("Lock", ctx, outboxTaskID).Return(&outboxTask, nil).Once()
+				m.On("Update", ctx, outboxTaskID, withError).Return(nil).Once()
+				return m
+			},
+			taskClient: func(t *testing.T) *mocks.TaskClient {
+				t.Helper()
+				m := mocks.NewTaskClient(t)
+				m.On("ScheduleResultCheck", ctx, matchID, uint(1), executeAt).Return(nil, errUnexpected).Once()
+				return m
+			},
+			expectedErr: fmt.Errorf("failed to schedule result check task: %w", errUnexpected),
+		},
+		{
+			name: "it returns an error when task already exists and its retrieval fails",
+			outboxTaskRepository: func(t *testing.T) *mocks.OutboxTaskRepository {
+				t.Helper()
+				m := mocks.NewOutboxTaskRepository(t)
+				m.On("Lock", ctx, outboxTaskID).Return(&outboxTask, nil).Once()
+				m.On("Update", ctx, outboxTaskID, withError).Return(nil).Once()
+				return m
+			},
+			taskClient: func(t *testing.T) *mocks.TaskClient {
+				t.Helper()
+				m := mocks.NewTaskClient(t)
+				m.On("ScheduleResultCheck", ctx, matchID, uint(1), executeAt).Return(nil, models.NewResourceAlreadyExistsError(errors.New("task exists"))).Once()
+				m.On("GetResultCheckTask", ctx, matchID, uint(1)).Return(nil, errUnexpected).Once()
+				return m
+			},
+			expectedErr: fmt.Errorf("failed to get result check task: %w", errUnexpected),
+		},
+		{
+			name: "it returns an error when check result task saving fails",
+			outboxTaskRepository: func(t *testing.T) *mocks.OutboxTaskRepository {
+				t.Helper()
+				m := mocks.NewOutboxTaskRepository(t)
+				m.On("Lock", ctx, outboxTaskID).Return(&outboxTask, nil).Once()
+				m.On("Update", ctx, outboxTaskID, withError).Return(nil).Once()
+				return m
+			},
+			taskClient: func(t *testing.T) *mocks.TaskClient {
+				t.Helper()
+				m := mocks.NewTaskClient(t)
+				m.On("ScheduleResultCheck", ctx, matchID, uint(1), executeAt).Return(&clientTask, nil).Once()
+				return m
+			},
+			checkResultTaskRepository: func(t *testing.T) *mocks.CheckResultTaskRepository {
+				t.Helper()
+				m := mocks.NewCheckResultTaskRepository(t)
+				m.On("Save", ctx, checkResultTask).Return(nil, errUnexpected).Once()
+				return m
+			},
+			expectedErr: fmt.Errorf("failed to save result-check task: %w", errUnexpected),
+		},
+		{
+			name: "it returns an error when match update fails",
+			outboxTaskRepository: func(t *testing.T) *mocks.OutboxTaskRepository {
+				t.Helper()
+				m := mocks.NewOutboxTaskRepository(t)
+				m.On("Lock", ctx, outboxTaskID).Return(&outboxTask, nil).Once()
+				m.On("Update", ctx, outboxTaskID, withError).Return(nil).Once()
+				return m
+			},
+			matchRepository: func(t *testing.T) *mocks.MatchRepository {
+				t.Helper()
+				m := mocks.NewMatchRepository(t)
+				m.On("One", ctx, models.Match{ID: matchID}).Return(&notScheduledMatch, nil).Once()
+				m.On("Update", ctx, transitionTo(matchID, models.Scheduled)).Return(nil, errUnexpected).Once()
+				return m
+			},
+			taskClient: func(t *testing.T) *mocks.TaskClient {
+				t.Helper()
+				m := mocks.NewTaskClient(t)
+				m.On("ScheduleResultCheck", ctx, matchID, uint(1), executeAt).Return(&clientTask, nil).Once()
+				return m
+			},
+			checkResultTaskRepository: func(t *testing.T) *mocks.CheckResultTaskRepository {
+				t.Helper()
+				m := mocks.NewCheckResultTaskRepository(t)
+				m.On("Save", ctx, checkResultTask).Return(&checkResultTask, nil).Once()
+				return m
+			},
+			expectedErr: fmt.Errorf("failed to update match status to %s: %w", models.Scheduled, errUnexpected),
+		},
+		{
+			name: "success - it schedules result check and marks outbox task as dispatched",
+			outboxTaskRepository: func(t *testing.T) *mocks.OutboxTaskRepository {
+				t.Helper()
+				m := mocks.NewOutboxTaskRepository(t)
+				m.On("Lock", ctx, outboxTaskID).Return(&outboxTask, nil).Once()
+				m.On("Update", ctx, outboxTaskID, dispatched).Return(nil).Once()
+				return m
+			},
+			matchRepository: func(t *testing.T) *mocks.MatchRepository {
+				t.Helper()
+				m := mocks.NewMatchRepository(t)
+				m.On("One", ctx, models.Match{ID: matchID}).Return(&notScheduledMatch, nil).Once()
+				m.On("Update", ctx, transitionTo(matchID, models.Scheduled)).Return(&scheduledMatch, nil).Once()
+				return m
+			},
+			taskClient: func(t *testing.T) *mocks.TaskClient {
+				t.Helper()
+				m := mocks.NewTaskClient(t)
+				m.On("ScheduleResultCheck", ctx, matchID, uint(1), executeAt).Return(&clientTask, nil).Once()
+				return m
+			},
+			checkResultTaskRepository: func(t *testing.T) *mocks.CheckResultTaskRepository {
+				t.Helper()
+				m := mocks.NewCheckResultTaskRepository(t)
+				m.On("Save", ctx, checkResultTask).Return(&checkResultTask, nil).Once()
+				return m
+			},
+		},
+		{
+			name: "success - it uses existing task and doesn't update already scheduled match",
+			outboxTaskRepository: func(t *testing.T) *mocks.OutboxTaskRepository {
+				t.Helper()
+				m := mocks.NewOutboxTaskRepository(t)
+				m.On("Lock", ctx, outboxTaskID).Return(&outboxTask, nil).Once()
+				m.On("Update", ctx, outboxTaskID, dispatched).Return(nil).Once()
+				return m
+			},
+			matchRepository: func(t *testing.T) *mocks.MatchRepository {
+				t.Helper()
+				m := mocks.NewMatchRepository(t)
+				m.On("One", ctx, models.Match{ID: matchID}).Return(&scheduledMatch, nil).Once()
+				return m
+			},
+			taskClient: func(t *testing.T) *mocks.TaskClient {
+				t.Helper()
+				m := mocks.NewTaskClient(t)
+				m.On("ScheduleResultCheck", ctx, matchID, uint(1), executeAt).Return(nil, models.NewResourceAlreadyExistsError(errors.New("task exists"))).Once()
+				m.On("GetResultCheckTask", ctx, matchID, uint(1)).Return(&clientTask, nil).Once()
+				return m
+			},
+			checkResultTaskRepository: func(t *testing.T) *mocks.CheckResultTaskRepository {
+				t.Helper()
+				m := mocks.NewCheckResultTaskRepository(t)
+				m.On("Save", ctx, checkResultTask).Return(&checkResultTask, nil).Once()
+				return m
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var outboxTaskRepository *mocks.OutboxTaskRepository
+			if tt.outboxTaskRepository != nil {
+				outboxTaskRepository = tt.outboxTaskRepository(t)
+			}
+
+			var matchRepository *mocks.MatchRepository
+			if tt.matchRepository != nil {
+				matchRepository = tt.matchRepository(t)
+			}
+
+			var checkResultTaskRepository *mocks.CheckResultTaskRepository
+			if tt.checkResultTaskRepository != nil {
+				checkResultTaskRepository = tt.checkResultTaskRepository(t)
+			}
+
+			var taskClient *mocks.TaskClient
+			if tt.taskClient != nil {
+				taskClient = tt.taskClient(t)
+			}
+
+			logger := loggerinternal.SetupLogger()
+
+			ds := match.NewOutboxDispatcherService(
+				config.Outbox{DispatchDelay: time.Minute},
+				passThroughUnitOfWork(t),
+				outboxTaskRepository,
+				matchRepository,
+				checkResultTaskRepository,
+				taskClient,
+				logger,
+			)
+
+			err := ds.Dispatch(ctx, outboxTaskID)
+			if tt.expectedErr != nil {
+				assert.ErrorContains(t, err, tt.expectedErr.Error())
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+// passThroughUnitOfWork returns a unit of work mock that calls the passed function without a transaction.
+func passThroughUnitOfWork(t *testing.T) *mocks.UnitOfWork {
+	t.Helper()
+	m := mocks.NewUnitOfWork(t)
+	m.On("Do", mock.Anything, mock.Anything).Return(func(ctx context.Context, fn func(ctx context.Context) error) error {
+		return fn(ctx)
+	}).Maybe()
+	return m
+}
@@ -0,0 +1,237 @@
+package match
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/andrewshostak/result-service/config"
+	"github.com/andrewshostak/result-service/internal/app/models"
+	"github.com/google/uuid"
+)
+
+// ReconcilerService finds matches, subscriptions and event deliveries that got stuck because a cloud task was lost
+// or never created, and re-creates the missing tasks. Subscriptions and event deliveries waiting for a retry
+// (subscriber_error), and the ones that are not delivered by design (unverified, suspended, dead_letter, unsubscribed)
+// are not reconciled.
+type ReconcilerService struct {
+	config                  config.Reconciliation
+	matchRepository         MatchRepository
+	subscriptionRepository  SubscriptionRepository
+	outboxTaskRepository    OutboxTaskRepository
+	eventDeliveryRepository EventDeliveryRepository
+	taskClient              TaskClient
+	outboxDispatcher        OutboxDispatcher
+	logger                  Logger
+}
+
+func NewReconcilerService(
+	config config.Reconciliation,
+	matchRepository MatchRepository,
+	subscriptionRepository SubscriptionRepository,
+	outboxTaskRepository OutboxTaskRepository,
+	eventDeliveryRepository EventDeliveryRepository,
+	taskClient TaskClient,
+	outboxDispatcher OutboxDispatcher,
+	logger Logger,
+) *ReconcilerService {
+	return &ReconcilerService{
+		config:                  config,
+		matchRepository:         matchRepository,
+		subscriptionRepository:  subscriptionRepository,
+		outboxTaskRepository:    outboxTaskRepository,
+		eventDeliveryRepository: eventDeliveryRepository,
+		taskClient:              taskClient,
+		outboxDispatcher:        outboxDispatcher,
+		logger:                  logger,
+	}
+}
+
+func (s *ReconcilerService) Reconcile(ctx context.Context) (*models.ReconciliationReport, error) {
+	report := models.ReconciliationReport{StartedAt: time.Now(), Items: []models.ReconciliationItem{}}
+
+	// not_scheduled matches left by a failed dispatch are scheduled by the dispatch of their pending outbox tasks
+	staleOutboxTasks, err := s.outboxTaskRepository.ListPending(ctx, report.StartedAt.Add(-s.config.StuckThreshold))
+	if err != nil {
+		return nil, fmt.Errorf("failed to list stale outbox tasks: %w", err)
+	}
+
+	for _, outboxTask := range staleOutboxTasks {
+		report.Items = append(report.Items, s.reconcileOutboxTask(ctx, outboxTask))
+	}
+
+	stuckMatches, err := s.matchRepository.ListScheduledBefore(ctx, report.StartedAt.Add(-s.config.StuckThreshold))
+	if err != nil {
+		return nil, fmt.Errorf("failed to list stuck matches: %w", err)
+	}
+
+	for _, match := range stuckMatches {
+		report.Items = append(report.Items, s.reconcileStuckMatch(ctx, match))
+	}
+
+	pendingSubscriptions, err := s.subscriptionRepository.ListByStatusAndMatchStatus(ctx, models.PendingSub, models.Received)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list pending subscriptions: %w", err)
+	}
+
+	for _, subscription := range pendingSubscriptions {
+		report.Items = append(report.Items, s.reconcileSubscription(ctx, subscription, models.IssuePendingNotification))
+	}
+
+	failedSubscriptions, err := s.subscriptionRepository.ListByStatusAndMatchStatus(ctx, models.SchedulingErrorSub, models.Received)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list subscriptions with scheduling error: %w", err)
+	}
+
+	for _, subscription := range failedSubscriptions {
+		report.Items = append(report.Items, s.reconcileSubscription(ctx, subscription, models.IssueNotificationSchedulingError))
+	}
+
+	failedDeliveries, err := s.eventDeliveryRepository.ListByStatus(ctx, models.SchedulingErrorSub)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list event deliveries with scheduling error: %w", err)
+	}
+
+	for _, delivery := range failedDeliveries {
+		report.Items = append(report.Items, s.reconcileEventDelivery(ctx, delivery))
+	}
+
+	report.FinishedAt = time.Now()
+
+	s.logger.Info().Int("number_of_items", len(report.Items)).Msg("reconciliation finished")
+
+	return &report, nil
+}
+
+// reconcileOutboxTask dispatches an outbox task which neither the immediate dispatch nor the outbox dispatch trigger
+// managed to dispatch.
+func (s *ReconcilerService) reconcileOutboxTask(ctx context.Context, outboxTask models.OutboxTask) models.ReconciliationItem {
+	item := models.ReconciliationItem{MatchID: outboxTask.MatchID, Issue: models.IssueStaleOutboxTask}
+
+	if err := s.outboxDispatcher.Dispatch(ctx, outboxTask.ID); err != nil {
+		return s.failedItem(item, fmt.Errorf("failed to dispatch outbox task: %w", err))
+	}
+
+	s.logger.Info().Uint("match_id", outboxTask.MatchID).Uint("outbox_task_id", outboxTask.ID).Msg("stale outbox task dispatched")
+
+	item.Action = models.ActionOutboxTaskDispatched
+	return item
+}
+
+// reconcileStuckMatch re-schedules a result check through the outbox when the task of a scheduled match is no longer
+// present in the queue. A present task means cloud tasks is still retrying it, so nothing is done in that case.
+// The dispatch treats an already existing task of the next attempt as scheduled and saves it as the check result task.
+func (s *ReconcilerService) reconcileStuckMatch(ctx context.Context, match models.Match) models.ReconciliationItem {
+	item := models.ReconciliationItem{MatchID: match.ID, Issue: models.IssueStuckResultCheck}
+
+	if match.CheckResultTask == nil {
+		return s.failedItem(item, errors.New("match relation result check task doesn't exist"))
+	}
+
+	_, err := s.taskClient.GetResultCheckTask(ctx, match.ID, match.CheckResultTask.AttemptNumber)
+	if err == nil {
+		item.Action = models.ActionNone
+		return item
+	}
+
+	if !errors.As(err, &models.ResourceNotFoundError{}) {
+		return s.failedItem(item, fmt.Errorf("failed to get result check task: %w", err))
+	}
+
+	attemptNumber := match.CheckResultTask.AttemptNumber + 1
+
+	outboxTask, err := s.outboxTaskRepository.Create(ctx, models.OutboxTask{
+		Kind:          models.OutboxKindResultCheck,
+		MatchID:       match.ID,
+		AttemptNumber: attemptNumber,
+		ExecuteAt:     time.Now(),
+	})
+	if err != nil {
+		return s.failedItem(item, fmt.Errorf("failed to create result check outbox task: %w", err))
+	}
+
+	// when the dispatch fails, the outbox task stays pending and is dispatched by the outbox dispatch trigger
+	if err := s.outboxDispatcher.Dispatch(ctx, outboxTask.ID); err != nil {
+		return s.failedItem(item, fmt.Errorf("failed to dispatch result check outbox task: %w", err))
+	}
+
+	s.logger.Info().Uint("match_id", match.ID).Uint("attempt_number", attemptNumber).Msg("lost result check task re-created")
+
+	item.Action = models.ActionResultCheckRescheduled
+	return item
+}
+
+// reconcileSubscription re-creates a notification task of a subscription whose match result is already received.
+// Present task means the notification is still in the queue, so nothing is done in that case. Cloud tasks keeps
+// the name of a gone task taken for a while, so the notification is re-created under a unique redelivery name.
+func (s *ReconcilerService) reconcileSubscription(ctx context.Context, subscription models.Subscription, issue models.ReconciliationIssue) models.ReconciliationItem {
+	subscriptionID := subscription.ID
+	item := models.ReconciliationItem{MatchID: subscription.MatchID, SubscriptionID: &subscriptionID, Issue: issue}
+
+	_, err := s.taskClient.GetSubscriberNotificationTask(ctx, subscription.ID)
+	if err == nil {
+		item.Action = models.ActionNone
+		return item
+	}
+
+	if !errors.As(err, &models.ResourceNotFoundError{}) {
+		return s.failedItem(item, fmt.Errorf("failed to get subscriber notification task: %w", err))
+	}
+
+	redeliveryID := uuid.NewString()
+	if err := s.taskClient.ScheduleSubscriberNotification(ctx, subscription.ID, redeliveryID); err != nil {
+		return s.failedItem(item, fmt.Errorf("failed to schedule subscriber notification: %w", err))
+	}
+
+	if subscription.Status != models.PendingSub {
+		if err := s.subscriptionRepository.Update(ctx, subscription.ID, models.Subscription{Status: models.PendingSub}); err != nil {
+			return s.failedItem(item, fmt.Errorf("failed to update subscription status to %s: %w", models.PendingSub, err))
+		}
+	}
+
+	s.logger.Info().Uint("subscription_id", subscription.ID).Str("redelivery_id", redeliveryID).Msg("lost subscriber notification task re-created")
+
+	item.Action = models.ActionNotificationRescheduled
+	return item
+}
+
+// reconcileEventDelivery creates the task of the current attempt of an event delivery whose scheduling failed.
+// Already existing task means the scheduling failed after the task was created, so only the status is fixed then.
+func (s *ReconcilerService) reconcileEventDelivery(ctx context.Context, delivery models.EventDelivery) models.ReconciliationItem {
+	deliveryID := delivery.ID
+	subscriptionID := delivery.SubscriptionID
+	item := models.ReconciliationItem{SubscriptionID: &subscriptionID, EventDeliveryID: &deliveryID, Issue: models.IssueEventDeliverySchedulingError}
+
+	if delivery.MatchEvent != nil {
+		item.MatchID = delivery.MatchEvent.MatchID
+	}
+
+	err := s.taskClient.ScheduleEventDelivery(ctx, delivery.ID, delivery.DeliveryAttempts, time.Now())
+	if err != nil && !errors.As(err, &models.ResourceAlreadyExistsError{}) {
+		return s.failedItem(item, fmt.Errorf("failed to schedule event delivery: %w", err))
+	}
+
+	item.Action = models.ActionEventDeliveryRescheduled
+	if err != nil {
+		item.Action = models.ActionNone
+	}
+
+	if err := s.eventDeliveryRepository.Update(ctx, delivery.ID, models.EventDelivery{Status: models.PendingSub, SubscriberError: delivery.SubscriberError}); err != nil {
+		return s.failedItem(item, fmt.Errorf("failed to update event delivery status to %s: %w", models.PendingSub, err))
+	}
+
+	s.logger.Info().Uint("event_delivery_id", delivery.ID).Str("action", string(item.Action)).Msg("event delivery with scheduling error reconciled")
+
+	return item
+}
+
+func (s *ReconcilerService) failedItem(item models.ReconciliationItem, err error) models.ReconciliationItem {
+	s.logger.Error().Err(err).Uint("match_id", item.MatchID).Str("issue", string(item.Issue)).Msg("failed to reconcile")
+
+	errMessage := err.Error()
+	item.Action = models.ActionFailed
+	item.Error = &errMessage
+
+	return item
+}
@@ -0,0 +1,463 @@
+package match_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/andrewshostak/result-service/config"
+	"github.com/andrewshostak/result-service/internal/app/match"
+	"github.com/andrewshostak/result-service/internal/app/match/mocks"
+	"github.com/andrewshostak/result-service/internal/app/models"
+	loggerinternal "github.com/andrewshostak/result-service/internal/infra/logger"
+	"github.com/andrewshostak/result-service/testutils"
+	"github.com/brianvoe/gofakeit/v6"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestReconcilerService_Reconcile(t *testing.T) {
+	ctx := context.Background()
+	unexpectedErr := errors.New("unexpected error")
+
+	stuckMatch := testutils.FakeMatch(func(r *models.Match) {
+		r.ResultStatus = models.Scheduled
+		r.CheckResultTask = &models.CheckResultTask{AttemptNumber: 2}
+	})
+
+	pendingSubscription := testutils.FakeSubscription(func(r *models.Subscription) {
+		r.Status = models.PendingSub
+	})
+
+	failedSubscription := testutils.FakeSubscription(func(r *models.Subscription) {
+		r.Status = models.SchedulingErrorSub
+	})
+
+	staleOutboxTask := models.OutboxTask{ID: uint(gofakeit.Uint32()), Kind: models.OutboxKindResultCheck, MatchID: stuckMatch.ID, AttemptNumber: 1}
+
+	failedDelivery := models.EventDelivery{
+		ID:               uint(gofakeit.Uint32()),
+		SubscriptionID:   pendingSubscription.ID,
+		Status:           models.SchedulingErrorSub,
+		DeliveryAttempts: 2,
+		MatchEvent:       &models.MatchEvent{MatchID: stuckMatch.ID},
+	}
+
+	scheduledDelivery := models.EventDelivery{
+		ID:             failedDelivery.ID + 1,
+		SubscriptionID: pendingSubscription.ID,
+		Status:         models.SchedulingErrorSub,
+		MatchEvent:     &models.MatchEvent{MatchID: stuckMatch.ID},
+	}
+
+	clientTask := testutils.FakeTask()
+	outboxTaskID := uint(gofakeit.Uint32())
+
+	isRedeliveryID := func(redeliveryID string) bool {
+		return redeliveryID != ""
+	}
+
+	tests := []struct {
+		name                    string
+		expectedErr             error
+		expectedActions         []models.ReconciliationAction
+		matchRepository         func(t *testing.T) *mocks.MatchRepository
+		subscriptionRepository  func(t *testing.T) *mocks.SubscriptionRepository
+		outboxTaskRepository    func(t *testing.T) *mocks.OutboxTaskRepository
+		eventDeliveryRepository func(t *testing.T) *mocks.EventDeliveryRepository
+		outboxDispatcher        func(t *testing.T) *mocks.OutboxDispatcher
+		taskClient              func(t *testing.T) *mocks.TaskClient
+	}{
+		{
+			name: "it returns an error when stale outbox tasks listing fails",
+			outboxTaskRepository: func(t *testing.T) *mocks.OutboxTaskRepository {
+				t.Helper()
+				m := mocks.NewOutboxTaskRepository(t)
+				m.On("ListPending", ctx, mock.Anything).Return(nil, unexpectedErr).Once()
+				return m
+			},
+			expectedErr: fmt.Errorf("failed to list stale outbox tasks: %w", unexpectedErr),
+		},
+		{
+			name: "it returns an error when stuck matches listing fails",
+			outboxTaskRepository: func(t *testing.T) *mocks.OutboxTaskRepository {
+				t.Helper()
+				m := mocks.NewOutboxTaskRepository(t)
+				m.On("ListPending", ctx, mock.Anything).Return([]models.OutboxTask{}, nil).Once()
+				return m
+			},
+			matchRepository: func(t *testing.T) *mocks.MatchRepository {
+				t.Helper()
+				m := mocks.NewMatchRepository(t)
+				m.On("ListScheduledBefore", ctx, mock.Anything).Return(nil, unexpectedErr).Once()
+				return m
+			},
+			expectedErr: fmt.Errorf("failed to list stuck matches: %w", unexpectedErr),
+		},
+		{
+			name: "it returns an error when pending subscriptions listing fails",
+			outboxTaskRepository: func(t *testing.T) *mocks.OutboxTaskRepository {
+				t.Helper()
+				m := mocks.NewOutboxTaskRepository(t)
+				m.On("ListPending", ctx, mock.Anything).Return([]models.OutboxTask{}, nil).Once()
+				return m
+			},
+			matchRepository: func(t *testing.T) *mocks.MatchRepository {
+				t.Helper()
+				m := mocks.NewMatchRepository(t)
+				m.On("ListScheduledBefore", ctx, mock.Anything).Return([]models.Match{}, nil).Once()
+				return m
+			},
+			subscriptionRepository: func(t *testing.T) *mocks.SubscriptionRepository {
+				t.Helper()
+				m := mocks.NewSubscriptionRepository(t)
+				m.On("ListByStatusAndMatchStatus", ctx, models.PendingSub, models.Received).Return(nil, unexpectedErr).Once()
+				return m
+			},
+			expectedErr: fmt.Errorf("failed to list pending subscriptions: %w", unexpectedErr),
+		},
+		{
+			name: "it returns an error when event deliveries listing fails",
+			outboxTaskRepository: func(t *testing.T) *mocks.OutboxTaskRepository {
+				t.Helper()
+				m := mocks.NewOutboxTaskRepository(t)
+				m.On("ListPending", ctx, mock.Anything).Return([]models.OutboxTask{}, nil).Once()
+				return m
+			},
+			matchRepository: func(t *testing.T) *mocks.MatchRepository {
+				t.Helper()
+				m := mocks.NewMatchRepository(t)
+				m.On("ListScheduledBefore", ctx, mock.Anything).Return([]models.Match{}, nil).Once()
+				return m
+			},
+			subscriptionRepository: func(t *testing.T) *mocks.SubscriptionRepository {
+				t.Helper()
+				m := mocks.NewSubscriptionRepository(t)
+				m.On("ListByStatusAndMatchStatus", ctx, models.PendingSub, models.Received).Return([]models.Subscription{}, nil).Once()
+				m.On("ListByStatusAndMatchStatus", ctx, models.SchedulingErrorSub, models.Received).Return([]models.Subscription{}, nil).Once()
+				return m
+			},
+			eventDeliveryRepository: func(t *testing.T) *mocks.EventDeliveryRepository {
+				t.Helper()
+				m := mocks.NewEventDeliveryRepository(t)
+				m.On("ListByStatus", ctx, models.SchedulingErrorSub).Return(nil, unexpectedErr).Once()
+				return m
+			},
+			expectedErr: fmt.Errorf("failed to list event deliveries with scheduling error: %w", unexpectedErr),
+		},
+		{
+			name: "success - it does nothing when tasks are still present in the queues",
+			outboxTaskRepository: func(t *testing.T) *mocks.OutboxTaskRepository {
+				t.Helper()
+				m := mocks.NewOutboxTaskRepository(t)
+				m.On("ListPending", ctx, mock.Anything).Return([]models.OutboxTask{}, nil).Once()
+				return m
+			},
+			matchRepository: func(t *testing.T) *mocks.MatchRepository {
+				t.Helper()
+				m := mocks.NewMatchRepository(t)
+				m.On("ListScheduledBefore", ctx, mock.Anything).Return([]models.Match{stuckMatch}, nil).Once()
+				return m
+			},
+			subscriptionRepository: func(t *testing.T) *mocks.SubscriptionRepository {
+				t.Helper()
+				m := mocks.NewSubscriptionRepository(t)
+				m.On("ListByStatusAndMatchStatus", ctx, models.PendingSub, models.Received).Return([]models.Subscription{pendingSubscription}, nil).Once()
+				m.On("ListByStatusAndMatchStatus", ctx, models.SchedulingErrorSub, models.Received).Return([]models.Subscription{}, nil).Once()
+				return m
+			},
+			eventDeliveryRepository: func(t *testing.T) *mocks.EventDeliveryRepository {
+				t.Helper()
+				m := mocks.NewEventDeliveryRepository(t)
+				m.On("ListByStatus", ctx, models.SchedulingErrorSub).Return([]models.EventDelivery{}, nil).Once()
+				return m
+			},
+			taskClient: func(t *testing.T) *mocks.TaskClient {
+				t.Helper()
+				m := mocks.NewTaskClient(t)
+				m.On("GetResultCheckTask", ctx, stuckMatch.ID, uint(2)).Return(&clientTask, nil).Once()
+				m.On("GetSubscriberNotificationTask", ctx, pendingSubscription.ID).Return(&clientTask, nil).Once()
+				return m
+			},
+			expectedActions: []models.ReconciliationAction{models.ActionNone, models.ActionNone},
+		},
+		{
+			name: "success - it re-creates lost tasks",
+			matchRepository: func(t *testing.T) *mocks.MatchRepository {
+				t.Helper()
+				m := mocks.NewMatchRepository(t)
+				m.On("ListScheduledBefore", ctx, mock.Anything).Return([]models.Match{stuckMatch}, nil).Once()
+				return m
+			},
+			subscriptionRepository: func(t *testing.T) *mocks.SubscriptionRepository {
+				t.Helper()
+				m := mocks.NewSubscriptionRepository(t)
+				m.On("ListByStatusAndMatchStatus", ctx, models.PendingSub, models.Received).Return([]models.Subscription{pendingSubscription}, nil).Once()
+				m.On("ListByStatusAndMatchStatus", ctx, models.SchedulingErrorSub, models.Received).Return([]models.Subscription{failedSubscription}, nil).Once()
+				m.On("Update", ctx, failedSubscription.ID, models.Subscription{Status: models.PendingSub}).Return(nil).Once()
+				return m
+			},
+			outboxTaskRepository: func(t *testing.T) *mocks.OutboxTaskRepository {
+				t.Helper()
+				m := mocks.NewOutboxTaskRepository(t)
+				m.On("ListPending", ctx, mock.Anything).Return([]models.OutboxTask{}, nil).Once()
+				m.On("Create", ctx, mock.MatchedBy(func(outboxTask models.OutboxTask) bool {
+					return outboxTask.Kind == models.OutboxKindResultCheck && outboxTask.MatchID == stuckMatch.ID && outboxTask.AttemptNumber == 3
+				})).Return(&models.OutboxTask{ID: outboxTaskID}, nil).Once()
+				return m
+			},
+			outboxDispatcher: func(t *testing.T) *mocks.OutboxDispatcher {
+				t.Helper()
+				m := mocks.NewOutboxDispatcher(t)
+				m.On("Dispatch", ctx, outboxTaskID).Return(nil).Once()
+				return m
+			},
+			eventDeliveryRepository: func(t *testing.T) *mocks.EventDeliveryRepository {
+				t.Helper()
+				m := mocks.NewEventDeliveryRepository(t)
+				m.On("ListByStatus", ctx, models.SchedulingErrorSub).Return([]models.EventDelivery{}, nil).Once()
+				return m
+			},
+			taskClient: func(t *testing.T) *mocks.TaskClient {
+				t.Helper()
+				m := mocks.NewTaskClient(t)
+				m.On("GetResultCheckTask", ctx, stuckMatch.ID, uint(2)).Return(nil, models.NewResourceNotFoundError(unexpectedErr)).Once()
+				m.On("GetSubscriberNotificationTask", ctx, pendingSubscription.ID).Return(nil, models.NewResourceNotFoundError(unexpectedErr)).Once()
+				m.On("ScheduleSubscriberNotification", ctx, pendingSubscription.ID, mock.MatchedBy(isRedeliveryID)).Return(nil).Once()
+				m.On("GetSubscriberNotificationTask", ctx, failedSubscription.ID).Return(nil, models.NewResourceNotFoundError(unexpectedErr)).Once()
+				m.On("ScheduleSubscriberNotification", ctx, failedSubscription.ID, mock.MatchedBy(isRedeliveryID)).Return(nil).Once()
+				return m
+			},
+			expectedActions: []models.ReconciliationAction{
+				models.ActionResultCheckRescheduled,
+				models.ActionNotificationRescheduled,
+				models.ActionNotificationRescheduled,
+			},
+		},
+		{
+			name: "success - it reports a failed item when result check outbox task dispatch fails",
+			matchRepository: func(t *testing.T) *mocks.MatchRepository {
+				t.Helper()
+				m := mocks.NewMatchRepository(t)
+				m.On("ListScheduledBefore", ctx, mock.Anything).Return([]models.Match{stuckMatch}, nil).Once()
+				return m
+			},
+			subscriptionRepository: func(t *testing.T) *mocks.SubscriptionRepository {
+				t.Helper()
+				m := mocks.NewSubscriptionRepository(t)
+				m.On("ListByStatusAndMatchStatus", ctx, models.PendingSub, models.Received).Return([]models.Subscription{}, nil).Once()
+				m.On("ListByStatusAndMatchStatus", ctx, models.SchedulingErrorSub, models.Received).Return([]models.Subscription{}, nil).Once()
+				return m
+			},
+			outboxTaskRepository: func(t *testing.T) *mocks.OutboxTaskRepository {
+				t.Helper()
+				m := mocks.NewOutboxTaskRepository(t)
+				m.On("ListPending", ctx, mock.Anything).Return([]models.OutboxTask{}, nil).Once()
+				m.On("Create", ctx, mock.Anything).Return(&models.OutboxTask{ID: outboxTaskID}, nil).Once()
+				return m
+			},
+			outboxDispatcher: func(t *testing.T) *mocks.OutboxDispatcher {
+				t.Helper()
+				m := mocks.NewOutboxDispatcher(t)
+				m.On("Dispatch", ctx, outboxTaskID).Return(unexpectedErr).Once()
+				return m
+			},
+			eventDeliveryRepository: func(t *testing.T) *mocks.EventDeliveryRepository {
+				t.Helper()
+				m := mocks.NewEventDeliveryRepository(t)
+				m.On("ListByStatus", ctx, models.SchedulingErrorSub).Return([]models.EventDelivery{}, nil).Once()
+				return m
+			},
+			taskClient: func(t *testing.T) *mocks.TaskClient {
+				t.Helper()
+				m := mocks.NewTaskClient(t)
+				m.On("GetResultCheckTask", ctx, stuckMatch.ID, uint(2)).Return(nil, models.NewResourceNotFoundError(unexpectedErr)).Once()
+				return m
+			},
+			expectedActions: []models.ReconciliationAction{models.ActionFailed},
+		},
+		{
+			name: "success - it reports failed items and continues",
+			outboxTaskRepository: func(t *testing.T) *mocks.OutboxTaskRepository {
+				t.Helper()
+				m := mocks.NewOutboxTaskRepository(t)
+				m.On("ListPending", ctx, mock.Anything).Return([]models.OutboxTask{}, nil).Once()
+				return m
+			},
+			matchRepository: func(t *testing.T) *mocks.MatchRepository {
+				t.Helper()
+				m := mocks.NewMatchRepository(t)
+				m.On("ListScheduledBefore", ctx, mock.Anything).Return([]models.Match{stuckMatch}, nil).Once()
+				return m
+			},
+			subscriptionRepository: func(t *testing.T) *mocks.SubscriptionRepository {
+				t.Helper()
+				m := mocks.NewSubscriptionRepository(t)
+				m.On("ListByStatusAndMatchStatus", ctx, models.PendingSub, models.Received).Return([]models.Subscription{}, nil).Once()
+				m.On("ListByStatusAndMatchStatus", ctx, models.SchedulingErrorSub, models.Received).Return([]models.Subscription{failedSubscription}, nil).Once()
+				return m
+			},
+			eventDeliveryRepository: func(t *testing.T) *mocks.EventDeliveryRepository {
+				t.Helper()
+				m := mocks.NewEventDeliveryRepository(t)
+				m.On("ListByStatus", ctx, models.SchedulingErrorSub).Return([]models.EventDelivery{}, nil).Once()
+				return m
+			},
+			taskClient: func(t *testing.T) *mocks.TaskClient {
+				t.Helper()
+				m := mocks.NewTaskClient(t)
+				m.On("GetResultCheckTask", ctx, stuckMatch.ID, uint(2)).Return(nil, unexpectedErr).Once()
+				m.On("GetSubscriberNotificationTask", ctx, failedSubscription.ID).Return(nil, models.NewResourceNotFoundError(unexpectedErr)).Once()
+				m.On("ScheduleSubscriberNotification", ctx, failedSubscription.ID, mock.MatchedBy(isRedeliveryID)).Return(unexpectedErr).Once()
+				return m
+			},
+			expectedActions: []models.ReconciliationAction{models.ActionFailed, models.ActionFailed},
+		},
+		{
+			name: "success - it reports a failed item when subscriber notification task retrieval fails",
+			outboxTaskRepository: func(t *testing.T) *mocks.OutboxTaskRepository {
+				t.Helper()
+				m := mocks.NewOutboxTaskRepository(t)
+				m.On("ListPending", ctx, mock.Anything).Return([]models.OutboxTask{}, nil).Once()
+				return m
+			},
+			matchRepository: func(t *testing.T) *mocks.MatchRepository {
+				t.Helper()
+				m := mocks.NewMatchRepository(t)
+				m.On("ListScheduledBefore", ctx, mock.Anything).Return([]models.Match{}, nil).Once()
+				return m
+			},
+			subscriptionRepository: func(t *testing.T) *mocks.SubscriptionRepository {
+				t.Helper()
+				m := mocks.NewSubscriptionRepository(t)
+				m.On("ListByStatusAndMatchStatus", ctx, models.PendingSub, models.Received).Return([]models.Subscription{pendingSubscription}, nil).Once()
+				m.On("ListByStatusAndMatchStatus", ctx, models.SchedulingErrorSub, models.Received).Return([]models.Subscription{}, nil).Once()
+				return m
+			},
+			eventDeliveryRepository: func(t *testing.T) *mocks.EventDeliveryRepository {
+				t.Helper()
+				m := mocks.NewEventDeliveryRepository(t)
+				m.On("ListByStatus", ctx, models.SchedulingErrorSub).Return([]models.EventDelivery{}, nil).Once()
+				return m
+			},
+			taskClient: func(t *testing.T) *mocks.TaskClient {
+				t.Helper()
+				m := mocks.NewTaskClient(t)
+				m.On("GetSubscriberNotificationTask", ctx, pendingSubscription.ID).Return(nil, unexpectedErr).Once()
+				return m
+			},
+			expectedActions: []models.ReconciliationAction{models.ActionFailed},
+		},
+		{
+			name: "success - it dispatches stale outbox tasks and re-schedules event deliveries",
+			outboxTaskRepository: func(t *testing.T) *mocks.OutboxTaskRepository {
+				t.Helper()
+				m := mocks.NewOutboxTaskRepository(t)
+				m.On("ListPending", ctx, mock.Anything).Return([]models.OutboxTask{staleOutboxTask}, nil).Once()
+				return m
+			},
+			outboxDispatcher: func(t *testing.T) *mocks.OutboxDispatcher {
+				t.Helper()
+				m := mocks.NewOutboxDispatcher(t)
+				m.On("Dispatch", ctx, staleOutboxTask.ID).Return(nil).Once()
+				return m
+			},
+			matchRepository: func(t *testing.T) *mocks.MatchRepository {
+				t.Helper()
+				m := mocks.NewMatchRepository(t)
+				m.On("ListScheduledBefore", ctx, mock.Anything).Return([]models.Match{}, nil).Once()
+				return m
+			},
+			subscriptionRepository: func(t *testing.T) *mocks.SubscriptionRepository {
+				t.Helper()
+				m := mocks.NewSubscriptionRepository(t)
+				m.On("ListByStatusAndMatchStatus", ctx, models.PendingSub, models.Received).Return([]models.Subscription{}, nil).Once()
+				m.On("ListByStatusAndMatchStatus", ctx, models.SchedulingErrorSub, models.Received).Return([]models.Subscription{}, nil).Once()
+				return m
+			},
+			eventDeliveryRepository: func(t *testing.T) *mocks.EventDeliveryRepository {
+				t.Helper()
+				m := mocks.NewEventDeliveryRepository(t)
+				m.On("ListByStatus", ctx, models.SchedulingErrorSub).Return([]models.EventDelivery{failedDelivery, scheduledDelivery}, nil).Once()
+				m.On("Update", ctx, failedDelivery.ID, models.EventDelivery{Status: models.PendingSub}).Return(nil).Once()
+				m.On("Update", ctx, scheduledDelivery.ID, models.EventDelivery{Status: models.PendingSub}).Return(nil).Once()
+				return m
+			},
+			taskClient: func(t *testing.T) *mocks.TaskClient {
+				t.Helper()
+				m := mocks.NewTaskClient(t)
+				m.On("ScheduleEventDelivery", ctx, failedDelivery.ID, uint(2), mock.AnythingOfType("time.Time")).Return(nil).Once()
+				m.On("ScheduleEventDelivery", ctx, scheduledDelivery.ID, uint(0), mock.AnythingOfType("time.Time")).Return(models.NewResourceAlreadyExistsError(unexpectedErr)).Once()
+				return m
+			},
+			expectedActions: []models.ReconciliationAction{
+				models.ActionOutboxTaskDispatched,
+				models.ActionEventDeliveryRescheduled,
+				models.ActionNone,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var matchRepository *mocks.MatchRepository
+			if tt.matchRepository != nil {
+				matchRepository = tt.matchRepository(t)
+			}
+
+			var subscriptionRepository *mocks.SubscriptionRepository
+			if tt.subscriptionRepository != nil {
+				subscriptionRepository = tt.subscriptionRepository(t)
+			}
+
+			var outboxTaskRepository *mocks.OutboxTaskRepository
+			if tt.outboxTaskRepository != nil {
+				outboxTaskRepository = tt.outboxTaskRepository(t)
+			}
+
+			var eventDeliveryRepository *mocks.EventDeliveryRepository
+			if tt.eventDeliveryRepository != nil {
+				eventDeliveryRepository = tt.eventDeliveryRepository(t)
+			}
+
+			var outboxDispatcher *mocks.OutboxDispatcher
+			if tt.outboxDispatcher != nil {
+				outboxDispatcher = tt.outboxDispatcher(t)
+			}
+
+			var taskClient *mocks.TaskClient
+			if tt.taskClient != nil {
+				taskClient = tt.taskClient(t)
+			}
+
+			logger := loggerinternal.SetupLogger()
+
+			rs := match.NewReconcilerService(
+				config.Reconciliation{StuckThreshold: 30 * time.Minute},
+				matchRepository,
+				subscriptionRepository,
+				outboxTaskRepository,
+				eventDeliveryRepository,
+				taskClient,
+				outboxDispatcher,
+				logger,
+			)
+
+			report, err := rs.Reconcile(ctx)
+			if tt.expectedErr != nil {
+				assert.ErrorContains(t, err, tt.expectedErr.Error())
+				return
+			}
+
+			assert.NoError(t, err)
+
+			actions := make([]models.ReconciliationAction, 0, len(report.Items))
+			for _, item := range report.Items {
+				actions = append(actions, item.Action)
+			}
+			assert.Equal(t, tt.expectedActions, actions)
+		})
+	}
+}
@@ -4,41 +4,60 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"time"
 
 	"github.com/andrewshostak/result-service/config"
 	"github.com/andrewshostak/result-service/internal/app/models"
 )
 
 type ResultCheckerService struct {
-	config                    config.ResultCheck
-	matchRepository           MatchRepository
-	externalMatchRepository   ExternalMatchRepository
-	subscriptionRepository    SubscriptionRepository
-	checkResultTaskRepository CheckResultTaskRepository
-	externalAPIClient         ExternalAPIClient
-	taskClient                TaskClient
-	logger                    Logger
+	config                       config.ResultCheck
+	unitOfWork                   UnitOfWork
+	matchRepository              MatchRepository
+	externalMatchRepository      ExternalMatchRepository
+	subscriptionRepository       SubscriptionRepository
+	outboxTaskRepository         OutboxTaskRepository
+	resultCheckAttemptRepository ResultCheckAttemptRepository
+	resultEventRepository        ResultEventRepository
+	matchUpdateRepository        MatchUpdateRepository
+	externalAPIClient            ExternalAPIClient
+	taskClient                   TaskClient
+	outboxDispatcher             OutboxDispatcher
+	eventPublisher               EventPublisher
+	logger                       Logger
 }
 
 func NewResultCheckerService(
 	config config.ResultCheck,
+	unitOfWork UnitOfWork,
 	matchRepository MatchRepository,
 	externalMatchRepository ExternalMatchRepository,
 	subscriptionRepository SubscriptionRepository,
-	checkResultTaskRepository CheckResultTaskRepository,
+	outboxTaskRepository OutboxTaskRepository,
+	resultCheckAttemptRepository ResultCheckAttemptRepository,
+	resultEventRepository ResultEventRepository,
+	matchUpdateRepository MatchUpdateRepository,
 	taskClient TaskClient,
 	externalAPIClient ExternalAPIClient,
+	outboxDispatcher OutboxDispatcher,
+	eventPublisher EventPublisher,
 	logger Logger,
 ) *ResultCheckerService {
 	return &ResultCheckerService{
-		config:                    config,
-		matchRepository:           matchRepository,
-		externalMatchRepository:   externalMatchRepository,
-		subscriptionRepository:    subscriptionRepository,
-		checkResultTaskRepository: checkResultTaskRepository,
-		taskClient:                taskClient,
-		externalAPIClient:         externalAPIClient,
-		logger:                    logger,
+		config:                       config,
+		unitOfWork:                   unitOfWork,
+		matchRepository:              matchRepository,
+		externalMatchRepository:      externalMatchRepository,
+		subscriptionRepository:       subscriptionRepository,
+		outboxTaskRepository:         outboxTaskRepository,
+		resultCheckAttemptRepository: resultCheckAttemptRepository,
+		resultEventRepository:        resultEventRepository,
+		matchUpdateRepository:        matchUpdateRepository,
+		taskClient:                   taskClient,
+		externalAPIClient:            externalAPIClient,
+		outboxDispatcher:             outboxDispatcher,
+		eventPublisher:               eventPublisher,
+		logger:                       logger,
 	}
 }
 
@@ -48,6 +67,14 @@ func (s *ResultCheckerService) CheckResult(ctx context.Context, matchID uint) er
 		return fmt.Errorf("failed to get match by id: %w", err)
 	}
 
+	if match.ResultStatus == models.Received {
+		attempt := s.newAttempt(*match)
+		err = s.checkCorrection(ctx, *match, &attempt)
+		s.saveAttempt(ctx, attempt, err)
+
+		return err
+	}
+
 	if !s.isScheduled(match) {
 		s.logger.Error().Uint("match_id", matchID).Msg(fmt.Sprintf("expected result status to be %s, actual result status is %s", models.Scheduled, match.ResultStatus))
 		return nil
@@ -58,11 +85,34 @@ func (s *ResultCheckerService) CheckResult(ctx context.Context, matchID uint) er
 		return errors.New("match relation external match doesn't exist")
 	}
 
+	attempt := s.newAttempt(*match)
+	err = s.checkResult(ctx, *match, &attempt)
+
+	s.saveAttempt(ctx, attempt, err)
+
+	return err
+}
+
+// newAttempt starts an attempt of the result check history. The attempt gets the number of the current check result task.
+func (s *ResultCheckerService) newAttempt(match models.Match) models.ResultCheckAttempt {
+	attempt := models.ResultCheckAttempt{MatchID: match.ID, ExecutedAt: time.Now()}
+	if match.CheckResultTask != nil {
+		scheduledAt := match.CheckResultTask.ExecuteAt
+		attempt.AttemptNumber = match.CheckResultTask.AttemptNumber
+		attempt.ScheduledAt = &scheduledAt
+	}
+
+	return attempt
+}
+
+// checkResult fetches the match from external api and handles it depending on its status.
+// Observed data and the outcome of the check are written to the attempt.
+func (s *ResultCheckerService) checkResult(ctx context.Context, match models.Match, attempt *models.ResultCheckAttempt) error {
 	matches, err := s.externalAPIClient.GetMatches(ctx, match.StartsAt)
 	if err != nil {
-		s.logger.Error().Uint("match_id", matchID).Err(err)
-		if errUpdate := s.updateMatchResultStatus(ctx, match.ID, models.APIError); errUpdate != nil {
-			s.logger.Error().Uint("match_id", matchID).Err(errUpdate)
+		s.logger.Error().Uint("match_id", match.ID).Err(err)
+		if errUpdate := s.updateMatchResultStatus(ctx, match, models.APIError, fmt.Sprintf("failed to get matches from external api: %s", err.Error())); errUpdate != nil {
+			s.logger.Error().Uint("match_id", match.ID).Err(errUpdate)
 		}
 
 		return fmt.Errorf("failed to get matches from external api: %w", err)
@@ -70,11 +120,26 @@ func (s *ResultCheckerService) CheckResult(ctx context.Context, matchID uint) er
 
 	externalAPIMatch := s.findExternalMatchByID(match.ExternalMatch.ID, matches)
 	if externalAPIMatch == nil {
-		s.logger.Info().Uint("match_id", matchID).Msgf("external match with id %d is not found", match.ExternalMatch.ID)
+		s.logger.Info().Uint("match_id", match.ID).Msgf("external match with id %d is not found", match.ExternalMatch.ID)
 
-		return s.handleNotFoundMatch(ctx, *match.ExternalMatch)
+		attempt.Outcome = models.OutcomeCancelled
+		return s.handleNotFoundMatch(ctx, match)
 	}
 
+	status, homeScore, awayScore := externalAPIMatch.Status, externalAPIMatch.HomeScore, externalAPIMatch.AwayScore
+	attempt.ProviderStatus = &status
+	attempt.HomeScore = &homeScore
+	attempt.AwayScore = &awayScore
+	attempt.Snapshot = externalAPIMatch.Snapshot
+
+	kickoffMoved := !externalAPIMatch.Time.IsZero() && !externalAPIMatch.Time.Equal(match.StartsAt)
+
+	events := s.observeEvents(match, *externalAPIMatch)
+	s.publishEvents(ctx, events)
+	s.rescheduleReminders(ctx, events)
+	s.recordMatchUpdate(ctx, match, *externalAPIMatch)
+	match = s.saveKickoff(ctx, match, *externalAPIMatch)
+
 	_, err = s.externalMatchRepository.Save(ctx, &match.ExternalMatch.ID, externalAPIMatch.ToExternalMatch(match.ID))
 	if err != nil {
 		return fmt.Errorf("failed to update external match: %w", err)
@@ -82,12 +147,279 @@ func (s *ResultCheckerService) CheckResult(ctx context.Context, matchID uint) er
 
 	switch externalAPIMatch.Status {
 	case models.StatusMatchInProgress:
-		return s.handleInPlayMatch(ctx, *match)
+		attempt.Outcome = models.OutcomeRescheduled
+		return s.handleInPlayMatch(ctx, match)
+	// the kickoff has moved later, so the result check is moved with it
+	case models.StatusMatchNotStarted:
+		if kickoffMoved && externalAPIMatch.Time.After(time.Now()) {
+			attempt.Outcome = models.OutcomeRescheduled
+			return s.handleRescheduledMatch(ctx, match, externalAPIMatch.Time)
+		}
+
+		attempt.Outcome = models.OutcomeCancelled
+		return s.handleMatchWithUnexpectedStatus(ctx, match, externalAPIMatch.Status)
 	case models.StatusMatchFinished:
-		return s.handleFinishedMatch(ctx, match.ID)
+		attempt.Outcome = models.OutcomeFinished
+		return s.handleFinishedMatch(ctx, match, externalAPIMatch.ToExternalMatch(match.ID))
 	// if we receive here any other status - that is not expected, we should cancel the result check.
 	default:
-		return s.handleMatchWithUnexpectedStatus(ctx, matchID, externalAPIMatch.Status)
+		attempt.Outcome = models.OutcomeCancelled
+		return s.handleMatchWithUnexpectedStatus(ctx, match, externalAPIMatch.Status)
+	}
+}
+
+// checkCorrection checks the match once more after its result is received. When the provider has corrected the score,
+// the external match is updated and result.corrected is appended to the result feed in the same transaction.
+// A match which is not found or is no longer finished is left as is, and the attempt gets the cancelled outcome.
+func (s *ResultCheckerService) checkCorrection(ctx context.Context, match models.Match, attempt *models.ResultCheckAttempt) error {
+	if match.ExternalMatch == nil {
+		return errors.New("match relation external match doesn't exist")
+	}
+
+	matches, err := s.externalAPIClient.GetMatches(ctx, match.StartsAt)
+	if err != nil {
+		return fmt.Errorf("failed to get matches from external api: %w", err)
+	}
+
+	externalAPIMatch := s.findExternalMatchByID(match.ExternalMatch.ID, matches)
+	if externalAPIMatch == nil {
+		s.logger.Info().Uint("match_id", match.ID).Msg("correction check skipped: external match is not found")
+		attempt.Outcome = models.OutcomeCancelled
+		return nil
+	}
+
+	status, homeScore, awayScore := externalAPIMatch.Status, externalAPIMatch.HomeScore, externalAPIMatch.AwayScore
+	attempt.ProviderStatus = &status
+	attempt.HomeScore = &homeScore
+	attempt.AwayScore = &awayScore
+	attempt.Snapshot = externalAPIMatch.Snapshot
+
+	if externalAPIMatch.Status != models.StatusMatchFinished {
+		s.logger.Info().Uint("match_id", match.ID).Msg("correction check skipped: external match is not finished")
+		attempt.Outcome = models.OutcomeCancelled
+		return nil
+	}
+
+	attempt.Outcome = models.OutcomeFinished
+
+	if externalAPIMatch.HomeScore == match.ExternalMatch.HomeScore && externalAPIMatch.AwayScore == match.ExternalMatch.AwayScore {
+		s.logger.Debug().Uint("match_id", match.ID).Msg("result is not corrected")
+		return nil
+	}
+
+	attempt.Outcome = models.OutcomeCorrected
+
+	s.recordMatchUpdate(ctx, match, *externalAPIMatch)
+
+	corrected := externalAPIMatch.ToExternalMatch(match.ID)
+
+	return s.unitOfWork.Do(ctx, func(ctx context.Context) error {
+		if _, errSave := s.externalMatchRepository.Save(ctx, &match.ExternalMatch.ID, corrected); errSave != nil {
+			return fmt.Errorf("failed to update external match: %w", errSave)
+		}
+
+		return s.recordResultEvent(ctx, s.resultEvent(match, models.EventResultCorrected, &corrected))
+	})
+}
+
+// CheckLive checks the match while it is in play to publish in-play events, such as kickoff, half-time and goals.
+// Live checks are chained until the match is no longer in play or the max number of checks is reached.
+// The result itself is handled by the regular result check.
+func (s *ResultCheckerService) CheckLive(ctx context.Context, matchID uint, sequence uint) error {
+	match, err := s.matchRepository.One(ctx, models.Match{ID: matchID})
+	if errors.As(err, &models.ResourceNotFoundError{}) {
+		s.logger.Info().Uint("match_id", matchID).Msg("live tracking stopped: match is deleted")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("failed to get match by id: %w", err)
+	}
+
+	if !s.isScheduled(match) {
+		s.logger.Debug().Uint("match_id", matchID).Msg(fmt.Sprintf("live tracking stopped: result status is %s", match.ResultStatus))
+		return nil
+	}
+
+	if match.ExternalMatch == nil {
+		return errors.New("match relation external match doesn't exist")
+	}
+
+	matches, err := s.externalAPIClient.GetMatches(ctx, match.StartsAt)
+	if err != nil {
+		return fmt.Errorf("failed to get matches from external api: %w", err)
+	}
+
+	externalAPIMatch := s.findExternalMatchByID(match.ExternalMatch.ID, matches)
+	if externalAPIMatch == nil {
+		s.logger.Info().Uint("match_id", matchID).Msgf("live tracking stopped: external match with id %d is not found", match.ExternalMatch.ID)
+		return nil
+	}
+
+	events := s.observeEvents(*match, *externalAPIMatch)
+	s.publishEvents(ctx, events)
+	s.rescheduleReminders(ctx, events)
+	s.recordMatchUpdate(ctx, *match, *externalAPIMatch)
+	s.saveKickoff(ctx, *match, *externalAPIMatch)
+
+	_, err = s.externalMatchRepository.Save(ctx, &match.ExternalMatch.ID, externalAPIMatch.ToExternalMatch(match.ID))
+	if err != nil {
+		return fmt.Errorf("failed to update external match: %w", err)
+	}
+
+	if externalAPIMatch.Status != models.StatusMatchNotStarted && externalAPIMatch.Status != models.StatusMatchInProgress {
+		s.logger.Debug().Uint("match_id", matchID).Msg(fmt.Sprintf("live tracking stopped: external match status is %s", externalAPIMatch.Status))
+		return nil
+	}
+
+	if sequence >= s.config.LiveMaxChecks {
+		s.logger.Info().Uint("match_id", matchID).Uint("sequence", sequence).Msg("live tracking stopped: max number of live checks is reached")
+		return nil
+	}
+
+	err = s.taskClient.ScheduleLiveCheck(ctx, matchID, sequence+1, time.Now().Add(s.config.LiveInterval))
+	if err != nil && !errors.As(err, &models.ResourceAlreadyExistsError{}) {
+		return fmt.Errorf("failed to schedule live check: %w", err)
+	}
+
+	return nil
+}
+
+// observeEvents compares the match as it is observed in external api with the stored one and returns observed events.
+// Goals are derived from the score change, as external api provides the score only. Each goal gets the score it led to.
+func (s *ResultCheckerService) observeEvents(match models.Match, observed models.ExternalAPIMatch) []models.MatchEvent {
+	var events []models.MatchEvent
+
+	newEvent := func(eventType models.NotificationEventType, key string, home, away int) models.MatchEvent {
+		return models.MatchEvent{MatchID: match.ID, Type: eventType, Key: key, HomeScore: home, AwayScore: away, StartsAt: observed.Time}
+	}
+
+	if !observed.Time.IsZero() && !observed.Time.Equal(match.StartsAt) {
+		key := fmt.Sprintf("rescheduled-%d", observed.Time.Unix())
+		events = append(events, newEvent(models.EventMatchRescheduled, key, observed.HomeScore, observed.AwayScore))
+	}
+
+	if observed.Status != models.StatusMatchInProgress && observed.Status != models.StatusMatchFinished {
+		return events
+	}
+
+	events = append(events, newEvent(models.EventMatchKickoff, "kickoff", 0, 0))
+
+	home, away := 0, 0
+	if match.ExternalMatch != nil {
+		home, away = match.ExternalMatch.HomeScore, match.ExternalMatch.AwayScore
+	}
+
+	for home < observed.HomeScore {
+		home++
+		events = append(events, newEvent(models.EventMatchGoal, fmt.Sprintf("goal-%d-%d", home, away), home, away))
+	}
+
+	for away < observed.AwayScore {
+		away++
+		events = append(events, newEvent(models.EventMatchGoal, fmt.Sprintf("goal-%d-%d", home, away), home, away))
+	}
+
+	if observed.HalfTime {
+		events = append(events, newEvent(models.EventMatchHalfTime, "half-time", observed.HomeScore, observed.AwayScore))
+	}
+
+	return events
+}
+
+// publishEvents publishes observed events. Events are a side effect of the check, so failures are only logged.
+func (s *ResultCheckerService) publishEvents(ctx context.Context, events []models.MatchEvent) {
+	for _, event := range events {
+		if err := s.eventPublisher.Publish(ctx, event); err != nil {
+			s.logger.Error().Err(err).Uint("match_id", event.MatchID).Str("key", event.Key).Msg("failed to publish match event")
+		}
+	}
+}
+
+// rescheduleReminders schedules kickoff reminders of the match subscriptions to the new kickoff when the match is rescheduled.
+// As on the creation, only subscriptions which still wait for the match are reminded.
+// The reminder of the previous kickoff may be due after the new kickoff, so it can't be relied on. Reminder tasks are named
+// by the kickoff, so a reschedule observed by several checks creates them once. Reminders are a side effect of the check, so failures are only logged.
+func (s *ResultCheckerService) rescheduleReminders(ctx context.Context, events []models.MatchEvent) {
+	for _, event := range events {
+		if event.Type != models.EventMatchRescheduled || !event.StartsAt.After(time.Now()) {
+			continue
+		}
+
+		subscriptions, err := s.subscriptionRepository.ListWithReminderByMatch(ctx, event.MatchID)
+		if err != nil {
+			s.logger.Error().Err(err).Uint("match_id", event.MatchID).Msg("failed to list subscriptions with kickoff reminder")
+			continue
+		}
+
+		for _, subscription := range subscriptions {
+			if subscription.Status != models.PendingSub && subscription.Status != models.UnverifiedSub {
+				continue
+			}
+
+			remindAt := subscription.RemindAt(event.StartsAt)
+			if remindAt.Before(time.Now()) {
+				remindAt = time.Now()
+			}
+
+			err := s.taskClient.ScheduleKickoffReminder(ctx, subscription.ID, event.StartsAt, remindAt)
+			if err != nil && !errors.As(err, &models.ResourceAlreadyExistsError{}) {
+				s.logger.Error().Err(err).Uint("subscription_id", subscription.ID).Msg("failed to reschedule kickoff reminder")
+			}
+		}
+	}
+}
+
+// saveKickoff stores the kickoff observed in external api when it differs from the stored one and returns the match with it,
+// so the reschedule is observed once, and next checks search the match by its new date. Failures are only logged,
+// as the reschedule is observed again by the next check.
+func (s *ResultCheckerService) saveKickoff(ctx context.Context, match models.Match, observed models.ExternalAPIMatch) models.Match {
+	if observed.Time.IsZero() || observed.Time.Equal(match.StartsAt) {
+		return match
+	}
+
+	if err := s.matchRepository.UpdateStartsAt(ctx, match.ID, observed.Time); err != nil {
+		s.logger.Error().Err(err).Uint("match_id", match.ID).Time("starts_at", observed.Time).Msg("failed to save rescheduled kickoff")
+		return match
+	}
+
+	match.StartsAt = observed.Time
+
+	return match
+}
+
+// recordMatchUpdate stores the observed status and score when they differ from the stored ones, so they can be streamed to live viewers.
+// Updates are a side effect of the check, so failures are only logged.
+func (s *ResultCheckerService) recordMatchUpdate(ctx context.Context, match models.Match, observed models.ExternalAPIMatch) {
+	if match.ExternalMatch != nil &&
+		match.ExternalMatch.Status == observed.Status &&
+		match.ExternalMatch.HomeScore == observed.HomeScore &&
+		match.ExternalMatch.AwayScore == observed.AwayScore {
+		return
+	}
+
+	_, err := s.matchUpdateRepository.Create(ctx, models.MatchUpdate{
+		MatchID:   match.ID,
+		Status:    observed.Status,
+		HomeScore: observed.HomeScore,
+		AwayScore: observed.AwayScore,
+	})
+	if err != nil {
+		s.logger.Error().Err(err).Uint("match_id", match.ID).Msg("failed to record match update")
+	}
+}
+
+// saveAttempt stores the attempt in the result check history.
+// Failure to save the history doesn't affect the result check, so it is only logged.
+func (s *ResultCheckerService) saveAttempt(ctx context.Context, attempt models.ResultCheckAttempt, checkErr error) {
+	if checkErr != nil {
+		errMessage := checkErr.Error()
+		attempt.Outcome = models.OutcomeError
+		attempt.ErrorMessage = &errMessage
+	}
+
+	if _, err := s.resultCheckAttemptRepository.Create(ctx, attempt); err != nil {
+		s.logger.Error().Err(err).Uint("match_id", attempt.MatchID).Uint("attempt_number", attempt.AttemptNumber).Msg("failed to save result check attempt")
 	}
 }
 
@@ -102,10 +434,10 @@ func (s *ResultCheckerService) findExternalMatchByID(externalID uint, matches []
 }
 
 // TODO: update external match as well
-func (s *ResultCheckerService) handleMatchWithUnexpectedStatus(ctx context.Context, matchID uint, externalMatchStatus models.ExternalMatchStatus) error {
-	s.logger.Error().Uint("match_id", matchID).Msgf("result check cancelled: external match status is %s", externalMatchStatus)
+func (s *ResultCheckerService) handleMatchWithUnexpectedStatus(ctx context.Context, match models.Match, externalMatchStatus models.ExternalMatchStatus) error {
+	s.logger.Error().Uint("match_id", match.ID).Msgf("result check cancelled: external match status is %s", externalMatchStatus)
 
-	if err := s.updateMatchResultStatus(ctx, matchID, models.Cancelled); err != nil {
+	if err := s.updateMatchResultStatus(ctx, match, models.Cancelled, fmt.Sprintf("external match status is %s", externalMatchStatus)); err != nil {
 		return fmt.Errorf("failed to update result status of match: %w", err)
 	}
 
@@ -124,31 +456,44 @@ func (s *ResultCheckerService) handleInPlayMatch(ctx context.Context, match mode
 		scheduleAt = scheduleAt.Add(s.config.Interval)
 	}
 
-	attemptNumber := match.CheckResultTask.AttemptNumber + 1
+	return s.scheduleNextResultCheck(ctx, match, scheduleAt)
+}
 
-	task, err := s.taskClient.ScheduleResultCheck(ctx, match.ID, attemptNumber, scheduleAt)
-	if err != nil {
-		s.logger.Error().Uint("match_id", match.ID).Uint("attempt_number", attemptNumber).Time("schedule_at", scheduleAt).Err(err).Msg("failed to re-schedule check result task")
-		if errUpdate := s.updateMatchResultStatus(ctx, match.ID, models.SchedulingError); errUpdate != nil {
-			s.logger.Error().Uint("match_id", match.ID).Err(errUpdate)
-		}
+// handleRescheduledMatch moves the result check to the first attempt delay after the new kickoff.
+func (s *ResultCheckerService) handleRescheduledMatch(ctx context.Context, match models.Match, kickoff time.Time) error {
+	s.logger.Debug().Uint("match_id", match.ID).Time("kickoff", kickoff).Msg("match is rescheduled, moving result check task")
 
-		return fmt.Errorf("failed to re-schedule result check task: %w", err)
+	if match.CheckResultTask == nil {
+		return errors.New("match relation result check task doesn't exist")
 	}
 
-	if _, err := s.checkResultTaskRepository.Save(ctx, models.CheckResultTask{
+	return s.scheduleNextResultCheck(ctx, match, kickoff.Add(s.config.FirstAttemptDelay))
+}
+
+// scheduleNextResultCheck schedules the next attempt of the result check through the outbox.
+func (s *ResultCheckerService) scheduleNextResultCheck(ctx context.Context, match models.Match, scheduleAt time.Time) error {
+	attemptNumber := match.CheckResultTask.AttemptNumber + 1
+
+	outboxTask, err := s.outboxTaskRepository.Create(ctx, models.OutboxTask{
+		Kind:          models.OutboxKindResultCheck,
 		MatchID:       match.ID,
-		Name:          task.Name,
 		AttemptNumber: attemptNumber,
-		ExecuteAt:     task.ExecuteAt,
-	}); err != nil {
-		return fmt.Errorf("failed to update result check task: %w", err)
+		ExecuteAt:     scheduleAt,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to create result check outbox task: %w", err)
+	}
+
+	// when the immediate dispatch fails, the outbox task stays pending and is dispatched by the outbox dispatch trigger
+	if err := s.outboxDispatcher.Dispatch(ctx, outboxTask.ID); err != nil {
+		s.logger.Error().Uint("match_id", match.ID).Uint("attempt_number", attemptNumber).Err(err).Msg("failed to dispatch result check outbox task")
 	}
 
 	return nil
 }
 
-func (s *ResultCheckerService) handleFinishedMatch(ctx context.Context, matchID uint) error {
+func (s *ResultCheckerService) handleFinishedMatch(ctx context.Context, match models.Match, externalMatch models.ExternalMatch) error {
+	matchID := match.ID
 	s.logger.Debug().Uint("match_id", matchID).Msg("match is finished, scheduling subscribers notifications")
 
 	subscriptions, err := s.subscriptionRepository.ListByMatchAndStatus(ctx, matchID, models.PendingSub)
@@ -161,7 +506,7 @@ func (s *ResultCheckerService) handleFinishedMatch(ctx context.Context, matchID
 	}
 
 	for _, subscription := range subscriptions {
-		err := s.taskClient.ScheduleSubscriberNotification(ctx, subscription.ID)
+		err := s.taskClient.ScheduleSubscriberNotification(ctx, subscription.ID, "")
 		if err != nil && !errors.As(err, &models.ResourceAlreadyExistsError{}) {
 			s.logger.Error().Uint("subscription_id", subscription.ID).Err(err).Msg("failed to schedule subscriber notification task")
 			errUpdate := s.subscriptionRepository.Update(ctx, subscription.ID, models.Subscription{Status: models.SchedulingErrorSub, SubscriberError: nil})
@@ -173,36 +518,185 @@ func (s *ResultCheckerService) handleFinishedMatch(ctx context.Context, matchID
 		}
 	}
 
-	if err := s.updateMatchResultStatus(ctx, matchID, models.Received); err != nil {
+	match.ExternalMatch = &externalMatch
+	if err := s.updateMatchResultStatus(ctx, match, models.Received, "external match is finished"); err != nil {
 		return fmt.Errorf("failed to handle finished match: %w", err)
 	}
 
+	s.scheduleCorrectionCheck(ctx, match)
+
 	return nil
 }
 
+// scheduleCorrectionCheck schedules one more check of the finished match through the outbox to catch a score corrected
+// by the provider. The dispatch saves it as the check result task of the next attempt. The result is already received,
+// so a failure is only logged.
+func (s *ResultCheckerService) scheduleCorrectionCheck(ctx context.Context, match models.Match) {
+	if s.config.CorrectionDelay == 0 {
+		return
+	}
+
+	attemptNumber := uint(1)
+	if match.CheckResultTask != nil {
+		attemptNumber = match.CheckResultTask.AttemptNumber + 1
+	}
+
+	scheduleAt := time.Now().Add(s.config.CorrectionDelay)
+
+	outboxTask, err := s.outboxTaskRepository.Create(ctx, models.OutboxTask{
+		Kind:          models.OutboxKindResultCheck,
+		MatchID:       match.ID,
+		AttemptNumber: attemptNumber,
+		ExecuteAt:     scheduleAt,
+	})
+	if err != nil {
+		s.logger.Error().Uint("match_id", match.ID).Uint("attempt_number", attemptNumber).Time("schedule_at", scheduleAt).Err(err).Msg("failed to create correction check outbox task")
+		return
+	}
+
+	// when the immediate dispatch fails, the outbox task stays pending and is dispatched by the outbox dispatch trigger
+	if err := s.outboxDispatcher.Dispatch(ctx, outboxTask.ID); err != nil {
+		s.logger.Error().Uint("match_id", match.ID).Uint("attempt_number", attemptNumber).Err(err).Msg("failed to dispatch correction check outbox task")
+	}
+}
+
 // handleNotFoundMatch updates statuses of match and external match.
 // When a match is postponed to another date - it is removed from original date matches. In that case retrying doesn't make sense, so returning nil.
-func (s *ResultCheckerService) handleNotFoundMatch(ctx context.Context, externalMatch models.ExternalMatch) error {
+func (s *ResultCheckerService) handleNotFoundMatch(ctx context.Context, match models.Match) error {
+	externalMatch := *match.ExternalMatch
 	externalMatch.Status = models.StatusMatchUnknown
 	if _, err := s.externalMatchRepository.Save(ctx, &externalMatch.MatchID, externalMatch); err != nil {
 		return fmt.Errorf("failed to update external match: %w", err)
 	}
 
-	if err := s.updateMatchResultStatus(ctx, externalMatch.MatchID, models.Cancelled); err != nil {
+	if err := s.updateMatchResultStatus(ctx, match, models.Cancelled, "external match is not found"); err != nil {
 		return fmt.Errorf("failed to update match: %w", err)
 	}
 
 	return nil
 }
 
-func (s *ResultCheckerService) updateMatchResultStatus(ctx context.Context, matchID uint, status models.ResultStatus) error {
-	if _, errUpdate := s.matchRepository.Update(ctx, matchID, status); errUpdate != nil {
-		return fmt.Errorf("failed to update result status to %s: %w", status, errUpdate)
+// updateMatchResultStatus validates and records the transition of match result status.
+// When the status is terminal and no result will be received, pending subscriptions of the match are moved to the corresponding status.
+// Unverified subscriptions are never notified, so they are moved to a terminal status whenever the match ends.
+// A received or cancelled result is appended to the result feed in the same transaction, so the feed can't miss it.
+func (s *ResultCheckerService) updateMatchResultStatus(ctx context.Context, match models.Match, status models.ResultStatus, reason string) error {
+	matchID := match.ID
+
+	transition, err := models.NewMatchStatusTransition(match, status, reason)
+	if err != nil {
+		return fmt.Errorf("failed to update result status to %s: %w", status, err)
+	}
+
+	err = s.unitOfWork.Do(ctx, func(ctx context.Context) error {
+		if _, errUpdate := s.matchRepository.Update(ctx, *transition); errUpdate != nil {
+			return fmt.Errorf("failed to update result status to %s: %w", status, errUpdate)
+		}
+
+		if unverifiedStatus, ok := s.toTerminalUnverifiedStatus(status); ok {
+			if errUpdate := s.subscriptionRepository.UpdateStatusByMatch(ctx, matchID, models.UnverifiedSub, unverifiedStatus); errUpdate != nil {
+				return fmt.Errorf("failed to update unverified subscriptions status to %s: %w", unverifiedStatus, errUpdate)
+			}
+		}
+
+		if subscriptionStatus, ok := s.toTerminalSubscriptionStatus(status); ok {
+			if errUpdate := s.subscriptionRepository.UpdateStatusByMatch(ctx, matchID, models.PendingSub, subscriptionStatus); errUpdate != nil {
+				return fmt.Errorf("failed to update subscriptions status to %s: %w", subscriptionStatus, errUpdate)
+			}
+
+			s.logger.Debug().Uint("match_id", matchID).Msg(fmt.Sprintf("pending subscriptions status updated to %s", subscriptionStatus))
+		}
+
+		if eventType, ok := s.toResultEventType(status); ok {
+			return s.recordResultEvent(ctx, s.resultEvent(match, eventType, match.ExternalMatch))
+		}
+
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	if status == models.Cancelled {
+		s.publishEvents(ctx, []models.MatchEvent{s.cancelledEvent(match)})
 	}
 
 	return nil
 }
 
+func (s *ResultCheckerService) cancelledEvent(match models.Match) models.MatchEvent {
+	event := models.MatchEvent{MatchID: match.ID, Type: models.EventMatchCancelled, Key: "cancelled", StartsAt: match.StartsAt}
+	if match.ExternalMatch != nil {
+		event.HomeScore, event.AwayScore = match.ExternalMatch.HomeScore, match.ExternalMatch.AwayScore
+	}
+
+	return event
+}
+
+// recordResultEvent appends the event to the result feed. An event which is already recorded is skipped.
+func (s *ResultCheckerService) recordResultEvent(ctx context.Context, event models.ResultEvent) error {
+	_, err := s.resultEventRepository.Create(ctx, event)
+	if errors.As(err, &models.ResourceAlreadyExistsError{}) {
+		s.logger.Debug().Uint("match_id", event.MatchID).Str("event_type", string(event.Type)).Msg("result event is already recorded")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("failed to record result event %s: %w", event.Type, err)
+	}
+
+	return nil
+}
+
+func (s *ResultCheckerService) resultEvent(match models.Match, eventType models.NotificationEventType, externalMatch *models.ExternalMatch) models.ResultEvent {
+	event := models.ResultEvent{
+		MatchID:    match.ID,
+		Type:       eventType,
+		HomeTeamID: match.HomeTeamID,
+		AwayTeamID: match.AwayTeamID,
+		StartsAt:   match.StartsAt,
+	}
+
+	if externalMatch != nil {
+		event.HomeScore, event.AwayScore = externalMatch.HomeScore, externalMatch.AwayScore
+		event.FinishType = externalMatch.FinishType
+	}
+
+	return event
+}
+
+func (s *ResultCheckerService) toResultEventType(status models.ResultStatus) (models.NotificationEventType, bool) {
+	switch status {
+	case models.Received:
+		return models.EventResultFinished, true
+	case models.Cancelled:
+		return models.EventMatchCancelled, true
+	default:
+		return "", false
+	}
+}
+
+func (s *ResultCheckerService) toTerminalSubscriptionStatus(status models.ResultStatus) (models.SubscriptionStatus, bool) {
+	switch status {
+	case models.Cancelled:
+		return models.MatchCancelledSub, true
+	case models.APIError, models.SchedulingError:
+		return models.MatchFailedSub, true
+	default:
+		return "", false
+	}
+}
+
+// toTerminalUnverifiedStatus returns the status of unverified subscriptions of the ended match.
+// A received result isn't sent to an unverified endpoint, so the subscription keeps its verification error as the subscriber error.
+func (s *ResultCheckerService) toTerminalUnverifiedStatus(status models.ResultStatus) (models.SubscriptionStatus, bool) {
+	if status == models.Received {
+		return models.SubscriberErrorSub, true
+	}
+
+	return s.toTerminalSubscriptionStatus(status)
+}
+
 func (s *ResultCheckerService) isScheduled(match *models.Match) bool {
 	return match != nil && match.ResultStatus == models.Scheduled
 }
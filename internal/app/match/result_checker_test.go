@@ -21,6 +21,7 @@ import (
 func TestResultCheckerService_CheckResult(t *testing.T) {
 	pollingInterval := 15 * time.Minute
 	pollingFirstAttemptDelay := 115 * time.Minute
+	correctionDelay := 24 * time.Hour
 
 	ctx := context.Background()
 	unexpectedErr := errors.New("unexpected error")
@@ -43,6 +44,7 @@ func TestResultCheckerService_CheckResult(t *testing.T) {
 
 	externalMatchClient := testutils.FakeExternalAPIMatch(func(r *models.ExternalAPIMatch) {
 		r.ID = externalMatchID
+		r.Time = startsAt
 	})
 
 	externalMatchClientFinished := externalMatchClient
@@ -57,7 +59,39 @@ func TestResultCheckerService_CheckResult(t *testing.T) {
 	externalMatchClientInProgress := externalMatchClient
 	externalMatchClientInProgress.Status = models.StatusMatchInProgress
 
-	clientTask := testutils.FakeTask()
+	externalMatchClientHalfTime := externalMatchClientInProgress
+	externalMatchClientHalfTime.Time = startsAt
+	externalMatchClientHalfTime.HomeScore = 2
+	externalMatchClientHalfTime.AwayScore = 1
+	externalMatchClientHalfTime.HalfTime = true
+
+	receivedMatch := scheduledMatch
+	receivedMatch.ResultStatus = models.Received
+	receivedMatch.ExternalMatch = &models.ExternalMatch{
+		ID:        externalMatchID,
+		MatchID:   matchID,
+		HomeScore: externalMatchClient.HomeScore + 1,
+		AwayScore: externalMatchClient.AwayScore,
+		Status:    models.StatusMatchFinished,
+	}
+
+	notCorrectedMatch := receivedMatch
+	notCorrectedMatch.ExternalMatch = &models.ExternalMatch{
+		ID:        externalMatchID,
+		MatchID:   matchID,
+		HomeScore: externalMatchClient.HomeScore,
+		AwayScore: externalMatchClient.AwayScore,
+		Status:    models.StatusMatchFinished,
+	}
+
+	outboxTaskID := uint(gofakeit.Uint32())
+
+	remindBefore := uint(30)
+	remindedSubscription := testutils.FakeSubscription(func(r *models.Subscription) {
+		r.MatchID = matchID
+		r.RemindBefore = &remindBefore
+		r.Status = models.PendingSub
+	})
 	repositorySubscription := testutils.FakeSubscription()
 
 	expectedRepositoryMatch := models.ExternalMatch{
@@ -81,15 +115,21 @@ func TestResultCheckerService_CheckResult(t *testing.T) {
 	expectedRepositoryMatchInProgress.Status = models.StatusMatchInProgress
 
 	tests := []struct {
-		name                      string
-		input                     uint
-		expectedErr               error
-		matchRepository           func(t *testing.T) *mocks.MatchRepository
-		externalMatchRepository   func(t *testing.T) *mocks.ExternalMatchRepository
-		checkResultTaskRepository func(t *testing.T) *mocks.CheckResultTaskRepository
-		subscriptionRepository    func(t *testing.T) *mocks.SubscriptionRepository
-		externalAPIClient         func(t *testing.T) *mocks.ExternalAPIClient
-		taskClient                func(t *testing.T) *mocks.TaskClient
+		name                    string
+		input                   uint
+		expectedErr             error
+		matchRepository         func(t *testing.T) *mocks.MatchRepository
+		externalMatchRepository func(t *testing.T) *mocks.ExternalMatchRepository
+		outboxTaskRepository    func(t *testing.T) *mocks.OutboxTaskRepository
+		outboxDispatcher        func(t *testing.T) *mocks.OutboxDispatcher
+		subscriptionRepository  func(t *testing.T) *mocks.SubscriptionRepository
+		externalAPIClient       func(t *testing.T) *mocks.ExternalAPIClient
+		taskClient              func(t *testing.T) *mocks.TaskClient
+
+		resultCheckAttemptRepository func(t *testing.T) *mocks.ResultCheckAttemptRepository
+		resultEventRepository        func(t *testing.T) *mocks.ResultEventRepository
+		matchUpdateRepository        func(t *testing.T) *mocks.MatchUpdateRepository
+		eventPublisher               func(t *testing.T) *mocks.EventPublisher
 	}{
 		{
 			name:  "it returns an error when match retrieval fails",
@@ -139,7 +179,7 @@ func TestResultCheckerService_CheckResult(t *testing.T) {
 				t.Helper()
 				m := mocks.NewMatchRepository(t)
 				m.On("One", ctx, models.Match{ID: matchID}).Return(&scheduledMatch, nil).Once()
-				m.On("Update", ctx, matchID, models.APIError).Return(nil, unexpectedErr).Once()
+				m.On("Update", ctx, transitionTo(matchID, models.APIError)).Return(nil, unexpectedErr).Once()
 				return m
 			},
 			externalAPIClient: func(t *testing.T) *mocks.ExternalAPIClient {
@@ -153,13 +193,20 @@ func TestResultCheckerService_CheckResult(t *testing.T) {
 		{
 			name:  "it returns an error when matches retrieval from external api fails and match update succeeds",
 			input: matchID,
+			subscriptionRepository: func(t *testing.T) *mocks.SubscriptionRepository {
+				t.Helper()
+				m := mocks.NewSubscriptionRepository(t)
+				m.On("UpdateStatusByMatch", ctx, matchID, models.UnverifiedSub, models.MatchFailedSub).Return(nil).Once()
+				m.On("UpdateStatusByMatch", ctx, matchID, models.PendingSub, models.MatchFailedSub).Return(nil).Once()
+				return m
+			},
 			matchRepository: func(t *testing.T) *mocks.MatchRepository {
 				t.Helper()
 				m := mocks.NewMatchRepository(t)
 				updatedMatch := scheduledMatch
 				updatedMatch.ResultStatus = models.APIError
 				m.On("One", ctx, models.Match{ID: matchID}).Return(&scheduledMatch, nil).Once()
-				m.On("Update", ctx, matchID, models.APIError).Return(&updatedMatch, nil).Once()
+				m.On("Update", ctx, transitionTo(matchID, models.APIError)).Return(&updatedMatch, nil).Once()
 				return m
 			},
 			externalAPIClient: func(t *testing.T) *mocks.ExternalAPIClient {
@@ -173,11 +220,18 @@ func TestResultCheckerService_CheckResult(t *testing.T) {
 		{
 			name:  "it returns nil when external api result doesn't contain expected match",
 			input: matchID,
+			subscriptionRepository: func(t *testing.T) *mocks.SubscriptionRepository {
+				t.Helper()
+				m := mocks.NewSubscriptionRepository(t)
+				m.On("UpdateStatusByMatch", ctx, matchID, models.UnverifiedSub, models.MatchCancelledSub).Return(nil).Once()
+				m.On("UpdateStatusByMatch", ctx, matchID, models.PendingSub, models.MatchCancelledSub).Return(nil).Once()
+				return m
+			},
 			matchRepository: func(t *testing.T) *mocks.MatchRepository {
 				t.Helper()
 				m := mocks.NewMatchRepository(t)
 				m.On("One", ctx, models.Match{ID: matchID}).Return(&scheduledMatch, nil).Once()
-				m.On("Update", ctx, matchID, models.Cancelled).Return(&models.Match{}, nil).Once()
+				m.On("Update", ctx, transitionTo(matchID, models.Cancelled)).Return(&models.Match{}, nil).Once()
 				return m
 			},
 			externalAPIClient: func(t *testing.T) *mocks.ExternalAPIClient {
@@ -235,7 +289,7 @@ func TestResultCheckerService_CheckResult(t *testing.T) {
 				t.Helper()
 				m := mocks.NewMatchRepository(t)
 				m.On("One", ctx, models.Match{ID: matchID}).Return(&scheduledMatch, nil).Once()
-				m.On("Update", ctx, matchID, models.Cancelled).Return(nil, unexpectedErr).Once()
+				m.On("Update", ctx, transitionTo(matchID, models.Cancelled)).Return(nil, unexpectedErr).Once()
 				return m
 			},
 			externalAPIClient: func(t *testing.T) *mocks.ExternalAPIClient {
@@ -288,7 +342,7 @@ func TestResultCheckerService_CheckResult(t *testing.T) {
 				t.Helper()
 				m := mocks.NewMatchRepository(t)
 				m.On("One", ctx, models.Match{ID: matchID}).Return(&scheduledMatch, nil).Once()
-				m.On("Update", ctx, matchID, models.Cancelled).Return(nil, unexpectedErr).Once()
+				m.On("Update", ctx, transitionTo(matchID, models.Cancelled)).Return(nil, unexpectedErr).Once()
 				return m
 			},
 			externalAPIClient: func(t *testing.T) *mocks.ExternalAPIClient {
@@ -308,13 +362,141 @@ func TestResultCheckerService_CheckResult(t *testing.T) {
 		{
 			name:  "it returns nil when external match status is cancelled and update succeeds",
 			input: matchID,
+			subscriptionRepository: func(t *testing.T) *mocks.SubscriptionRepository {
+				t.Helper()
+				m := mocks.NewSubscriptionRepository(t)
+				m.On("UpdateStatusByMatch", ctx, matchID, models.UnverifiedSub, models.MatchCancelledSub).Return(nil).Once()
+				m.On("UpdateStatusByMatch", ctx, matchID, models.PendingSub, models.MatchCancelledSub).Return(nil).Once()
+				return m
+			},
+			matchRepository: func(t *testing.T) *mocks.MatchRepository {
+				t.Helper()
+				m := mocks.NewMatchRepository(t)
+				cancelledMatch := scheduledMatch
+				cancelledMatch.ResultStatus = models.Cancelled
+				m.On("One", ctx, models.Match{ID: matchID}).Return(&scheduledMatch, nil).Once()
+				m.On("Update", ctx, transitionTo(matchID, models.Cancelled)).Return(&cancelledMatch, nil).Once()
+				return m
+			},
+			externalAPIClient: func(t *testing.T) *mocks.ExternalAPIClient {
+				t.Helper()
+				m := mocks.NewExternalAPIClient(t)
+				m.On("GetMatches", ctx, startsAt).Return([]models.ExternalAPIMatch{externalMatchClientCancelled}, nil).Once()
+				return m
+			},
+			externalMatchRepository: func(t *testing.T) *mocks.ExternalMatchRepository {
+				t.Helper()
+				m := mocks.NewExternalMatchRepository(t)
+				m.On("Save", ctx, &externalMatchID, expectedRepositoryMatchCancelled).Return(&models.ExternalMatch{}, nil).Once()
+				return m
+			},
+			resultEventRepository: func(t *testing.T) *mocks.ResultEventRepository {
+				t.Helper()
+				m := mocks.NewResultEventRepository(t)
+				m.On("Create", ctx, models.ResultEvent{
+					MatchID:    matchID,
+					Type:       models.EventMatchCancelled,
+					HomeTeamID: scheduledMatch.HomeTeamID,
+					AwayTeamID: scheduledMatch.AwayTeamID,
+					StartsAt:   startsAt,
+				}).Return(&models.ResultEvent{}, nil).Once()
+				return m
+			},
+		},
+		{
+			name:  "it returns an error when external match status is cancelled and result event recording fails",
+			input: matchID,
+			subscriptionRepository: func(t *testing.T) *mocks.SubscriptionRepository {
+				t.Helper()
+				m := mocks.NewSubscriptionRepository(t)
+				m.On("UpdateStatusByMatch", ctx, matchID, models.UnverifiedSub, models.MatchCancelledSub).Return(nil).Once()
+				m.On("UpdateStatusByMatch", ctx, matchID, models.PendingSub, models.MatchCancelledSub).Return(nil).Once()
+				return m
+			},
+			matchRepository: func(t *testing.T) *mocks.MatchRepository {
+				t.Helper()
+				m := mocks.NewMatchRepository(t)
+				cancelledMatch := scheduledMatch
+				cancelledMatch.ResultStatus = models.Cancelled
+				m.On("One", ctx, models.Match{ID: matchID}).Return(&scheduledMatch, nil).Once()
+				m.On("Update", ctx, transitionTo(matchID, models.Cancelled)).Return(&cancelledMatch, nil).Once()
+				return m
+			},
+			externalAPIClient: func(t *testing.T) *mocks.ExternalAPIClient {
+				t.Helper()
+				m := mocks.NewExternalAPIClient(t)
+				m.On("GetMatches", ctx, startsAt).Return([]models.ExternalAPIMatch{externalMatchClientCancelled}, nil).Once()
+				return m
+			},
+			externalMatchRepository: func(t *testing.T) *mocks.ExternalMatchRepository {
+				t.Helper()
+				m := mocks.NewExternalMatchRepository(t)
+				m.On("Save", ctx, &externalMatchID, expectedRepositoryMatchCancelled).Return(&models.ExternalMatch{}, nil).Once()
+				return m
+			},
+			resultEventRepository: func(t *testing.T) *mocks.ResultEventRepository {
+				t.Helper()
+				m := mocks.NewResultEventRepository(t)
+				m.On("Create", ctx, models.ResultEvent{
+					MatchID:    matchID,
+					Type:       models.EventMatchCancelled,
+					HomeTeamID: scheduledMatch.HomeTeamID,
+					AwayTeamID: scheduledMatch.AwayTeamID,
+					StartsAt:   startsAt,
+				}).Return(nil, unexpectedErr).Once()
+				return m
+			},
+			expectedErr: fmt.Errorf("failed to record result event %s: %w", models.EventMatchCancelled, unexpectedErr),
+		},
+		{
+			name:  "it returns an error when external match status is cancelled and unverified subscriptions update fails",
+			input: matchID,
+			subscriptionRepository: func(t *testing.T) *mocks.SubscriptionRepository {
+				t.Helper()
+				m := mocks.NewSubscriptionRepository(t)
+				m.On("UpdateStatusByMatch", ctx, matchID, models.UnverifiedSub, models.MatchCancelledSub).Return(unexpectedErr).Once()
+				return m
+			},
+			matchRepository: func(t *testing.T) *mocks.MatchRepository {
+				t.Helper()
+				m := mocks.NewMatchRepository(t)
+				cancelledMatch := scheduledMatch
+				cancelledMatch.ResultStatus = models.Cancelled
+				m.On("One", ctx, models.Match{ID: matchID}).Return(&scheduledMatch, nil).Once()
+				m.On("Update", ctx, transitionTo(matchID, models.Cancelled)).Return(&cancelledMatch, nil).Once()
+				return m
+			},
+			externalAPIClient: func(t *testing.T) *mocks.ExternalAPIClient {
+				t.Helper()
+				m := mocks.NewExternalAPIClient(t)
+				m.On("GetMatches", ctx, startsAt).Return([]models.ExternalAPIMatch{externalMatchClientCancelled}, nil).Once()
+				return m
+			},
+			externalMatchRepository: func(t *testing.T) *mocks.ExternalMatchRepository {
+				t.Helper()
+				m := mocks.NewExternalMatchRepository(t)
+				m.On("Save", ctx, &externalMatchID, expectedRepositoryMatchCancelled).Return(&models.ExternalMatch{}, nil).Once()
+				return m
+			},
+			expectedErr: fmt.Errorf("failed to update unverified subscriptions status to %s: %w", models.MatchCancelledSub, unexpectedErr),
+		},
+		{
+			name:  "it returns an error when external match status is cancelled and subscriptions update fails",
+			input: matchID,
+			subscriptionRepository: func(t *testing.T) *mocks.SubscriptionRepository {
+				t.Helper()
+				m := mocks.NewSubscriptionRepository(t)
+				m.On("UpdateStatusByMatch", ctx, matchID, models.UnverifiedSub, models.MatchCancelledSub).Return(nil).Once()
+				m.On("UpdateStatusByMatch", ctx, matchID, models.PendingSub, models.MatchCancelledSub).Return(unexpectedErr).Once()
+				return m
+			},
 			matchRepository: func(t *testing.T) *mocks.MatchRepository {
 				t.Helper()
 				m := mocks.NewMatchRepository(t)
 				cancelledMatch := scheduledMatch
 				cancelledMatch.ResultStatus = models.Cancelled
 				m.On("One", ctx, models.Match{ID: matchID}).Return(&scheduledMatch, nil).Once()
-				m.On("Update", ctx, matchID, models.Cancelled).Return(&cancelledMatch, nil).Once()
+				m.On("Update", ctx, transitionTo(matchID, models.Cancelled)).Return(&cancelledMatch, nil).Once()
 				return m
 			},
 			externalAPIClient: func(t *testing.T) *mocks.ExternalAPIClient {
@@ -329,17 +511,25 @@ func TestResultCheckerService_CheckResult(t *testing.T) {
 				m.On("Save", ctx, &externalMatchID, expectedRepositoryMatchCancelled).Return(&models.ExternalMatch{}, nil).Once()
 				return m
 			},
+			expectedErr: fmt.Errorf("failed to update subscriptions status to %s: %w", models.MatchCancelledSub, unexpectedErr),
 		},
 		{
 			name:  "it returns nil when external match status is not started and update succeeds",
 			input: matchID,
+			subscriptionRepository: func(t *testing.T) *mocks.SubscriptionRepository {
+				t.Helper()
+				m := mocks.NewSubscriptionRepository(t)
+				m.On("UpdateStatusByMatch", ctx, matchID, models.UnverifiedSub, models.MatchCancelledSub).Return(nil).Once()
+				m.On("UpdateStatusByMatch", ctx, matchID, models.PendingSub, models.MatchCancelledSub).Return(nil).Once()
+				return m
+			},
 			matchRepository: func(t *testing.T) *mocks.MatchRepository {
 				t.Helper()
 				m := mocks.NewMatchRepository(t)
 				cancelledMatch := scheduledMatch
 				cancelledMatch.ResultStatus = models.Cancelled
 				m.On("One", ctx, models.Match{ID: matchID}).Return(&scheduledMatch, nil).Once()
-				m.On("Update", ctx, matchID, models.Cancelled).Return(&cancelledMatch, nil).Once()
+				m.On("Update", ctx, transitionTo(matchID, models.Cancelled)).Return(&cancelledMatch, nil).Once()
 				return m
 			},
 			externalAPIClient: func(t *testing.T) *mocks.ExternalAPIClient {
@@ -381,13 +571,12 @@ func TestResultCheckerService_CheckResult(t *testing.T) {
 			expectedErr: errors.New("match relation result check task doesn't exist"),
 		},
 		{
-			name:  "it returns an error when external match status is in progress and task re-scheduling fails",
+			name:  "it returns an error when external match status is in progress and outbox task creation fails",
 			input: matchID,
 			matchRepository: func(t *testing.T) *mocks.MatchRepository {
 				t.Helper()
 				m := mocks.NewMatchRepository(t)
 				m.On("One", ctx, models.Match{ID: matchID}).Return(&scheduledMatch, nil).Once()
-				m.On("Update", ctx, matchID, models.SchedulingError).Return(&models.Match{}, nil).Once()
 				return m
 			},
 			externalAPIClient: func(t *testing.T) *mocks.ExternalAPIClient {
@@ -402,24 +591,26 @@ func TestResultCheckerService_CheckResult(t *testing.T) {
 				m.On("Save", ctx, &externalMatchID, expectedRepositoryMatchInProgress).Return(&models.ExternalMatch{}, nil).Once()
 				return m
 			},
-			taskClient: func(t *testing.T) *mocks.TaskClient {
+			outboxTaskRepository: func(t *testing.T) *mocks.OutboxTaskRepository {
 				t.Helper()
-				m := mocks.NewTaskClient(t)
-				m.On("ScheduleResultCheck", ctx, matchID, scheduledMatch.CheckResultTask.AttemptNumber+1, scheduledMatch.StartsAt.Add(pollingFirstAttemptDelay).Add(pollingInterval)).
-					Return(nil, unexpectedErr).
-					Once()
+				m := mocks.NewOutboxTaskRepository(t)
+				m.On("Create", ctx, models.OutboxTask{
+					Kind:          models.OutboxKindResultCheck,
+					MatchID:       matchID,
+					AttemptNumber: scheduledMatch.CheckResultTask.AttemptNumber + 1,
+					ExecuteAt:     scheduledMatch.StartsAt.Add(pollingFirstAttemptDelay).Add(pollingInterval),
+				}).Return(nil, unexpectedErr).Once()
 				return m
 			},
-			expectedErr: fmt.Errorf("failed to re-schedule result check task: %w", unexpectedErr),
+			expectedErr: fmt.Errorf("failed to create result check outbox task: %w", unexpectedErr),
 		},
 		{
-			name:  "it returns an error when external match status is in progress and task re-scheduling fails and match status update fails",
+			name:  "success - it returns nil when external match status is in progress and outbox task dispatch fails",
 			input: matchID,
 			matchRepository: func(t *testing.T) *mocks.MatchRepository {
 				t.Helper()
 				m := mocks.NewMatchRepository(t)
 				m.On("One", ctx, models.Match{ID: matchID}).Return(&scheduledMatch, nil).Once()
-				m.On("Update", ctx, matchID, models.SchedulingError).Return(nil, unexpectedErr).Once()
 				return m
 			},
 			externalAPIClient: func(t *testing.T) *mocks.ExternalAPIClient {
@@ -434,18 +625,26 @@ func TestResultCheckerService_CheckResult(t *testing.T) {
 				m.On("Save", ctx, &externalMatchID, expectedRepositoryMatchInProgress).Return(&models.ExternalMatch{}, nil).Once()
 				return m
 			},
-			taskClient: func(t *testing.T) *mocks.TaskClient {
+			outboxTaskRepository: func(t *testing.T) *mocks.OutboxTaskRepository {
 				t.Helper()
-				m := mocks.NewTaskClient(t)
-				m.On("ScheduleResultCheck", ctx, matchID, scheduledMatch.CheckResultTask.AttemptNumber+1, scheduledMatch.StartsAt.Add(pollingFirstAttemptDelay).Add(pollingInterval)).
-					Return(nil, unexpectedErr).
-					Once()
+				m := mocks.NewOutboxTaskRepository(t)
+				m.On("Create", ctx, models.OutboxTask{
+					Kind:          models.OutboxKindResultCheck,
+					MatchID:       matchID,
+					AttemptNumber: scheduledMatch.CheckResultTask.AttemptNumber + 1,
+					ExecuteAt:     scheduledMatch.StartsAt.Add(pollingFirstAttemptDelay).Add(pollingInterval),
+				}).Return(&models.OutboxTask{ID: outboxTaskID}, nil).Once()
+				return m
+			},
+			outboxDispatcher: func(t *testing.T) *mocks.OutboxDispatcher {
+				t.Helper()
+				m := mocks.NewOutboxDispatcher(t)
+				m.On("Dispatch", ctx, outboxTaskID).Return(unexpectedErr).Once()
 				return m
 			},
-			expectedErr: fmt.Errorf("failed to re-schedule result check task: %w", unexpectedErr),
 		},
 		{
-			name:  "it returns an error when external match status is in progress and rescheduled task saving fails",
+			name:  "success - it returns nil when processing external match with status in progress",
 			input: matchID,
 			matchRepository: func(t *testing.T) *mocks.MatchRepository {
 				t.Helper()
@@ -465,29 +664,26 @@ func TestResultCheckerService_CheckResult(t *testing.T) {
 				m.On("Save", ctx, &externalMatchID, expectedRepositoryMatchInProgress).Return(&models.ExternalMatch{}, nil).Once()
 				return m
 			},
-			taskClient: func(t *testing.T) *mocks.TaskClient {
+			outboxTaskRepository: func(t *testing.T) *mocks.OutboxTaskRepository {
 				t.Helper()
-				m := mocks.NewTaskClient(t)
-				m.On("ScheduleResultCheck", ctx, matchID, scheduledMatch.CheckResultTask.AttemptNumber+1, scheduledMatch.StartsAt.Add(pollingFirstAttemptDelay).Add(pollingInterval)).
-					Return(&clientTask, nil).
-					Once()
+				m := mocks.NewOutboxTaskRepository(t)
+				m.On("Create", ctx, models.OutboxTask{
+					Kind:          models.OutboxKindResultCheck,
+					MatchID:       matchID,
+					AttemptNumber: scheduledMatch.CheckResultTask.AttemptNumber + 1,
+					ExecuteAt:     scheduledMatch.StartsAt.Add(pollingFirstAttemptDelay).Add(pollingInterval),
+				}).Return(&models.OutboxTask{ID: outboxTaskID}, nil).Once()
 				return m
 			},
-			checkResultTaskRepository: func(t *testing.T) *mocks.CheckResultTaskRepository {
+			outboxDispatcher: func(t *testing.T) *mocks.OutboxDispatcher {
 				t.Helper()
-				m := mocks.NewCheckResultTaskRepository(t)
-				m.On("Save", ctx, models.CheckResultTask{
-					MatchID:       matchID,
-					Name:          clientTask.Name,
-					AttemptNumber: scheduledMatch.CheckResultTask.AttemptNumber + 1,
-					ExecuteAt:     clientTask.ExecuteAt,
-				}).Return(nil, unexpectedErr).Once()
+				m := mocks.NewOutboxDispatcher(t)
+				m.On("Dispatch", ctx, outboxTaskID).Return(nil).Once()
 				return m
 			},
-			expectedErr: fmt.Errorf("failed to update result check task: %w", unexpectedErr),
 		},
 		{
-			name:  "success - it returns nil when processing external match with status in progress",
+			name:  "success - it publishes kickoff, goals and half-time observed while match is in play",
 			input: matchID,
 			matchRepository: func(t *testing.T) *mocks.MatchRepository {
 				t.Helper()
@@ -498,32 +694,110 @@ func TestResultCheckerService_CheckResult(t *testing.T) {
 			externalAPIClient: func(t *testing.T) *mocks.ExternalAPIClient {
 				t.Helper()
 				m := mocks.NewExternalAPIClient(t)
-				m.On("GetMatches", ctx, startsAt).Return([]models.ExternalAPIMatch{externalMatchClientInProgress}, nil).Once()
+				m.On("GetMatches", ctx, startsAt).Return([]models.ExternalAPIMatch{externalMatchClientHalfTime}, nil).Once()
 				return m
 			},
 			externalMatchRepository: func(t *testing.T) *mocks.ExternalMatchRepository {
 				t.Helper()
 				m := mocks.NewExternalMatchRepository(t)
-				m.On("Save", ctx, &externalMatchID, expectedRepositoryMatchInProgress).Return(&models.ExternalMatch{}, nil).Once()
+				m.On("Save", ctx, &externalMatchID, models.ExternalMatch{
+					ID:        externalMatchID,
+					MatchID:   matchID,
+					HomeScore: 2,
+					AwayScore: 1,
+					Status:    models.StatusMatchInProgress,
+				}).Return(&models.ExternalMatch{}, nil).Once()
+				return m
+			},
+			eventPublisher: func(t *testing.T) *mocks.EventPublisher {
+				t.Helper()
+				m := mocks.NewEventPublisher(t)
+				m.On("Publish", ctx, matchEvent(matchID, models.EventMatchKickoff, "kickoff", 0, 0, startsAt)).Return(nil).Once()
+				m.On("Publish", ctx, matchEvent(matchID, models.EventMatchGoal, "goal-1-0", 1, 0, startsAt)).Return(nil).Once()
+				m.On("Publish", ctx, matchEvent(matchID, models.EventMatchGoal, "goal-2-0", 2, 0, startsAt)).Return(nil).Once()
+				m.On("Publish", ctx, matchEvent(matchID, models.EventMatchGoal, "goal-2-1", 2, 1, startsAt)).Return(unexpectedErr).Once()
+				m.On("Publish", ctx, matchEvent(matchID, models.EventMatchHalfTime, "half-time", 2, 1, startsAt)).Return(nil).Once()
+				return m
+			},
+			outboxTaskRepository: func(t *testing.T) *mocks.OutboxTaskRepository {
+				t.Helper()
+				m := mocks.NewOutboxTaskRepository(t)
+				m.On("Create", ctx, mock.Anything).Return(&models.OutboxTask{ID: outboxTaskID}, nil).Once()
+				return m
+			},
+			outboxDispatcher: func(t *testing.T) *mocks.OutboxDispatcher {
+				t.Helper()
+				m := mocks.NewOutboxDispatcher(t)
+				m.On("Dispatch", ctx, outboxTaskID).Return(nil).Once()
+				return m
+			},
+		},
+		{
+			name:  "success - it publishes rescheduled event, reschedules reminders of active subscriptions, saves the kickoff and moves result check when kickoff has moved later",
+			input: matchID,
+			subscriptionRepository: func(t *testing.T) *mocks.SubscriptionRepository {
+				t.Helper()
+				m := mocks.NewSubscriptionRepository(t)
+				unsubscribed := remindedSubscription
+				unsubscribed.ID++
+				unsubscribed.Status = models.UnsubscribedSub
+				suspended := remindedSubscription
+				suspended.ID += 2
+				suspended.Status = models.SuspendedSub
+				m.On("ListWithReminderByMatch", ctx, matchID).Return([]models.Subscription{remindedSubscription, unsubscribed, suspended}, nil).Once()
 				return m
 			},
 			taskClient: func(t *testing.T) *mocks.TaskClient {
 				t.Helper()
 				m := mocks.NewTaskClient(t)
-				m.On("ScheduleResultCheck", ctx, matchID, scheduledMatch.CheckResultTask.AttemptNumber+1, scheduledMatch.StartsAt.Add(pollingFirstAttemptDelay).Add(pollingInterval)).
-					Return(&clientTask, nil).
-					Once()
+				rescheduledAt := startsAt.Add(time.Hour)
+				m.On("ScheduleKickoffReminder", ctx, remindedSubscription.ID, rescheduledAt, rescheduledAt.Add(-30*time.Minute)).Return(nil).Once()
+				return m
+			},
+			matchRepository: func(t *testing.T) *mocks.MatchRepository {
+				t.Helper()
+				m := mocks.NewMatchRepository(t)
+				m.On("One", ctx, models.Match{ID: matchID}).Return(&scheduledMatch, nil).Once()
+				m.On("UpdateStartsAt", ctx, matchID, startsAt.Add(time.Hour)).Return(nil).Once()
+				return m
+			},
+			externalAPIClient: func(t *testing.T) *mocks.ExternalAPIClient {
+				t.Helper()
+				m := mocks.NewExternalAPIClient(t)
+				rescheduled := externalMatchClientNotStarted
+				rescheduled.Time = startsAt.Add(time.Hour)
+				rescheduled.HomeScore, rescheduled.AwayScore = 0, 0
+				m.On("GetMatches", ctx, startsAt).Return([]models.ExternalAPIMatch{rescheduled}, nil).Once()
+				return m
+			},
+			externalMatchRepository: func(t *testing.T) *mocks.ExternalMatchRepository {
+				t.Helper()
+				m := mocks.NewExternalMatchRepository(t)
+				m.On("Save", ctx, &externalMatchID, mock.Anything).Return(&models.ExternalMatch{}, nil).Once()
 				return m
 			},
-			checkResultTaskRepository: func(t *testing.T) *mocks.CheckResultTaskRepository {
+			eventPublisher: func(t *testing.T) *mocks.EventPublisher {
 				t.Helper()
-				m := mocks.NewCheckResultTaskRepository(t)
-				m.On("Save", ctx, models.CheckResultTask{
+				m := mocks.NewEventPublisher(t)
+				rescheduledAt := startsAt.Add(time.Hour)
+				m.On("Publish", ctx, matchEvent(matchID, models.EventMatchRescheduled, fmt.Sprintf("rescheduled-%d", rescheduledAt.Unix()), 0, 0, rescheduledAt)).Return(nil).Once()
+				return m
+			},
+			outboxTaskRepository: func(t *testing.T) *mocks.OutboxTaskRepository {
+				t.Helper()
+				m := mocks.NewOutboxTaskRepository(t)
+				m.On("Create", ctx, models.OutboxTask{
+					Kind:          models.OutboxKindResultCheck,
 					MatchID:       matchID,
-					Name:          clientTask.Name,
-					AttemptNumber: scheduledMatch.CheckResultTask.AttemptNumber + 1,
-					ExecuteAt:     clientTask.ExecuteAt,
-				}).Return(&models.CheckResultTask{}, nil).Once()
+					AttemptNumber: 2,
+					ExecuteAt:     startsAt.Add(time.Hour).Add(pollingFirstAttemptDelay),
+				}).Return(&models.OutboxTask{ID: outboxTaskID}, nil).Once()
+				return m
+			},
+			outboxDispatcher: func(t *testing.T) *mocks.OutboxDispatcher {
+				t.Helper()
+				m := mocks.NewOutboxDispatcher(t)
+				m.On("Dispatch", ctx, outboxTaskID).Return(nil).Once()
 				return m
 			},
 		},
@@ -563,7 +837,7 @@ func TestResultCheckerService_CheckResult(t *testing.T) {
 				t.Helper()
 				m := mocks.NewMatchRepository(t)
 				m.On("One", ctx, models.Match{ID: matchID}).Return(&scheduledMatch, nil).Once()
-				m.On("Update", ctx, matchID, models.Received).Return(nil, unexpectedErr).Once()
+				m.On("Update", ctx, transitionTo(matchID, models.Received)).Return(nil, unexpectedErr).Once()
 				return m
 			},
 			externalAPIClient: func(t *testing.T) *mocks.ExternalAPIClient {
@@ -617,7 +891,7 @@ func TestResultCheckerService_CheckResult(t *testing.T) {
 			taskClient: func(t *testing.T) *mocks.TaskClient {
 				t.Helper()
 				m := mocks.NewTaskClient(t)
-				m.On("ScheduleSubscriberNotification", ctx, repositorySubscription.ID).Return(unexpectedErr).Once()
+				m.On("ScheduleSubscriberNotification", ctx, repositorySubscription.ID, "").Return(unexpectedErr).Once()
 				return m
 			},
 			expectedErr: fmt.Errorf("failed to schedule subscriber notification: %w", unexpectedErr),
@@ -653,7 +927,7 @@ func TestResultCheckerService_CheckResult(t *testing.T) {
 			taskClient: func(t *testing.T) *mocks.TaskClient {
 				t.Helper()
 				m := mocks.NewTaskClient(t)
-				m.On("ScheduleSubscriberNotification", ctx, repositorySubscription.ID).Return(unexpectedErr).Once()
+				m.On("ScheduleSubscriberNotification", ctx, repositorySubscription.ID, "").Return(unexpectedErr).Once()
 				return m
 			},
 			expectedErr: fmt.Errorf("failed to schedule subscriber notification: %w", unexpectedErr),
@@ -665,7 +939,7 @@ func TestResultCheckerService_CheckResult(t *testing.T) {
 				t.Helper()
 				m := mocks.NewMatchRepository(t)
 				m.On("One", ctx, models.Match{ID: matchID}).Return(&scheduledMatch, nil).Once()
-				m.On("Update", ctx, matchID, models.Received).Return(&models.Match{}, nil).Once()
+				m.On("Update", ctx, transitionTo(matchID, models.Received)).Return(&models.Match{}, nil).Once()
 				return m
 			},
 			externalAPIClient: func(t *testing.T) *mocks.ExternalAPIClient {
@@ -684,69 +958,742 @@ func TestResultCheckerService_CheckResult(t *testing.T) {
 				t.Helper()
 				m := mocks.NewSubscriptionRepository(t)
 				m.On("ListByMatchAndStatus", ctx, matchID, models.PendingSub).Return([]models.Subscription{repositorySubscription}, nil).Once()
+				m.On("UpdateStatusByMatch", ctx, matchID, models.UnverifiedSub, models.SubscriberErrorSub).Return(nil).Once()
 				return m
 			},
 			taskClient: func(t *testing.T) *mocks.TaskClient {
 				t.Helper()
 				m := mocks.NewTaskClient(t)
-				m.On("ScheduleSubscriberNotification", ctx, repositorySubscription.ID).Return(nil).Once()
+				m.On("ScheduleSubscriberNotification", ctx, repositorySubscription.ID, "").Return(nil).Once()
+				return m
+			},
+			outboxTaskRepository: func(t *testing.T) *mocks.OutboxTaskRepository {
+				t.Helper()
+				m := mocks.NewOutboxTaskRepository(t)
+				m.On("Create", ctx, mock.MatchedBy(func(outboxTask models.OutboxTask) bool {
+					return outboxTask.Kind == models.OutboxKindResultCheck &&
+						outboxTask.MatchID == matchID &&
+						outboxTask.AttemptNumber == 2 &&
+						outboxTask.ExecuteAt.After(time.Now().Add(correctionDelay-time.Minute))
+				})).Return(&models.OutboxTask{ID: outboxTaskID}, nil).Once()
+				return m
+			},
+			outboxDispatcher: func(t *testing.T) *mocks.OutboxDispatcher {
+				t.Helper()
+				m := mocks.NewOutboxDispatcher(t)
+				m.On("Dispatch", ctx, outboxTaskID).Return(nil).Once()
+				return m
+			},
+			resultEventRepository: func(t *testing.T) *mocks.ResultEventRepository {
+				t.Helper()
+				m := mocks.NewResultEventRepository(t)
+				m.On("Create", ctx, models.ResultEvent{
+					MatchID:    matchID,
+					Type:       models.EventResultFinished,
+					HomeTeamID: scheduledMatch.HomeTeamID,
+					AwayTeamID: scheduledMatch.AwayTeamID,
+					StartsAt:   startsAt,
+					HomeScore:  externalMatchClientFinished.HomeScore,
+					AwayScore:  externalMatchClientFinished.AwayScore,
+					FinishType: externalMatchClientFinished.FinishType,
+				}).Return(nil, models.NewResourceAlreadyExistsError(errors.New("already exists"))).Once()
 				return m
 			},
 		},
-	}
-
-	for _, tt := range tests {
-		t.Run(tt.name, func(t *testing.T) {
-			var matchRepository *mocks.MatchRepository
-			if tt.matchRepository != nil {
-				matchRepository = tt.matchRepository(t)
-			}
-
-			var externalAPIClient *mocks.ExternalAPIClient
-			if tt.externalAPIClient != nil {
-				externalAPIClient = tt.externalAPIClient(t)
-			}
-
-			var externalMatchRepository *mocks.ExternalMatchRepository
-			if tt.externalMatchRepository != nil {
-				externalMatchRepository = tt.externalMatchRepository(t)
-			}
-
-			var checkResultTaskRepository *mocks.CheckResultTaskRepository
-			if tt.checkResultTaskRepository != nil {
-				checkResultTaskRepository = tt.checkResultTaskRepository(t)
-			}
-
-			var taskClient *mocks.TaskClient
-			if tt.taskClient != nil {
-				taskClient = tt.taskClient(t)
-			}
-
-			var subscriptionRepository *mocks.SubscriptionRepository
-			if tt.subscriptionRepository != nil {
-				subscriptionRepository = tt.subscriptionRepository(t)
-			}
-
-			logger := loggerinternal.SetupLogger()
-
-			cfg := config.ResultCheck{
-				MaxRetries:        0,
-				Interval:          pollingInterval,
-				FirstAttemptDelay: pollingFirstAttemptDelay,
-			}
-
-			rcs := match.NewResultCheckerService(
-				cfg,
-				matchRepository,
-				externalMatchRepository,
-				subscriptionRepository,
-				checkResultTaskRepository,
-				taskClient,
-				externalAPIClient,
-				logger,
-			)
-
-			err := rcs.CheckResult(ctx, tt.input)
+		{
+			name:  "success - it saves result check attempt with error outcome when external api fails",
+			input: matchID,
+			matchRepository: func(t *testing.T) *mocks.MatchRepository {
+				t.Helper()
+				m := mocks.NewMatchRepository(t)
+				m.On("One", ctx, models.Match{ID: matchID}).Return(&scheduledMatch, nil).Once()
+				m.On("Update", ctx, transitionTo(matchID, models.APIError)).Return(&models.Match{}, nil).Once()
+				return m
+			},
+			subscriptionRepository: func(t *testing.T) *mocks.SubscriptionRepository {
+				t.Helper()
+				m := mocks.NewSubscriptionRepository(t)
+				m.On("UpdateStatusByMatch", ctx, matchID, models.UnverifiedSub, models.MatchFailedSub).Return(nil).Once()
+				m.On("UpdateStatusByMatch", ctx, matchID, models.PendingSub, models.MatchFailedSub).Return(nil).Once()
+				return m
+			},
+			externalAPIClient: func(t *testing.T) *mocks.ExternalAPIClient {
+				t.Helper()
+				m := mocks.NewExternalAPIClient(t)
+				m.On("GetMatches", ctx, startsAt).Return(nil, unexpectedErr).Once()
+				return m
+			},
+			resultCheckAttemptRepository: func(t *testing.T) *mocks.ResultCheckAttemptRepository {
+				t.Helper()
+				m := mocks.NewResultCheckAttemptRepository(t)
+				m.On("Create", ctx, mock.MatchedBy(func(actual models.ResultCheckAttempt) bool {
+					return actual.MatchID == matchID &&
+						actual.AttemptNumber == scheduledMatch.CheckResultTask.AttemptNumber &&
+						actual.Outcome == models.OutcomeError &&
+						actual.ErrorMessage != nil &&
+						actual.ProviderStatus == nil
+				})).Return(&models.ResultCheckAttempt{}, nil).Once()
+				return m
+			},
+			expectedErr: fmt.Errorf("failed to get matches from external api: %w", unexpectedErr),
+		},
+		{
+			name:  "success - it saves result check attempt with observed data when match is finished",
+			input: matchID,
+			matchRepository: func(t *testing.T) *mocks.MatchRepository {
+				t.Helper()
+				m := mocks.NewMatchRepository(t)
+				m.On("One", ctx, models.Match{ID: matchID}).Return(&scheduledMatch, nil).Once()
+				m.On("Update", ctx, transitionTo(matchID, models.Received)).Return(&models.Match{}, nil).Once()
+				return m
+			},
+			externalAPIClient: func(t *testing.T) *mocks.ExternalAPIClient {
+				t.Helper()
+				m := mocks.NewExternalAPIClient(t)
+				m.On("GetMatches", ctx, startsAt).Return([]models.ExternalAPIMatch{externalMatchClientFinished}, nil).Once()
+				return m
+			},
+			externalMatchRepository: func(t *testing.T) *mocks.ExternalMatchRepository {
+				t.Helper()
+				m := mocks.NewExternalMatchRepository(t)
+				m.On("Save", ctx, &externalMatchID, expectedRepositoryMatchFinished).Return(&models.ExternalMatch{}, nil).Once()
+				return m
+			},
+			subscriptionRepository: func(t *testing.T) *mocks.SubscriptionRepository {
+				t.Helper()
+				m := mocks.NewSubscriptionRepository(t)
+				m.On("ListByMatchAndStatus", ctx, matchID, models.PendingSub).Return([]models.Subscription{}, nil).Once()
+				m.On("UpdateStatusByMatch", ctx, matchID, models.UnverifiedSub, models.SubscriberErrorSub).Return(nil).Once()
+				return m
+			},
+			resultCheckAttemptRepository: func(t *testing.T) *mocks.ResultCheckAttemptRepository {
+				t.Helper()
+				m := mocks.NewResultCheckAttemptRepository(t)
+				m.On("Create", ctx, mock.MatchedBy(func(actual models.ResultCheckAttempt) bool {
+					return actual.MatchID == matchID &&
+						actual.Outcome == models.OutcomeFinished &&
+						actual.ErrorMessage == nil &&
+						*actual.ProviderStatus == models.StatusMatchFinished &&
+						*actual.HomeScore == externalMatchClientFinished.HomeScore &&
+						*actual.AwayScore == externalMatchClientFinished.AwayScore
+				})).Return(nil, unexpectedErr).Once()
+				return m
+			},
+			outboxTaskRepository: func(t *testing.T) *mocks.OutboxTaskRepository {
+				t.Helper()
+				m := mocks.NewOutboxTaskRepository(t)
+				m.On("Create", ctx, mock.Anything).Return(nil, unexpectedErr).Once()
+				return m
+			},
+		},
+		{
+			name:  "success - it records corrected result when score of received match is changed",
+			input: matchID,
+			matchRepository: func(t *testing.T) *mocks.MatchRepository {
+				t.Helper()
+				m := mocks.NewMatchRepository(t)
+				m.On("One", ctx, models.Match{ID: matchID}).Return(&receivedMatch, nil).Once()
+				return m
+			},
+			externalAPIClient: func(t *testing.T) *mocks.ExternalAPIClient {
+				t.Helper()
+				m := mocks.NewExternalAPIClient(t)
+				m.On("GetMatches", ctx, startsAt).Return([]models.ExternalAPIMatch{externalMatchClientFinished}, nil).Once()
+				return m
+			},
+			externalMatchRepository: func(t *testing.T) *mocks.ExternalMatchRepository {
+				t.Helper()
+				m := mocks.NewExternalMatchRepository(t)
+				m.On("Save", ctx, &externalMatchID, expectedRepositoryMatchFinished).Return(&models.ExternalMatch{}, nil).Once()
+				return m
+			},
+			resultEventRepository: func(t *testing.T) *mocks.ResultEventRepository {
+				t.Helper()
+				m := mocks.NewResultEventRepository(t)
+				m.On("Create", ctx, models.ResultEvent{
+					MatchID:    matchID,
+					Type:       models.EventResultCorrected,
+					HomeTeamID: scheduledMatch.HomeTeamID,
+					AwayTeamID: scheduledMatch.AwayTeamID,
+					StartsAt:   startsAt,
+					HomeScore:  externalMatchClientFinished.HomeScore,
+					AwayScore:  externalMatchClientFinished.AwayScore,
+					FinishType: externalMatchClientFinished.FinishType,
+				}).Return(&models.ResultEvent{}, nil).Once()
+				return m
+			},
+			resultCheckAttemptRepository: func(t *testing.T) *mocks.ResultCheckAttemptRepository {
+				t.Helper()
+				m := mocks.NewResultCheckAttemptRepository(t)
+				m.On("Create", ctx, mock.MatchedBy(func(actual models.ResultCheckAttempt) bool {
+					return actual.MatchID == matchID &&
+						actual.AttemptNumber == receivedMatch.CheckResultTask.AttemptNumber &&
+						actual.Outcome == models.OutcomeCorrected &&
+						actual.ErrorMessage == nil &&
+						*actual.HomeScore == externalMatchClientFinished.HomeScore &&
+						*actual.AwayScore == externalMatchClientFinished.AwayScore
+				})).Return(&models.ResultCheckAttempt{}, nil).Once()
+				return m
+			},
+		},
+		{
+			name:  "it returns an error when score of received match is changed and corrected result recording fails",
+			input: matchID,
+			matchRepository: func(t *testing.T) *mocks.MatchRepository {
+				t.Helper()
+				m := mocks.NewMatchRepository(t)
+				m.On("One", ctx, models.Match{ID: matchID}).Return(&receivedMatch, nil).Once()
+				return m
+			},
+			externalAPIClient: func(t *testing.T) *mocks.ExternalAPIClient {
+				t.Helper()
+				m := mocks.NewExternalAPIClient(t)
+				m.On("GetMatches", ctx, startsAt).Return([]models.ExternalAPIMatch{externalMatchClientFinished}, nil).Once()
+				return m
+			},
+			externalMatchRepository: func(t *testing.T) *mocks.ExternalMatchRepository {
+				t.Helper()
+				m := mocks.NewExternalMatchRepository(t)
+				m.On("Save", ctx, &externalMatchID, expectedRepositoryMatchFinished).Return(&models.ExternalMatch{}, nil).Once()
+				return m
+			},
+			resultEventRepository: func(t *testing.T) *mocks.ResultEventRepository {
+				t.Helper()
+				m := mocks.NewResultEventRepository(t)
+				m.On("Create", ctx, mock.Anything).Return(nil, unexpectedErr).Once()
+				return m
+			},
+			expectedErr: fmt.Errorf("failed to record result event %s: %w", models.EventResultCorrected, unexpectedErr),
+		},
+		{
+			name:  "success - it doesn't record corrected result when score of received match is not changed",
+			input: matchID,
+			matchRepository: func(t *testing.T) *mocks.MatchRepository {
+				t.Helper()
+				m := mocks.NewMatchRepository(t)
+				m.On("One", ctx, models.Match{ID: matchID}).Return(&notCorrectedMatch, nil).Once()
+				return m
+			},
+			externalAPIClient: func(t *testing.T) *mocks.ExternalAPIClient {
+				t.Helper()
+				m := mocks.NewExternalAPIClient(t)
+				m.On("GetMatches", ctx, startsAt).Return([]models.ExternalAPIMatch{externalMatchClientFinished}, nil).Once()
+				return m
+			},
+			resultEventRepository: func(t *testing.T) *mocks.ResultEventRepository {
+				t.Helper()
+				return mocks.NewResultEventRepository(t)
+			},
+			resultCheckAttemptRepository: func(t *testing.T) *mocks.ResultCheckAttemptRepository {
+				t.Helper()
+				m := mocks.NewResultCheckAttemptRepository(t)
+				m.On("Create", ctx, mock.MatchedBy(func(actual models.ResultCheckAttempt) bool {
+					return actual.MatchID == matchID && actual.Outcome == models.OutcomeFinished && actual.ErrorMessage == nil
+				})).Return(&models.ResultCheckAttempt{}, nil).Once()
+				return m
+			},
+		},
+		{
+			name:  "success - it saves cancelled attempt when received match is not found during correction check",
+			input: matchID,
+			matchRepository: func(t *testing.T) *mocks.MatchRepository {
+				t.Helper()
+				m := mocks.NewMatchRepository(t)
+				m.On("One", ctx, models.Match{ID: matchID}).Return(&receivedMatch, nil).Once()
+				return m
+			},
+			externalAPIClient: func(t *testing.T) *mocks.ExternalAPIClient {
+				t.Helper()
+				m := mocks.NewExternalAPIClient(t)
+				m.On("GetMatches", ctx, startsAt).Return([]models.ExternalAPIMatch{}, nil).Once()
+				return m
+			},
+			resultCheckAttemptRepository: func(t *testing.T) *mocks.ResultCheckAttemptRepository {
+				t.Helper()
+				m := mocks.NewResultCheckAttemptRepository(t)
+				m.On("Create", ctx, mock.MatchedBy(func(actual models.ResultCheckAttempt) bool {
+					return actual.MatchID == matchID && actual.Outcome == models.OutcomeCancelled && actual.ProviderStatus == nil
+				})).Return(&models.ResultCheckAttempt{}, nil).Once()
+				return m
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var matchRepository *mocks.MatchRepository
+			if tt.matchRepository != nil {
+				matchRepository = tt.matchRepository(t)
+			}
+
+			var externalAPIClient *mocks.ExternalAPIClient
+			if tt.externalAPIClient != nil {
+				externalAPIClient = tt.externalAPIClient(t)
+			}
+
+			var externalMatchRepository *mocks.ExternalMatchRepository
+			if tt.externalMatchRepository != nil {
+				externalMatchRepository = tt.externalMatchRepository(t)
+			}
+
+			var outboxTaskRepository *mocks.OutboxTaskRepository
+			if tt.outboxTaskRepository != nil {
+				outboxTaskRepository = tt.outboxTaskRepository(t)
+			}
+
+			var outboxDispatcher *mocks.OutboxDispatcher
+			if tt.outboxDispatcher != nil {
+				outboxDispatcher = tt.outboxDispatcher(t)
+			}
+
+			var taskClient *mocks.TaskClient
+			if tt.taskClient != nil {
+				taskClient = tt.taskClient(t)
+			}
+
+			var subscriptionRepository *mocks.SubscriptionRepository
+			if tt.subscriptionRepository != nil {
+				subscriptionRepository = tt.subscriptionRepository(t)
+			}
+
+			resultCheckAttemptRepository := mocks.NewResultCheckAttemptRepository(t)
+			if tt.resultCheckAttemptRepository != nil {
+				resultCheckAttemptRepository = tt.resultCheckAttemptRepository(t)
+			} else {
+				resultCheckAttemptRepository.On("Create", ctx, mock.Anything).Return(&models.ResultCheckAttempt{}, nil).Maybe()
+			}
+
+			eventPublisher := mocks.NewEventPublisher(t)
+			if tt.eventPublisher != nil {
+				eventPublisher = tt.eventPublisher(t)
+			} else {
+				eventPublisher.On("Publish", ctx, mock.Anything).Return(nil).Maybe()
+			}
+
+			resultEventRepository := mocks.NewResultEventRepository(t)
+			if tt.resultEventRepository != nil {
+				resultEventRepository = tt.resultEventRepository(t)
+			} else {
+				resultEventRepository.On("Create", ctx, mock.Anything).Return(&models.ResultEvent{}, nil).Maybe()
+			}
+
+			matchUpdateRepository := mocks.NewMatchUpdateRepository(t)
+			if tt.matchUpdateRepository != nil {
+				matchUpdateRepository = tt.matchUpdateRepository(t)
+			} else {
+				matchUpdateRepository.On("Create", ctx, mock.Anything).Return(&models.MatchUpdate{}, nil).Maybe()
+			}
+
+			logger := loggerinternal.SetupLogger()
+
+			cfg := config.ResultCheck{
+				MaxRetries:        0,
+				Interval:          pollingInterval,
+				FirstAttemptDelay: pollingFirstAttemptDelay,
+				CorrectionDelay:   correctionDelay,
+			}
+
+			rcs := match.NewResultCheckerService(
+				cfg,
+				passThroughUnitOfWork(t),
+				matchRepository,
+				externalMatchRepository,
+				subscriptionRepository,
+				outboxTaskRepository,
+				resultCheckAttemptRepository,
+				resultEventRepository,
+				matchUpdateRepository,
+				taskClient,
+				externalAPIClient,
+				outboxDispatcher,
+				eventPublisher,
+				logger,
+			)
+
+			err := rcs.CheckResult(ctx, tt.input)
+			if tt.expectedErr != nil {
+				assert.ErrorContains(t, err, tt.expectedErr.Error())
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func matchEvent(matchID uint, eventType models.NotificationEventType, key string, home, away int, startsAt time.Time) models.MatchEvent {
+	return models.MatchEvent{MatchID: matchID, Type: eventType, Key: key, HomeScore: home, AwayScore: away, StartsAt: startsAt}
+}
+
+func transitionTo(matchID uint, status models.ResultStatus) any {
+	return mock.MatchedBy(func(actual models.MatchStatusTransition) bool {
+		return actual.MatchID == matchID && actual.To == status
+	})
+}
+
+func TestResultCheckerService_CheckLive(t *testing.T) {
+	liveInterval := 2 * time.Minute
+	liveMaxChecks := uint(3)
+
+	ctx := context.Background()
+	unexpectedErr := errors.New("unexpected error")
+	matchID := uint(gofakeit.Uint8())
+	startsAt := time.Now().Add(-time.Hour)
+	externalMatchID := uint(gofakeit.Uint32())
+
+	scheduledMatch := testutils.FakeMatch(func(r *models.Match) {
+		r.ID = matchID
+		r.ResultStatus = models.Scheduled
+		r.StartsAt = startsAt
+		r.ExternalMatch = &models.ExternalMatch{ID: externalMatchID, MatchID: matchID}
+	})
+
+	inPlay := testutils.FakeExternalAPIMatch(func(r *models.ExternalAPIMatch) {
+		r.ID = externalMatchID
+		r.Time = startsAt
+		r.Status = models.StatusMatchInProgress
+		r.HomeScore = 1
+		r.AwayScore = 0
+	})
+
+	finished := inPlay
+	finished.Status = models.StatusMatchFinished
+
+	postponed := inPlay
+	postponed.Time = startsAt.Add(3 * time.Hour)
+	postponed.Status = models.StatusMatchNotStarted
+	postponed.HomeScore, postponed.AwayScore = 0, 0
+
+	remindBefore := uint(15)
+	remindedSubscription := testutils.FakeSubscription(func(r *models.Subscription) {
+		r.MatchID = matchID
+		r.RemindBefore = &remindBefore
+		r.Status = models.PendingSub
+	})
+
+	type input struct {
+		matchID  uint
+		sequence uint
+	}
+
+	tests := []struct {
+		name                    string
+		input                   input
+		expectedErr             error
+		matchRepository         func(t *testing.T) *mocks.MatchRepository
+		externalMatchRepository func(t *testing.T) *mocks.ExternalMatchRepository
+		externalAPIClient       func(t *testing.T) *mocks.ExternalAPIClient
+		taskClient              func(t *testing.T) *mocks.TaskClient
+		subscriptionRepository  func(t *testing.T) *mocks.SubscriptionRepository
+		eventPublisher          func(t *testing.T) *mocks.EventPublisher
+		matchUpdateRepository   func(t *testing.T) *mocks.MatchUpdateRepository
+	}{
+		{
+			name:  "success - it stops live tracking when match is deleted",
+			input: input{matchID: matchID, sequence: 1},
+			matchRepository: func(t *testing.T) *mocks.MatchRepository {
+				t.Helper()
+				m := mocks.NewMatchRepository(t)
+				m.On("One", ctx, models.Match{ID: matchID}).Return(nil, models.NewResourceNotFoundError(errors.New("not found"))).Once()
+				return m
+			},
+		},
+		{
+			name:  "success - it stops live tracking when match result is not scheduled",
+			input: input{matchID: matchID, sequence: 1},
+			matchRepository: func(t *testing.T) *mocks.MatchRepository {
+				t.Helper()
+				m := mocks.NewMatchRepository(t)
+				receivedMatch := scheduledMatch
+				receivedMatch.ResultStatus = models.Received
+				m.On("One", ctx, models.Match{ID: matchID}).Return(&receivedMatch, nil).Once()
+				return m
+			},
+		},
+		{
+			name:  "it returns an error when matches retrieval from external api fails",
+			input: input{matchID: matchID, sequence: 1},
+			matchRepository: func(t *testing.T) *mocks.MatchRepository {
+				t.Helper()
+				m := mocks.NewMatchRepository(t)
+				m.On("One", ctx, models.Match{ID: matchID}).Return(&scheduledMatch, nil).Once()
+				return m
+			},
+			externalAPIClient: func(t *testing.T) *mocks.ExternalAPIClient {
+				t.Helper()
+				m := mocks.NewExternalAPIClient(t)
+				m.On("GetMatches", ctx, startsAt).Return(nil, unexpectedErr).Once()
+				return m
+			},
+			expectedErr: fmt.Errorf("failed to get matches from external api: %w", unexpectedErr),
+		},
+		{
+			name:  "success - it stops live tracking when external match is not found",
+			input: input{matchID: matchID, sequence: 1},
+			matchRepository: func(t *testing.T) *mocks.MatchRepository {
+				t.Helper()
+				m := mocks.NewMatchRepository(t)
+				m.On("One", ctx, models.Match{ID: matchID}).Return(&scheduledMatch, nil).Once()
+				return m
+			},
+			externalAPIClient: func(t *testing.T) *mocks.ExternalAPIClient {
+				t.Helper()
+				m := mocks.NewExternalAPIClient(t)
+				m.On("GetMatches", ctx, startsAt).Return([]models.ExternalAPIMatch{}, nil).Once()
+				return m
+			},
+		},
+		{
+			name:  "success - it publishes observed events and schedules the next live check",
+			input: input{matchID: matchID, sequence: 1},
+			matchRepository: func(t *testing.T) *mocks.MatchRepository {
+				t.Helper()
+				m := mocks.NewMatchRepository(t)
+				m.On("One", ctx, models.Match{ID: matchID}).Return(&scheduledMatch, nil).Once()
+				return m
+			},
+			externalAPIClient: func(t *testing.T) *mocks.ExternalAPIClient {
+				t.Helper()
+				m := mocks.NewExternalAPIClient(t)
+				m.On("GetMatches", ctx, startsAt).Return([]models.ExternalAPIMatch{inPlay}, nil).Once()
+				return m
+			},
+			eventPublisher: func(t *testing.T) *mocks.EventPublisher {
+				t.Helper()
+				m := mocks.NewEventPublisher(t)
+				m.On("Publish", ctx, matchEvent(matchID, models.EventMatchKickoff, "kickoff", 0, 0, startsAt)).Return(nil).Once()
+				m.On("Publish", ctx, matchEvent(matchID, models.EventMatchGoal, "goal-1-0", 1, 0, startsAt)).Return(nil).Once()
+				return m
+			},
+			matchUpdateRepository: func(t *testing.T) *mocks.MatchUpdateRepository {
+				t.Helper()
+				m := mocks.NewMatchUpdateRepository(t)
+				m.On("Create", ctx, models.MatchUpdate{MatchID: matchID, Status: models.StatusMatchInProgress, HomeScore: 1, AwayScore: 0}).Return(&models.MatchUpdate{}, nil).Once()
+				return m
+			},
+			externalMatchRepository: func(t *testing.T) *mocks.ExternalMatchRepository {
+				t.Helper()
+				m := mocks.NewExternalMatchRepository(t)
+				m.On("Save", ctx, &externalMatchID, inPlay.ToExternalMatch(matchID)).Return(&models.ExternalMatch{}, nil).Once()
+				return m
+			},
+			taskClient: func(t *testing.T) *mocks.TaskClient {
+				t.Helper()
+				m := mocks.NewTaskClient(t)
+				m.On("ScheduleLiveCheck", ctx, matchID, uint(2), mock.MatchedBy(func(scheduleAt time.Time) bool {
+					return scheduleAt.After(time.Now()) && scheduleAt.Before(time.Now().Add(liveInterval))
+				})).Return(nil).Once()
+				return m
+			},
+		},
+		{
+			name:  "success - it doesn't record match update when status and score are not changed",
+			input: input{matchID: matchID, sequence: 1},
+			matchRepository: func(t *testing.T) *mocks.MatchRepository {
+				t.Helper()
+				m := mocks.NewMatchRepository(t)
+				observedMatch := scheduledMatch
+				observedMatch.ExternalMatch = &models.ExternalMatch{ID: externalMatchID, MatchID: matchID, Status: models.StatusMatchInProgress, HomeScore: 1}
+				m.On("One", ctx, models.Match{ID: matchID}).Return(&observedMatch, nil).Once()
+				return m
+			},
+			externalAPIClient: func(t *testing.T) *mocks.ExternalAPIClient {
+				t.Helper()
+				m := mocks.NewExternalAPIClient(t)
+				m.On("GetMatches", ctx, startsAt).Return([]models.ExternalAPIMatch{inPlay}, nil).Once()
+				return m
+			},
+			matchUpdateRepository: func(t *testing.T) *mocks.MatchUpdateRepository {
+				t.Helper()
+				return mocks.NewMatchUpdateRepository(t)
+			},
+			externalMatchRepository: func(t *testing.T) *mocks.ExternalMatchRepository {
+				t.Helper()
+				m := mocks.NewExternalMatchRepository(t)
+				m.On("Save", ctx, &externalMatchID, inPlay.ToExternalMatch(matchID)).Return(&models.ExternalMatch{}, nil).Once()
+				return m
+			},
+			taskClient: func(t *testing.T) *mocks.TaskClient {
+				t.Helper()
+				m := mocks.NewTaskClient(t)
+				m.On("ScheduleLiveCheck", ctx, matchID, uint(2), mock.Anything).Return(nil).Once()
+				return m
+			},
+		},
+		{
+			name:  "it returns an error when the next live check scheduling fails",
+			input: input{matchID: matchID, sequence: 1},
+			matchRepository: func(t *testing.T) *mocks.MatchRepository {
+				t.Helper()
+				m := mocks.NewMatchRepository(t)
+				m.On("One", ctx, models.Match{ID: matchID}).Return(&scheduledMatch, nil).Once()
+				return m
+			},
+			externalAPIClient: func(t *testing.T) *mocks.ExternalAPIClient {
+				t.Helper()
+				m := mocks.NewExternalAPIClient(t)
+				m.On("GetMatches", ctx, startsAt).Return([]models.ExternalAPIMatch{inPlay}, nil).Once()
+				return m
+			},
+			externalMatchRepository: func(t *testing.T) *mocks.ExternalMatchRepository {
+				t.Helper()
+				m := mocks.NewExternalMatchRepository(t)
+				m.On("Save", ctx, &externalMatchID, inPlay.ToExternalMatch(matchID)).Return(&models.ExternalMatch{}, nil).Once()
+				return m
+			},
+			taskClient: func(t *testing.T) *mocks.TaskClient {
+				t.Helper()
+				m := mocks.NewTaskClient(t)
+				m.On("ScheduleLiveCheck", ctx, matchID, uint(2), mock.Anything).Return(unexpectedErr).Once()
+				return m
+			},
+			expectedErr: fmt.Errorf("failed to schedule live check: %w", unexpectedErr),
+		},
+		{
+			name:  "success - it stops live tracking when max number of live checks is reached",
+			input: input{matchID: matchID, sequence: liveMaxChecks},
+			matchRepository: func(t *testing.T) *mocks.MatchRepository {
+				t.Helper()
+				m := mocks.NewMatchRepository(t)
+				m.On("One", ctx, models.Match{ID: matchID}).Return(&scheduledMatch, nil).Once()
+				return m
+			},
+			externalAPIClient: func(t *testing.T) *mocks.ExternalAPIClient {
+				t.Helper()
+				m := mocks.NewExternalAPIClient(t)
+				m.On("GetMatches", ctx, startsAt).Return([]models.ExternalAPIMatch{inPlay}, nil).Once()
+				return m
+			},
+			externalMatchRepository: func(t *testing.T) *mocks.ExternalMatchRepository {
+				t.Helper()
+				m := mocks.NewExternalMatchRepository(t)
+				m.On("Save", ctx, &externalMatchID, inPlay.ToExternalMatch(matchID)).Return(&models.ExternalMatch{}, nil).Once()
+				return m
+			},
+		},
+		{
+			name:  "success - it stops live tracking when match is finished",
+			input: input{matchID: matchID, sequence: 1},
+			matchRepository: func(t *testing.T) *mocks.MatchRepository {
+				t.Helper()
+				m := mocks.NewMatchRepository(t)
+				m.On("One", ctx, models.Match{ID: matchID}).Return(&scheduledMatch, nil).Once()
+				return m
+			},
+			externalAPIClient: func(t *testing.T) *mocks.ExternalAPIClient {
+				t.Helper()
+				m := mocks.NewExternalAPIClient(t)
+				m.On("GetMatches", ctx, startsAt).Return([]models.ExternalAPIMatch{finished}, nil).Once()
+				return m
+			},
+			externalMatchRepository: func(t *testing.T) *mocks.ExternalMatchRepository {
+				t.Helper()
+				m := mocks.NewExternalMatchRepository(t)
+				m.On("Save", ctx, &externalMatchID, finished.ToExternalMatch(matchID)).Return(&models.ExternalMatch{}, nil).Once()
+				return m
+			},
+		},
+		{
+			name:  "success - it saves the kickoff and reschedules kickoff reminders when kickoff has moved",
+			input: input{matchID: matchID, sequence: 1},
+			matchRepository: func(t *testing.T) *mocks.MatchRepository {
+				t.Helper()
+				m := mocks.NewMatchRepository(t)
+				m.On("One", ctx, models.Match{ID: matchID}).Return(&scheduledMatch, nil).Once()
+				m.On("UpdateStartsAt", ctx, matchID, postponed.Time).Return(nil).Once()
+				return m
+			},
+			externalAPIClient: func(t *testing.T) *mocks.ExternalAPIClient {
+				t.Helper()
+				m := mocks.NewExternalAPIClient(t)
+				m.On("GetMatches", ctx, startsAt).Return([]models.ExternalAPIMatch{postponed}, nil).Once()
+				return m
+			},
+			externalMatchRepository: func(t *testing.T) *mocks.ExternalMatchRepository {
+				t.Helper()
+				m := mocks.NewExternalMatchRepository(t)
+				m.On("Save", ctx, &externalMatchID, postponed.ToExternalMatch(matchID)).Return(&models.ExternalMatch{}, nil).Once()
+				return m
+			},
+			subscriptionRepository: func(t *testing.T) *mocks.SubscriptionRepository {
+				t.Helper()
+				m := mocks.NewSubscriptionRepository(t)
+				m.On("ListWithReminderByMatch", ctx, matchID).Return([]models.Subscription{remindedSubscription}, nil).Once()
+				return m
+			},
+			taskClient: func(t *testing.T) *mocks.TaskClient {
+				t.Helper()
+				m := mocks.NewTaskClient(t)
+				m.On("ScheduleKickoffReminder", ctx, remindedSubscription.ID, postponed.Time, postponed.Time.Add(-15*time.Minute)).Return(unexpectedErr).Once()
+				m.On("ScheduleLiveCheck", ctx, matchID, uint(2), mock.Anything).Return(nil).Once()
+				return m
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var matchRepository *mocks.MatchRepository
+			if tt.matchRepository != nil {
+				matchRepository = tt.matchRepository(t)
+			}
+
+			var externalMatchRepository *mocks.ExternalMatchRepository
+			if tt.externalMatchRepository != nil {
+				externalMatchRepository = tt.externalMatchRepository(t)
+			}
+
+			var externalAPIClient *mocks.ExternalAPIClient
+			if tt.externalAPIClient != nil {
+				externalAPIClient = tt.externalAPIClient(t)
+			}
+
+			var taskClient *mocks.TaskClient
+			if tt.taskClient != nil {
+				taskClient = tt.taskClient(t)
+			}
+
+			var subscriptionRepository *mocks.SubscriptionRepository
+			if tt.subscriptionRepository != nil {
+				subscriptionRepository = tt.subscriptionRepository(t)
+			}
+
+			eventPublisher := mocks.NewEventPublisher(t)
+			if tt.eventPublisher != nil {
+				eventPublisher = tt.eventPublisher(t)
+			} else {
+				eventPublisher.On("Publish", ctx, mock.Anything).Return(nil).Maybe()
+			}
+
+			matchUpdateRepository := mocks.NewMatchUpdateRepository(t)
+			if tt.matchUpdateRepository != nil {
+				matchUpdateRepository = tt.matchUpdateRepository(t)
+			} else {
+				matchUpdateRepository.On("Create", ctx, mock.Anything).Return(&models.MatchUpdate{}, nil).Maybe()
+			}
+
+			cfg := config.ResultCheck{
+				LiveInterval:  liveInterval,
+				LiveMaxChecks: liveMaxChecks,
+			}
+
+			rcs := match.NewResultCheckerService(
+				cfg,
+				passThroughUnitOfWork(t),
+				matchRepository,
+				externalMatchRepository,
+				subscriptionRepository,
+				nil,
+				nil,
+				nil,
+				matchUpdateRepository,
+				taskClient,
+				externalAPIClient,
+				nil,
+				eventPublisher,
+				loggerinternal.SetupLogger(),
+			)
+
+			err := rcs.CheckLive(ctx, tt.input.matchID, tt.input.sequence)
 			if tt.expectedErr != nil {
 				assert.ErrorContains(t, err, tt.expectedErr.Error())
 			} else {
@@ -0,0 +1,65 @@
+package match
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/andrewshostak/result-service/config"
+	"github.com/andrewshostak/result-service/internal/app/models"
+)
+
+// ResultFeedService serves the feed of result events to consumers which can't receive webhooks.
+// Consumers pull the events after their cursor, a pull without new events is held until they appear (long polling).
+type ResultFeedService struct {
+	config                config.ResultFeed
+	resultEventRepository ResultEventRepository
+}
+
+func NewResultFeedService(config config.ResultFeed, resultEventRepository ResultEventRepository) *ResultFeedService {
+	return &ResultFeedService{
+		config:                config,
+		resultEventRepository: resultEventRepository,
+	}
+}
+
+// List returns the events after the cursor. When there are none, it waits for them up to the requested wait
+// (limited by the max wait) and returns an empty page when the wait is over.
+func (s *ResultFeedService) List(ctx context.Context, request models.ListResultEventsRequest) (*models.ResultEventsPage, error) {
+	filter := models.ResultEventFilter{AfterID: request.Since, Limit: request.Limit}
+
+	wait := min(request.Wait, s.config.MaxWait)
+	deadline := time.NewTimer(wait)
+	defer deadline.Stop()
+
+	ticker := time.NewTicker(s.config.PollInterval)
+	defer ticker.Stop()
+
+	for {
+		events, err := s.resultEventRepository.List(ctx, filter)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list result events: %w", err)
+		}
+
+		if len(events) > 0 || wait <= 0 {
+			return s.page(request.Since, events), nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return s.page(request.Since, events), nil
+		case <-deadline.C:
+			return s.page(request.Since, events), nil
+		case <-ticker.C:
+		}
+	}
+}
+
+func (s *ResultFeedService) page(since uint, events []models.ResultEvent) *models.ResultEventsPage {
+	page := models.ResultEventsPage{Events: events, NextCursor: since}
+	if len(events) > 0 {
+		page.NextCursor = events[len(events)-1].ID
+	}
+
+	return &page
+}
@@ -0,0 +1,109 @@
+package match_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/andrewshostak/result-service/config"
+	"github.com/andrewshostak/result-service/internal/app/match"
+	"github.com/andrewshostak/result-service/internal/app/match/mocks"
+	"github.com/andrewshostak/result-service/internal/app/models"
+	"github.com/brianvoe/gofakeit/v6"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestResultFeedService_List(t *testing.T) {
+	ctx := context.Background()
+	unexpectedErr := errors.New("unexpected error")
+
+	cfg := config.ResultFeed{MaxWait: 50 * time.Millisecond, PollInterval: 10 * time.Millisecond}
+
+	since := uint(gofakeit.Uint16())
+	filter := models.ResultEventFilter{AfterID: since, Limit: 100}
+	events := []models.ResultEvent{
+		{ID: since + 1, MatchID: uint(gofakeit.Uint8()), Type: models.EventResultFinished, HomeScore: 2, AwayScore: 1},
+		{ID: since + 3, MatchID: uint(gofakeit.Uint8()), Type: models.EventMatchCancelled},
+	}
+
+	tests := []struct {
+		name                  string
+		input                 models.ListResultEventsRequest
+		resultEventRepository func(t *testing.T) *mocks.ResultEventRepository
+		expected              *models.ResultEventsPage
+		expectedErr           error
+	}{
+		{
+			name:  "it returns an error when result events listing fails",
+			input: models.ListResultEventsRequest{Since: since, Limit: 100},
+			resultEventRepository: func(t *testing.T) *mocks.ResultEventRepository {
+				t.Helper()
+				m := mocks.NewResultEventRepository(t)
+				m.On("List", ctx, filter).Return(nil, unexpectedErr).Once()
+				return m
+			},
+			expectedErr: fmt.Errorf("failed to list result events: %w", unexpectedErr),
+		},
+		{
+			name:  "success - it returns events after the cursor and the id of the last one as the next cursor",
+			input: models.ListResultEventsRequest{Since: since, Limit: 100, Wait: time.Minute},
+			resultEventRepository: func(t *testing.T) *mocks.ResultEventRepository {
+				t.Helper()
+				m := mocks.NewResultEventRepository(t)
+				m.On("List", ctx, filter).Return(events, nil).Once()
+				return m
+			},
+			expected: &models.ResultEventsPage{Events: events, NextCursor: since + 3},
+		},
+		{
+			name:  "success - it returns an empty page without waiting when wait is not requested",
+			input: models.ListResultEventsRequest{Since: since, Limit: 100},
+			resultEventRepository: func(t *testing.T) *mocks.ResultEventRepository {
+				t.Helper()
+				m := mocks.NewResultEventRepository(t)
+				m.On("List", ctx, filter).Return([]models.ResultEvent{}, nil).Once()
+				return m
+			},
+			expected: &models.ResultEventsPage{Events: []models.ResultEvent{}, NextCursor: since},
+		},
+		{
+			name:  "success - it waits for events when there are none after the cursor",
+			input: models.ListResultEventsRequest{Since: since, Limit: 100, Wait: time.Minute},
+			resultEventRepository: func(t *testing.T) *mocks.ResultEventRepository {
+				t.Helper()
+				m := mocks.NewResultEventRepository(t)
+				m.On("List", ctx, filter).Return([]models.ResultEvent{}, nil).Once()
+				m.On("List", ctx, filter).Return(events[:1], nil).Once()
+				return m
+			},
+			expected: &models.ResultEventsPage{Events: events[:1], NextCursor: since + 1},
+		},
+		{
+			name:  "success - it returns an empty page when no events appear within the max wait",
+			input: models.ListResultEventsRequest{Since: since, Limit: 100, Wait: time.Minute},
+			resultEventRepository: func(t *testing.T) *mocks.ResultEventRepository {
+				t.Helper()
+				m := mocks.NewResultEventRepository(t)
+				m.On("List", ctx, filter).Return([]models.ResultEvent{}, nil)
+				return m
+			},
+			expected: &models.ResultEventsPage{Events: []models.ResultEvent{}, NextCursor: since},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rfs := match.NewResultFeedService(cfg, tt.resultEventRepository(t))
+
+			actual, err := rfs.List(ctx, tt.input)
+			if tt.expectedErr != nil {
+				assert.EqualError(t, err, tt.expectedErr.Error())
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, tt.expected, actual)
+		})
+	}
+}
@@ -1,5 +1,10 @@
 package models
 
+import "errors"
+
+// ErrDestinationNotAllowed is returned when a notification destination resolves to an address the service doesn't connect to.
+var ErrDestinationNotAllowed = errors.New("destination address is not allowed")
+
 type Code string
 
 const (
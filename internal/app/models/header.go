@@ -0,0 +1,48 @@
+package models
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"unicode"
+)
+
+// IsHeaderName reports whether the name is an http token, so it can be sent as a header name.
+func IsHeaderName(name string) bool {
+	if name == "" {
+		return false
+	}
+
+	for _, r := range name {
+		if r > unicode.MaxASCII || !(unicode.IsLetter(r) || unicode.IsDigit(r) || strings.ContainsRune("!#$%&'*+-.^_`|~", r)) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// ValidateAuthHeader checks that the key can be sent in the auth header: the scheme precedes the key, so it is given with the header only.
+func ValidateAuthHeader(header *string, scheme *string, key string) error {
+	if header == nil {
+		if scheme != nil {
+			return errors.New("auth scheme requires auth header")
+		}
+
+		return nil
+	}
+
+	if !IsHeaderName(*header) {
+		return fmt.Errorf("auth header %q is not valid", *header)
+	}
+
+	if scheme != nil && !IsHeaderName(*scheme) {
+		return fmt.Errorf("auth scheme %q is not valid", *scheme)
+	}
+
+	if strings.ContainsAny(key, "\r\n") {
+		return errors.New("secret key sent in auth header must not contain line breaks")
+	}
+
+	return nil
+}
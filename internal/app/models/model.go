@@ -11,9 +11,53 @@ type CreateMatchRequest struct {
 }
 
 type CreateSubscriptionRequest struct {
-	MatchID   uint
-	URL       string
-	SecretKey string
+	MatchID        uint
+	URL            string
+	SecretKey      string
+	PayloadVersion PayloadVersion
+	DeliveryFormat DeliveryFormat
+	EventTypes     []NotificationEventType
+	Channel        NotificationChannel
+	HTTPMethod     string            // webhook channel only
+	Headers        map[string]string // webhook channel only
+	AuthHeader     *string           // webhook channel only, header the secret key is sent in
+	AuthScheme     *string           // webhook channel only, scheme preceding the secret key in the auth header
+	BatchURL       *string           // webhook channel only, results are delivered in batches when set
+	RemindBefore   *uint             // minutes before the kickoff the subscriber is reminded about it
+	Verify         bool              // webhook channel only, the endpoint has to echo a challenge before it is notified
+}
+
+// TestDeliveryRequest describes a destination which receives a sample notification without a subscription.
+type TestDeliveryRequest struct {
+	URL            string
+	SecretKey      string
+	PayloadVersion PayloadVersion
+	DeliveryFormat DeliveryFormat
+	Channel        NotificationChannel
+	HTTPMethod     string            // webhook channel only
+	Headers        map[string]string // webhook channel only
+	AuthHeader     *string           // webhook channel only
+	AuthScheme     *string           // webhook channel only
+}
+
+type CreateStandingSubscriptionRequest struct {
+	Alias          *string // team alias, set when the team is followed
+	League         *League // set when the competition is followed
+	URLTemplate    string
+	SecretKey      string
+	PayloadVersion PayloadVersion
+	DeliveryFormat DeliveryFormat
+	AuthHeader     *string // header the secret key is sent in by the planned subscriptions
+	AuthScheme     *string
+}
+
+type SubscriptionFilter struct {
+	MatchID             *uint
+	NotificationBatchID *uint
+	Status              *SubscriptionStatus
+	URL                 string // exact url
+	URLPrefix           string
+	Host                string // host of the http url, case-insensitive
 }
 
 type DeleteSubscriptionRequest struct {
@@ -24,6 +68,16 @@ type DeleteSubscriptionRequest struct {
 	SecretKey string
 }
 
+type RotateSecretKeyRequest struct {
+	SecretKey    string
+	NewSecretKey string
+}
+
+type RedeliverRequest struct {
+	SubscriptionID uint
+	Force          bool // allows to redeliver to already successfully notified subscriber
+}
+
 type ExternalTeam struct {
 	ID     uint
 	TeamID uint
@@ -49,6 +103,14 @@ type Match struct {
 
 	ExternalMatch   *ExternalMatch
 	CheckResultTask *CheckResultTask
+	StatusHistory   []MatchStatusTransition
+	HomeTeam        *Team
+	AwayTeam        *Team
+}
+
+type Team struct {
+	ID      uint
+	Aliases []string
 }
 
 type Alias struct {
@@ -58,6 +120,16 @@ type Alias struct {
 	ExternalTeam *ExternalTeam
 }
 
+// SubscriberHost is the delivery health of a subscriber host, fed by notification outcomes of all its subscriptions.
+type SubscriberHost struct {
+	Host                string
+	ConsecutiveFailures uint
+	LastError           *string
+	LastFailureAt       *time.Time
+	LastSuccessAt       *time.Time
+	SuspendedAt         *time.Time // deliveries to the host are suspended until it is enabled
+}
+
 type SubscriptionStatus string
 
 const (
@@ -65,21 +137,63 @@ const (
 	SchedulingErrorSub SubscriptionStatus = "scheduling_error"
 	SuccessfulSub      SubscriptionStatus = "successful"
 	SubscriberErrorSub SubscriptionStatus = "subscriber_error"
+	MatchCancelledSub  SubscriptionStatus = "match_cancelled"
+	MatchFailedSub     SubscriptionStatus = "match_failed"
+	DeadLetterSub      SubscriptionStatus = "dead_letter"
+	UnverifiedSub      SubscriptionStatus = "unverified"
+	SuspendedSub       SubscriptionStatus = "suspended"
+	UnsubscribedSub    SubscriptionStatus = "unsubscribed"
 )
 
 type Subscription struct {
-	ID              uint
-	Url             string
-	MatchID         uint
-	Key             string
-	CreatedAt       time.Time
-	Status          SubscriptionStatus
-	NotifiedAt      *time.Time
-	SubscriberError *string
+	ID                  uint
+	Url                 string
+	MatchID             uint
+	Key                 string
+	PreviousKey         *string
+	PreviousKeyTill     *time.Time
+	PayloadVersion      PayloadVersion
+	DeliveryFormat      DeliveryFormat
+	EventTypes          []NotificationEventType
+	Channel             NotificationChannel
+	HTTPMethod          string
+	Headers             map[string]string
+	AuthHeader          *string // header the key is sent in, the key is only used for signing when nil
+	AuthScheme          *string // scheme preceding the key in the auth header, e.g. Bearer
+	BatchURL            *string
+	NotificationBatchID *uint  // batch the result notification joined, it is delivered individually afterward
+	RemindBefore        *uint  // minutes before the kickoff the subscriber is reminded about it, no reminder when nil
+	DeliveryID          string // id of the result notification, every attempt of it carries the same id
+	CreatedAt           time.Time
+	Status              SubscriptionStatus
+	NotifiedAt          *time.Time
+	SubscriberError     *string
+	DeliveryAttempts    uint // failed attempts of the current delivery
 
 	Match *Match
 }
 
+// RemindAt returns the time the subscriber is reminded about the kickoff. It is used for subscriptions with a reminder only.
+func (s Subscription) RemindAt(kickoff time.Time) time.Time {
+	return kickoff.Add(-time.Duration(*s.RemindBefore) * time.Minute)
+}
+
+type NotificationBatchStatus string
+
+const (
+	BatchOpen   NotificationBatchStatus = "open"
+	BatchClosed NotificationBatchStatus = "closed"
+)
+
+// NotificationBatch collects result notifications of subscriptions sharing the batch url and the secret key.
+// Subscriptions join the open batch until it is closed for the delivery.
+type NotificationBatch struct {
+	ID        uint
+	BatchURL  string
+	Status    NotificationBatchStatus
+	DeliverAt time.Time
+}
+
 type ExternalMatchStatus string
 
 const (
@@ -90,12 +204,22 @@ const (
 	StatusMatchUnknown    ExternalMatchStatus = "unknown"
 )
 
+// FinishType describes how a finished match was decided.
+type FinishType string
+
+const (
+	FinishRegularTime FinishType = "regular_time"
+	FinishExtraTime   FinishType = "extra_time"
+	FinishPenalties   FinishType = "penalties"
+)
+
 type ExternalMatch struct {
-	ID        uint
-	MatchID   uint
-	HomeScore int
-	AwayScore int
-	Status    ExternalMatchStatus
+	ID         uint
+	MatchID    uint
+	HomeScore  int
+	AwayScore  int
+	Status     ExternalMatchStatus
+	FinishType *FinishType
 }
 
 type CheckResultTask struct {
@@ -106,6 +230,89 @@ type CheckResultTask struct {
 	ExecuteAt     time.Time
 }
 
+type OutboxTaskKind string
+
+const (
+	OutboxKindResultCheck OutboxTaskKind = "result_check"
+)
+
+type OutboxTaskStatus string
+
+const (
+	OutboxPending    OutboxTaskStatus = "pending"
+	OutboxDispatched OutboxTaskStatus = "dispatched"
+)
+
+// OutboxTask is a cloud task that has to be created. It is saved in the same transaction as the state it belongs to
+// and is dispatched to cloud tasks afterward.
+type OutboxTask struct {
+	ID            uint
+	Kind          OutboxTaskKind
+	MatchID       uint
+	AttemptNumber uint
+	ExecuteAt     time.Time
+	Status        OutboxTaskStatus
+	Error         *string
+	CreatedAt     time.Time
+	DispatchedAt  *time.Time
+}
+
+type ResultCheckOutcome string
+
+const (
+	OutcomeRescheduled ResultCheckOutcome = "rescheduled"
+	OutcomeFinished    ResultCheckOutcome = "finished"
+	OutcomeCancelled   ResultCheckOutcome = "cancelled"
+	OutcomeCorrected   ResultCheckOutcome = "corrected" // correction check of the received result found a changed score
+	OutcomeError       ResultCheckOutcome = "error"
+)
+
+type ResultCheckAttempt struct {
+	ID             uint
+	MatchID        uint
+	AttemptNumber  uint
+	ScheduledAt    *time.Time
+	ExecutedAt     time.Time
+	ProviderStatus *ExternalMatchStatus
+	HomeScore      *int
+	AwayScore      *int
+	Outcome        ResultCheckOutcome
+	ErrorMessage   *string
+	Snapshot       []byte
+}
+
+// NotificationResponse describes an exchange with a subscriber endpoint.
+type NotificationResponse struct {
+	RequestPayload []byte
+	StatusCode     *int
+	Body           *string // truncated
+	Latency        time.Duration
+	RetryAfter     *time.Duration
+	Acknowledged   []string // delivery ids of batch items acknowledged by the subscriber
+}
+
+// TestDeliveryResult describes an exchange of a sample notification with a subscriber endpoint.
+type TestDeliveryResult struct {
+	DeliveryID string
+	StatusCode *int
+	Body       *string // truncated
+	Latency    time.Duration
+	Error      *string
+}
+
+type NotificationAttempt struct {
+	ID             uint
+	SubscriptionID uint
+	DeliveryID     string
+	EventType      NotificationEventType
+	ExecutedAt     time.Time
+	RequestPayload []byte
+	ResponseStatus *int
+	ResponseBody   *string
+	Latency        time.Duration
+	ErrorMessage   *string
+}
+
 type League struct {
 	CountryCode string
 	Name        string
@@ -119,13 +326,18 @@ type ExternalAPITeam struct {
 }
 
 type ExternalAPIMatch struct {
-	ID        uint
-	HomeID    uint
-	AwayID    uint
-	HomeScore int
-	AwayScore int
-	Time      time.Time
-	Status    ExternalMatchStatus
+	ID          uint
+	HomeID      uint
+	AwayID      uint
+	HomeScore   int
+	AwayScore   int
+	Time        time.Time
+	Status      ExternalMatchStatus
+	HalfTime    bool // match is at the half-time break
+	FinishType  *FinishType
+	LeagueNames []string
+	CountryCode string
+	Snapshot    []byte
 }
 
 type Task struct {
@@ -133,19 +345,264 @@ type Task struct {
 	ExecuteAt time.Time
 }
 
+type ReconciliationIssue string
+
+const (
+	IssueStuckResultCheck             ReconciliationIssue = "stuck_result_check"
+	IssuePendingNotification          ReconciliationIssue = "pending_notification"
+	IssueNotificationSchedulingError  ReconciliationIssue = "notification_scheduling_error"
+	IssueStaleOutboxTask              ReconciliationIssue = "stale_outbox_task"
+	IssueEventDeliverySchedulingError ReconciliationIssue = "event_delivery_scheduling_error"
+)
+
+type ReconciliationAction string
+
+const (
+	ActionNone                     ReconciliationAction = "none"
+	ActionResultCheckRescheduled   ReconciliationAction = "result_check_rescheduled"
+	ActionNotificationRescheduled  ReconciliationAction = "notification_rescheduled"
+	ActionOutboxTaskDispatched     ReconciliationAction = "outbox_task_dispatched"
+	ActionEventDeliveryRescheduled ReconciliationAction = "event_delivery_rescheduled"
+	ActionFailed                   ReconciliationAction = "failed"
+)
+
+type ReconciliationItem struct {
+	MatchID         uint
+	SubscriptionID  *uint
+	EventDeliveryID *uint
+	Issue           ReconciliationIssue
+	Action          ReconciliationAction
+	Error           *string
+}
+
+// StandingSubscription follows a team or a competition. Subscriptions of its future matches are created by the planner.
+type StandingSubscription struct {
+	ID             uint
+	TeamID         *uint
+	League         *League
+	URLTemplate    string
+	Key            string
+	PayloadVersion PayloadVersion
+	DeliveryFormat DeliveryFormat
+	AuthHeader     *string
+	AuthScheme     *string
+	CreatedAt      time.Time
+
+	ExternalTeam *ExternalTeam
+}
+
+type PlanningReport struct {
+	StartedAt  time.Time
+	FinishedAt time.Time
+	Items      []PlanningItem
+}
+
+// PlanningItem is a fixture followed by a standing subscription.
+type PlanningItem struct {
+	StandingSubscriptionID uint
+	ExternalMatchID        uint
+	MatchID                *uint
+	SubscriptionID         *uint
+	Error                  *string
+}
+
+type ReconciliationReport struct {
+	StartedAt  time.Time
+	FinishedAt time.Time
+	Items      []ReconciliationItem
+}
+
+// PayloadVersion is a version of the notification body sent to a subscriber.
+type PayloadVersion string
+
+const (
+	PayloadV1 PayloadVersion = "v1" // home and away score only
+	PayloadV2 PayloadVersion = "v2" // match details, score and delivery metadata
+)
+
+type NotificationEventType string
+
+const (
+	EventMatchKickoff     NotificationEventType = "match.kickoff"
+	EventMatchHalfTime    NotificationEventType = "match.half_time"
+	EventMatchGoal        NotificationEventType = "match.goal"
+	EventResultFinished   NotificationEventType = "result.finished"
+	EventResultCorrected  NotificationEventType = "result.corrected" // the score of a finished match is corrected by the provider, recorded to the result feed only
+	EventMatchCancelled   NotificationEventType = "match.cancelled"
+	EventMatchRescheduled NotificationEventType = "match.rescheduled"
+	EventMatchReminder    NotificationEventType = "match.reminder" // requested by the reminder interval of the subscription, not by the event types
+)
+
+// IsLive reports whether the event happens while the match is in play, so the match has to be tracked live to observe it.
+func (t NotificationEventType) IsLive() bool {
+	return t == EventMatchKickoff || t == EventMatchHalfTime || t == EventMatchGoal
+}
+
+// MatchEvent is an event observed by the result checker. Key identifies the event within the match,
+// so the same event observed by several checks is stored and delivered only once.
+type MatchEvent struct {
+	ID        uint
+	MatchID   uint
+	Type      NotificationEventType
+	Key       string
+	HomeScore int
+	AwayScore int
+	StartsAt  time.Time // kickoff time of the match when the event is observed
+	CreatedAt time.Time
+}
+
+// ResultEvent is a change of the match result in the feed pulled by consumers. ID orders the events and is the cursor of the feed.
+// The match data is copied to the event, so the event outlives the match.
+type ResultEvent struct {
+	ID         uint
+	MatchID    uint
+	Type       NotificationEventType // result.finished, result.corrected or match.cancelled
+	HomeTeamID uint
+	AwayTeamID uint
+	StartsAt   time.Time
+	HomeScore  int
+	AwayScore  int
+	FinishType *FinishType
+	CreatedAt  time.Time
+}
+
+type ResultEventFilter struct {
+	AfterID uint
+	Limit   uint
+}
+
+// ListResultEventsRequest describes a pull of the result feed. When there are no events after the cursor,
+// the pull waits for them up to Wait.
+type ListResultEventsRequest struct {
+	Since uint // cursor, id of the last event received by the consumer
+	Limit uint
+	Wait  time.Duration
+}
+
+type ResultEventsPage struct {
+	Events     []ResultEvent
+	NextCursor uint // id of the last event of the page, or the requested cursor when the page is empty
+}
+
+// MatchUpdate is a change of the status or the score of the match observed by a result check. ID orders the updates.
+type MatchUpdate struct {
+	ID         uint
+	MatchID    uint
+	Status     ExternalMatchStatus
+	HomeScore  int
+	AwayScore  int
+	ObservedAt time.Time
+}
+
+type MatchUpdateFilter struct {
+	AfterID  uint
+	MatchIDs []uint // all matches when empty
+	Limit    uint
+}
+
+// WatchMatchUpdatesRequest describes a stream of match updates. The stream starts after LastEventID,
+// or with the updates observed after its start when LastEventID is not given.
+type WatchMatchUpdatesRequest struct {
+	MatchIDs    []uint // all matches when empty
+	LastEventID *uint
+}
+
+// EventDelivery is a delivery of a match event to a subscription which has chosen its type.
+type EventDelivery struct {
+	ID               uint
+	MatchEventID     uint
+	SubscriptionID   uint
+	DeliveryID       string // stays the same across retries, so subscribers can deduplicate
+	Status           SubscriptionStatus
+	DeliveryAttempts uint
+	SubscriberError  *string
+	NotifiedAt       *time.Time
+
+	MatchEvent *MatchEvent
+}
+
+// NotificationChannel defines where a subscriber is notified. Url of the subscription is a destination of the channel.
+type NotificationChannel string
+
+const (
+	ChannelWebhook  NotificationChannel = "webhook"  // http request to the url
+	ChannelTelegram NotificationChannel = "telegram" // telegram bot message, url is telegram:<chat_id>
+	ChannelSlack    NotificationChannel = "slack"    // slack incoming webhook url
+	ChannelDiscord  NotificationChannel = "discord"  // discord incoming webhook url
+	ChannelEmail    NotificationChannel = "email"    // email message, url is mailto:<address>
+)
+
+// DeliveryFormat defines how a notification is wrapped when it is sent to a subscriber.
+type DeliveryFormat string
+
+const (
+	FormatWebhook               DeliveryFormat = "webhook"                // payload is sent as is
+	FormatCloudEventsStructured DeliveryFormat = "cloudevents_structured" // CloudEvents 1.0 structured JSON mode
+	FormatCloudEventsBinary     DeliveryFormat = "cloudevents_binary"     // CloudEvents 1.0 binary HTTP mode
+)
+
 type SubscriberNotification struct {
-	Url  string
-	Key  string
-	Home uint
-	Away uint
+	DeliveryID      string
+	EventType       NotificationEventType
+	PayloadVersion  PayloadVersion
+	DeliveryFormat  DeliveryFormat
+	Channel         NotificationChannel
+	HTTPMethod      string
+	Headers         map[string]string
+	AuthHeader      *string
+	AuthScheme      *string
+	Url             string
+	Key             string
+	PreviousKey     *string
+	MatchID         uint
+	ExternalMatchID uint
+	StartsAt        time.Time
+	HomeTeam        Team
+	AwayTeam        Team
+	FinishType      *FinishType
+	Home            uint
+	Away            uint
+	Test            bool // sample notification requested by the integrator, it is marked as such by every channel
+}
+
+// BatchNotification delivers result notifications of several subscriptions in a single request signed with their shared key.
+// The request carries the custom and auth headers shared by the subscriptions.
+type BatchNotification struct {
+	DeliveryID  string
+	Url         string
+	Key         string
+	PreviousKey *string
+	Headers     map[string]string
+	AuthHeader  *string
+	AuthScheme  *string
+	Items       []BatchNotificationItem
+}
+
+type BatchNotificationItem struct {
+	SubscriptionID uint
+	Notification   SubscriberNotification
+}
+
+// EndpointVerification is a handshake proving that the subscriber controls the endpoint: the endpoint has to echo the challenge.
+type EndpointVerification struct {
+	SubscriptionID uint
+	DeliveryID     string
+	Challenge      string
+	HTTPMethod     string
+	Headers        map[string]string
+	AuthHeader     *string
+	AuthScheme     *string
+	Url            string
+	Key            string
 }
 
 func (m *ExternalAPIMatch) ToExternalMatch(matchID uint) ExternalMatch {
 	return ExternalMatch{
-		ID:        m.ID,
-		MatchID:   matchID,
-		HomeScore: m.HomeScore,
-		AwayScore: m.AwayScore,
-		Status:    m.Status,
+		ID:         m.ID,
+		MatchID:    matchID,
+		HomeScore:  m.HomeScore,
+		AwayScore:  m.AwayScore,
+		Status:     m.Status,
+		FinishType: m.FinishType,
 	}
 }
@@ -0,0 +1,54 @@
+package models
+
+import (
+	"fmt"
+	"slices"
+	"time"
+)
+
+// resultStatusTransitions describes allowed changes of a match result status.
+// Statuses that are not present as keys are terminal.
+var resultStatusTransitions = map[ResultStatus][]ResultStatus{
+	NotScheduled: {Scheduled},
+	Scheduled:    {Received, APIError, Cancelled, SchedulingError},
+}
+
+func (s ResultStatus) CanTransitionTo(to ResultStatus) bool {
+	return slices.Contains(resultStatusTransitions[s], to)
+}
+
+func (s ResultStatus) IsTerminal() bool {
+	return len(resultStatusTransitions[s]) == 0
+}
+
+type MatchStatusTransition struct {
+	ID            uint
+	MatchID       uint
+	From          ResultStatus
+	To            ResultStatus
+	Reason        string
+	AttemptNumber *uint
+	CreatedAt     time.Time
+}
+
+// NewMatchStatusTransition validates that the match can be moved to the status and describes the transition.
+// Attempt number of the match result check task is attached when the relation is loaded.
+func NewMatchStatusTransition(match Match, to ResultStatus, reason string) (*MatchStatusTransition, error) {
+	if !match.ResultStatus.CanTransitionTo(to) {
+		return nil, NewUnprocessableContentError(fmt.Errorf("result status transition from %s to %s is not allowed", match.ResultStatus, to))
+	}
+
+	var attemptNumber *uint
+	if match.CheckResultTask != nil {
+		attempt := match.CheckResultTask.AttemptNumber
+		attemptNumber = &attempt
+	}
+
+	return &MatchStatusTransition{
+		MatchID:       match.ID,
+		From:          match.ResultStatus,
+		To:            to,
+		Reason:        reason,
+		AttemptNumber: attemptNumber,
+	}, nil
+}
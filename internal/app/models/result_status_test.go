@@ -0,0 +1,55 @@
+package models_test
+
+import (
+	"testing"
+
+	"github.com/andrewshostak/result-service/internal/app/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestResultStatus_CanTransitionTo(t *testing.T) {
+	tests := []struct {
+		from     models.ResultStatus
+		to       models.ResultStatus
+		expected bool
+	}{
+		{from: models.NotScheduled, to: models.Scheduled, expected: true},
+		{from: models.NotScheduled, to: models.Received, expected: false},
+		{from: models.Scheduled, to: models.Received, expected: true},
+		{from: models.Scheduled, to: models.APIError, expected: true},
+		{from: models.Scheduled, to: models.Cancelled, expected: true},
+		{from: models.Scheduled, to: models.SchedulingError, expected: true},
+		{from: models.Scheduled, to: models.NotScheduled, expected: false},
+		{from: models.Received, to: models.Scheduled, expected: false},
+		{from: models.Cancelled, to: models.Received, expected: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(string(tt.from)+"->"+string(tt.to), func(t *testing.T) {
+			assert.Equal(t, tt.expected, tt.from.CanTransitionTo(tt.to))
+		})
+	}
+}
+
+func TestNewMatchStatusTransition(t *testing.T) {
+	t.Run("it returns an error when transition is not allowed", func(t *testing.T) {
+		_, err := models.NewMatchStatusTransition(models.Match{ID: 1, ResultStatus: models.Received}, models.Scheduled, "reason")
+		assert.ErrorAs(t, err, &models.UnprocessableContentError{})
+	})
+
+	t.Run("success - it attaches attempt number of check result task", func(t *testing.T) {
+		match := models.Match{
+			ID:              1,
+			ResultStatus:    models.Scheduled,
+			CheckResultTask: &models.CheckResultTask{AttemptNumber: 3},
+		}
+
+		transition, err := models.NewMatchStatusTransition(match, models.Received, "external match is finished")
+		require.NoError(t, err)
+		assert.Equal(t, models.Scheduled, transition.From)
+		assert.Equal(t, models.Received, transition.To)
+		require.NotNil(t, transition.AttemptNumber)
+		assert.Equal(t, uint(3), *transition.AttemptNumber)
+	})
+}
@@ -0,0 +1,38 @@
+package planner
+
+import (
+	"context"
+	"time"
+
+	"github.com/andrewshostak/result-service/internal/app/models"
+	"github.com/rs/zerolog"
+)
+
+type StandingSubscriptionRepository interface {
+	Create(ctx context.Context, subscription models.StandingSubscription) (*models.StandingSubscription, error)
+	List(ctx context.Context) ([]models.StandingSubscription, error)
+	Delete(ctx context.Context, id uint) error
+}
+
+type AliasRepository interface {
+	Find(ctx context.Context, alias string) (*models.Alias, error)
+	FindByExternalTeamID(ctx context.Context, externalTeamID uint) (*models.Alias, error)
+}
+
+type ExternalAPIClient interface {
+	GetMatches(ctx context.Context, date time.Time) ([]models.ExternalAPIMatch, error)
+}
+
+type MatchService interface {
+	Create(ctx context.Context, request models.CreateMatchRequest) (uint, error)
+}
+
+type SubscriptionService interface {
+	Create(ctx context.Context, request models.CreateSubscriptionRequest) (uint, error)
+}
+
+type Logger interface {
+	Error() *zerolog.Event
+	Info() *zerolog.Event
+	Debug() *zerolog.Event
+}
@@ -0,0 +1,61 @@
+// Code generated by mockery v2.53.3. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	models "github.com/andrewshostak/result-service/internal/app/models"
+	mock "github.com/stretchr/testify/mock"
+
+	time "time"
+)
+
+// ExternalAPIClient is an autogenerated mock type for the ExternalAPIClient type
+type ExternalAPIClient struct {
+	mock.Mock
+}
+
+// GetMatches provides a mock function with given fields: ctx, date
+func (_m *ExternalAPIClient) GetMatches(ctx context.Context, date time.Time) ([]models.ExternalAPIMatch, error) {
+	ret := _m.Called(ctx, date)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetMatches")
+	}
+
+	var r0 []models.ExternalAPIMatch
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time) ([]models.ExternalAPIMatch, error)); ok {
+		return rf(ctx, date)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time) []models.ExternalAPIMatch); ok {
+		r0 = rf(ctx, date)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.ExternalAPIMatch)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, time.Time) error); ok {
+		r1 = rf(ctx, date)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NewExternalAPIClient creates a new instance of ExternalAPIClient. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewExternalAPIClient(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *ExternalAPIClient {
+	mock := &ExternalAPIClient{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,88 @@
+// Code generated by mockery v2.53.3. DO NOT EDIT.
+
+package mocks
+
+import (
+	mock "github.com/stretchr/testify/mock"
+
+	zerolog "github.com/rs/zerolog"
+)
+
+// Logger is an autogenerated mock type for the Logger type
+type Logger struct {
+	mock.Mock
+}
+
+// Debug provides a mock function with no fields
+func (_m *Logger) Debug() *zerolog.Event {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Debug")
+	}
+
+	var r0 *zerolog.Event
+	if rf, ok := ret.Get(0).(func() *zerolog.Event); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*zerolog.Event)
+		}
+	}
+
+	return r0
+}
+
+// Error provides a mock function with no fields
+func (_m *Logger) Error() *zerolog.Event {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Error")
+	}
+
+	var r0 *zerolog.Event
+	if rf, ok := ret.Get(0).(func() *zerolog.Event); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*zerolog.Event)
+		}
+	}
+
+	return r0
+}
+
+// Info provides a mock function with no fields
+func (_m *Logger) Info() *zerolog.Event {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Info")
+	}
+
+	var r0 *zerolog.Event
+	if rf, ok := ret.Get(0).(func() *zerolog.Event); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*zerolog.Event)
+		}
+	}
+
+	return r0
+}
+
+// NewLogger creates a new instance of Logger. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewLogger(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *Logger {
+	mock := &Logger{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,57 @@
+// Code generated by mockery v2.53.3. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	models "github.com/andrewshostak/result-service/internal/app/models"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MatchService is an autogenerated mock type for the MatchService type
+type MatchService struct {
+	mock.Mock
+}
+
+// Create provides a mock function with given fields: ctx, request
+func (_m *MatchService) Create(ctx context.Context, request models.CreateMatchRequest) (uint, error) {
+	ret := _m.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Create")
+	}
+
+	var r0 uint
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, models.CreateMatchRequest) (uint, error)); ok {
+		return rf(ctx, request)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, models.CreateMatchRequest) uint); ok {
+		r0 = rf(ctx, request)
+	} else {
+		r0 = ret.Get(0).(uint)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, models.CreateMatchRequest) error); ok {
+		r1 = rf(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NewMatchService creates a new instance of MatchService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMatchService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MatchService {
+	mock := &MatchService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,107 @@
+// Code generated by mockery v2.53.3. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	models "github.com/andrewshostak/result-service/internal/app/models"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// StandingSubscriptionRepository is an autogenerated mock type for the StandingSubscriptionRepository type
+type StandingSubscriptionRepository struct {
+	mock.Mock
+}
+
+// Create provides a mock function with given fields: ctx, subscription
+func (_m *StandingSubscriptionRepository) Create(ctx context.Context, subscription models.StandingSubscription) (*models.StandingSubscription, error) {
+	ret := _m.Called(ctx, subscription)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Create")
+	}
+
+	var r0 *models.StandingSubscription
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, models.StandingSubscription) (*models.StandingSubscription, error)); ok {
+		return rf(ctx, subscription)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, models.StandingSubscription) *models.StandingSubscription); ok {
+		r0 = rf(ctx, subscription)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.StandingSubscription)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, models.StandingSubscription) error); ok {
+		r1 = rf(ctx, subscription)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Delete provides a mock function with given fields: ctx, id
+func (_m *StandingSubscriptionRepository) Delete(ctx context.Context, id uint) error {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Delete")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint) error); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// List provides a mock function with given fields: ctx
+func (_m *StandingSubscriptionRepository) List(ctx context.Context) ([]models.StandingSubscription, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for List")
+	}
+
+	var r0 []models.StandingSubscription
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]models.StandingSubscription, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []models.StandingSubscription); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.StandingSubscription)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NewStandingSubscriptionRepository creates a new instance of StandingSubscriptionRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewStandingSubscriptionRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *StandingSubscriptionRepository {
+	mock := &StandingSubscriptionRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,57 @@
+// Code generated by mockery v2.53.3. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	models "github.com/andrewshostak/result-service/internal/app/models"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// SubscriptionService is an autogenerated mock type for the SubscriptionService type
+type SubscriptionService struct {
+	mock.Mock
+}
+
+// Create provides a mock function with given fields: ctx, request
+func (_m *SubscriptionService) Create(ctx context.Context, request models.CreateSubscriptionRequest) (uint, error) {
+	ret := _m.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Create")
+	}
+
+	var r0 uint
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, models.CreateSubscriptionRequest) (uint, error)); ok {
+		return rf(ctx, request)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, models.CreateSubscriptionRequest) uint); ok {
+		r0 = rf(ctx, request)
+	} else {
+		r0 = ret.Get(0).(uint)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, models.CreateSubscriptionRequest) error); ok {
+		r1 = rf(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NewSubscriptionService creates a new instance of SubscriptionService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewSubscriptionService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *SubscriptionService {
+	mock := &SubscriptionService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
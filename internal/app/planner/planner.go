@@ -0,0 +1,189 @@
+package planner
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/andrewshostak/result-service/config"
+	"github.com/andrewshostak/result-service/internal/app/models"
+)
+
+// PlannerService discovers upcoming fixtures of the followed teams and competitions, creates their matches and
+// attaches the subscriptions rendered from the standing subscriptions. Match and subscription creation is idempotent,
+// so the planning can run repeatedly over the same days.
+type PlannerService struct {
+	config                         config.Planner
+	standingSubscriptionRepository StandingSubscriptionRepository
+	aliasRepository                AliasRepository
+	externalAPIClient              ExternalAPIClient
+	matchService                   MatchService
+	subscriptionService            SubscriptionService
+	logger                         Logger
+}
+
+func NewPlannerService(
+	config config.Planner,
+	standingSubscriptionRepository StandingSubscriptionRepository,
+	aliasRepository AliasRepository,
+	externalAPIClient ExternalAPIClient,
+	matchService MatchService,
+	subscriptionService SubscriptionService,
+	logger Logger,
+) *PlannerService {
+	return &PlannerService{
+		config:                         config,
+		standingSubscriptionRepository: standingSubscriptionRepository,
+		aliasRepository:                aliasRepository,
+		externalAPIClient:              externalAPIClient,
+		matchService:                   matchService,
+		subscriptionService:            subscriptionService,
+		logger:                         logger,
+	}
+}
+
+func (s *PlannerService) Plan(ctx context.Context) (*models.PlanningReport, error) {
+	report := models.PlanningReport{StartedAt: time.Now(), Items: []models.PlanningItem{}}
+
+	standingSubscriptions, err := s.standingSubscriptionRepository.List(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list standing subscriptions: %w", err)
+	}
+
+	if len(standingSubscriptions) > 0 {
+		for day := 0; day <= int(s.config.DaysAhead); day++ {
+			date := report.StartedAt.AddDate(0, 0, day).UTC()
+
+			fixtures, err := s.externalAPIClient.GetMatches(ctx, date)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get matches of %s from external api: %w", date.Format(time.DateOnly), err)
+			}
+
+			for _, fixture := range fixtures {
+				if fixture.Status != models.StatusMatchNotStarted || !fixture.Time.After(report.StartedAt) {
+					continue
+				}
+
+				followers := s.followers(standingSubscriptions, fixture)
+				if len(followers) == 0 {
+					continue
+				}
+
+				report.Items = append(report.Items, s.planFixture(ctx, fixture, followers)...)
+			}
+		}
+	}
+
+	report.FinishedAt = time.Now()
+
+	s.logger.Info().Int("number_of_items", len(report.Items)).Msg("fixture planning finished")
+
+	return &report, nil
+}
+
+// planFixture creates the match of the fixture and a subscription of every standing subscription following it.
+// Failures are recorded in the report items, so that one fixture doesn't stop the planning of the others.
+func (s *PlannerService) planFixture(ctx context.Context, fixture models.ExternalAPIMatch, followers []models.StandingSubscription) []models.PlanningItem {
+	items := make([]models.PlanningItem, 0, len(followers))
+
+	aliasHome, aliasAway, matchID, err := s.createMatch(ctx, fixture)
+	if err != nil {
+		s.logger.Error().Err(err).Uint("external_match_id", fixture.ID).Msg("failed to plan fixture")
+
+		for _, follower := range followers {
+			items = append(items, s.item(follower, fixture, nil, err))
+		}
+
+		return items
+	}
+
+	// subscriptions are created without the endpoint verification. A handshake per follower would make the planning
+	// exceed the trigger timeout, and the endpoints of standing subscriptions are the consumers' own
+	for _, follower := range followers {
+		subscriptionID, err := s.subscriptionService.Create(ctx, models.CreateSubscriptionRequest{
+			MatchID:        matchID,
+			URL:            renderURL(follower.URLTemplate, matchID, aliasHome, aliasAway),
+			SecretKey:      follower.Key,
+			PayloadVersion: follower.PayloadVersion,
+			DeliveryFormat: follower.DeliveryFormat,
+			AuthHeader:     follower.AuthHeader,
+			AuthScheme:     follower.AuthScheme,
+			Verify:         false,
+		})
+		if err != nil {
+			err = fmt.Errorf("failed to create subscription: %w", err)
+			s.logger.Error().Err(err).Uint("standing_subscription_id", follower.ID).Uint("match_id", matchID).Msg("failed to plan fixture")
+			items = append(items, s.item(follower, fixture, &matchID, err))
+
+			continue
+		}
+
+		item := s.item(follower, fixture, &matchID, nil)
+		item.SubscriptionID = &subscriptionID
+		items = append(items, item)
+	}
+
+	return items
+}
+
+func (s *PlannerService) createMatch(ctx context.Context, fixture models.ExternalAPIMatch) (string, string, uint, error) {
+	aliasHome, err := s.aliasRepository.FindByExternalTeamID(ctx, fixture.HomeID)
+	if err != nil {
+		return "", "", 0, fmt.Errorf("failed to find home team alias: %w", err)
+	}
+
+	aliasAway, err := s.aliasRepository.FindByExternalTeamID(ctx, fixture.AwayID)
+	if err != nil {
+		return "", "", 0, fmt.Errorf("failed to find away team alias: %w", err)
+	}
+
+	matchID, err := s.matchService.Create(ctx, models.CreateMatchRequest{
+		StartsAt:  fixture.Time,
+		AliasHome: aliasHome.Alias,
+		AliasAway: aliasAway.Alias,
+	})
+	if err != nil {
+		return "", "", 0, fmt.Errorf("failed to create match: %w", err)
+	}
+
+	return aliasHome.Alias, aliasAway.Alias, matchID, nil
+}
+
+func (s *PlannerService) followers(standingSubscriptions []models.StandingSubscription, fixture models.ExternalAPIMatch) []models.StandingSubscription {
+	var followers []models.StandingSubscription
+	for _, standingSubscription := range standingSubscriptions {
+		if s.isFollowed(standingSubscription, fixture) {
+			followers = append(followers, standingSubscription)
+		}
+	}
+
+	return followers
+}
+
+func (s *PlannerService) isFollowed(standingSubscription models.StandingSubscription, fixture models.ExternalAPIMatch) bool {
+	if standingSubscription.ExternalTeam != nil {
+		return standingSubscription.ExternalTeam.ID == fixture.HomeID || standingSubscription.ExternalTeam.ID == fixture.AwayID
+	}
+
+	if standingSubscription.League != nil {
+		return fixture.CountryCode == standingSubscription.League.CountryCode && slices.Contains(fixture.LeagueNames, standingSubscription.League.Name)
+	}
+
+	return false
+}
+
+func (s *PlannerService) item(follower models.StandingSubscription, fixture models.ExternalAPIMatch, matchID *uint, err error) models.PlanningItem {
+	item := models.PlanningItem{
+		StandingSubscriptionID: follower.ID,
+		ExternalMatchID:        fixture.ID,
+		MatchID:                matchID,
+	}
+
+	if err != nil {
+		errMessage := err.Error()
+		item.Error = &errMessage
+	}
+
+	return item
+}
@@ -0,0 +1,315 @@
+package planner_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/andrewshostak/result-service/config"
+	"github.com/andrewshostak/result-service/internal/app/models"
+	"github.com/andrewshostak/result-service/internal/app/planner"
+	"github.com/andrewshostak/result-service/internal/app/planner/mocks"
+	loggerinternal "github.com/andrewshostak/result-service/internal/infra/logger"
+	"github.com/andrewshostak/result-service/testutils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestPlannerService_Plan(t *testing.T) {
+	ctx := context.Background()
+	unexpectedErr := errors.New("unexpected error")
+
+	fixture := testutils.FakeExternalAPIMatch(func(r *models.ExternalAPIMatch) {
+		r.HomeID = 100
+		r.AwayID = 200
+		r.Time = time.Now().Add(2 * time.Hour)
+		r.Status = models.StatusMatchNotStarted
+		r.LeagueNames = []string{"Premier League", "Premier League"}
+		r.CountryCode = "ENG"
+	})
+
+	started := fixture
+	started.Time = time.Now().Add(-time.Hour)
+	started.Status = models.StatusMatchInProgress
+
+	otherLeague := fixture
+	otherLeague.HomeID = 300
+	otherLeague.AwayID = 400
+	otherLeague.CountryCode = "ESP"
+
+	authHeader := "Authorization"
+	teamFollower := testutils.FakeStandingSubscription(func(r *models.StandingSubscription) {
+		r.ID = 1
+		r.URLTemplate = "https://example.com/{match_id}/{home}-{away}"
+		r.ExternalTeam = &models.ExternalTeam{ID: fixture.AwayID}
+		r.AuthHeader = &authHeader
+	})
+
+	leagueFollower := testutils.FakeStandingSubscription(func(r *models.StandingSubscription) {
+		r.ID = 2
+		r.URLTemplate = "https://example.org/results/{match_id}"
+		r.PayloadVersion = models.PayloadV2
+		r.League = &models.League{Name: "Premier League", CountryCode: "ENG"}
+	})
+
+	aliasHome := testutils.FakeAlias(func(r *models.Alias) {
+		r.Alias = "Man City"
+	})
+
+	aliasAway := testutils.FakeAlias(func(r *models.Alias) {
+		r.Alias = "Arsenal"
+	})
+
+	matchID := uint(15)
+	subscriptionID := uint(25)
+
+	createMatchRequest := models.CreateMatchRequest{
+		StartsAt:  fixture.Time,
+		AliasHome: aliasHome.Alias,
+		AliasAway: aliasAway.Alias,
+	}
+
+	tests := []struct {
+		name                           string
+		expectedErr                    error
+		expectedItems                  []models.PlanningItem
+		standingSubscriptionRepository func(t *testing.T) *mocks.StandingSubscriptionRepository
+		aliasRepository                func(t *testing.T) *mocks.AliasRepository
+		externalAPIClient              func(t *testing.T) *mocks.ExternalAPIClient
+		matchService                   func(t *testing.T) *mocks.MatchService
+		subscriptionService            func(t *testing.T) *mocks.SubscriptionService
+	}{
+		{
+			name: "it returns an error when standing subscriptions listing fails",
+			standingSubscriptionRepository: func(t *testing.T) *mocks.StandingSubscriptionRepository {
+				t.Helper()
+				m := mocks.NewStandingSubscriptionRepository(t)
+				m.On("List", ctx).Return(nil, unexpectedErr).Once()
+				return m
+			},
+			expectedErr: fmt.Errorf("failed to list standing subscriptions: %w", unexpectedErr),
+		},
+		{
+			name: "success - it doesn't search fixtures when there are no standing subscriptions",
+			standingSubscriptionRepository: func(t *testing.T) *mocks.StandingSubscriptionRepository {
+				t.Helper()
+				m := mocks.NewStandingSubscriptionRepository(t)
+				m.On("List", ctx).Return([]models.StandingSubscription{}, nil).Once()
+				return m
+			},
+			expectedItems: []models.PlanningItem{},
+		},
+		{
+			name: "it returns an error when matches retrieval fails",
+			standingSubscriptionRepository: func(t *testing.T) *mocks.StandingSubscriptionRepository {
+				t.Helper()
+				m := mocks.NewStandingSubscriptionRepository(t)
+				m.On("List", ctx).Return([]models.StandingSubscription{teamFollower}, nil).Once()
+				return m
+			},
+			externalAPIClient: func(t *testing.T) *mocks.ExternalAPIClient {
+				t.Helper()
+				m := mocks.NewExternalAPIClient(t)
+				m.On("GetMatches", ctx, mock.Anything).Return(nil, unexpectedErr).Once()
+				return m
+			},
+			expectedErr: unexpectedErr,
+		},
+		{
+			name: "success - it skips started and not followed fixtures",
+			standingSubscriptionRepository: func(t *testing.T) *mocks.StandingSubscriptionRepository {
+				t.Helper()
+				m := mocks.NewStandingSubscriptionRepository(t)
+				m.On("List", ctx).Return([]models.StandingSubscription{teamFollower, leagueFollower}, nil).Once()
+				return m
+			},
+			externalAPIClient: func(t *testing.T) *mocks.ExternalAPIClient {
+				t.Helper()
+				m := mocks.NewExternalAPIClient(t)
+				m.On("GetMatches", ctx, mock.Anything).Return([]models.ExternalAPIMatch{started, otherLeague}, nil).Once()
+				return m
+			},
+			expectedItems: []models.PlanningItem{},
+		},
+		{
+			name: "success - it records an error when team alias is not found",
+			standingSubscriptionRepository: func(t *testing.T) *mocks.StandingSubscriptionRepository {
+				t.Helper()
+				m := mocks.NewStandingSubscriptionRepository(t)
+				m.On("List", ctx).Return([]models.StandingSubscription{teamFollower}, nil).Once()
+				return m
+			},
+			externalAPIClient: func(t *testing.T) *mocks.ExternalAPIClient {
+				t.Helper()
+				m := mocks.NewExternalAPIClient(t)
+				m.On("GetMatches", ctx, mock.Anything).Return([]models.ExternalAPIMatch{fixture}, nil).Once()
+				return m
+			},
+			aliasRepository: func(t *testing.T) *mocks.AliasRepository {
+				t.Helper()
+				m := mocks.NewAliasRepository(t)
+				m.On("FindByExternalTeamID", ctx, fixture.HomeID).Return(nil, unexpectedErr).Once()
+				return m
+			},
+			expectedItems: []models.PlanningItem{
+				{
+					StandingSubscriptionID: teamFollower.ID,
+					ExternalMatchID:        fixture.ID,
+					Error:                  errorMessage(fmt.Errorf("failed to find home team alias: %w", unexpectedErr)),
+				},
+			},
+		},
+		{
+			name: "success - it records an error when match creation fails",
+			standingSubscriptionRepository: func(t *testing.T) *mocks.StandingSubscriptionRepository {
+				t.Helper()
+				m := mocks.NewStandingSubscriptionRepository(t)
+				m.On("List", ctx).Return([]models.StandingSubscription{teamFollower}, nil).Once()
+				return m
+			},
+			externalAPIClient: func(t *testing.T) *mocks.ExternalAPIClient {
+				t.Helper()
+				m := mocks.NewExternalAPIClient(t)
+				m.On("GetMatches", ctx, mock.Anything).Return([]models.ExternalAPIMatch{fixture}, nil).Once()
+				return m
+			},
+			aliasRepository: func(t *testing.T) *mocks.AliasRepository {
+				t.Helper()
+				m := mocks.NewAliasRepository(t)
+				m.On("FindByExternalTeamID", ctx, fixture.HomeID).Return(&aliasHome, nil).Once()
+				m.On("FindByExternalTeamID", ctx, fixture.AwayID).Return(&aliasAway, nil).Once()
+				return m
+			},
+			matchService: func(t *testing.T) *mocks.MatchService {
+				t.Helper()
+				m := mocks.NewMatchService(t)
+				m.On("Create", ctx, createMatchRequest).Return(uint(0), unexpectedErr).Once()
+				return m
+			},
+			expectedItems: []models.PlanningItem{
+				{
+					StandingSubscriptionID: teamFollower.ID,
+					ExternalMatchID:        fixture.ID,
+					Error:                  errorMessage(fmt.Errorf("failed to create match: %w", unexpectedErr)),
+				},
+			},
+		},
+		{
+			name: "success - it creates the match and subscriptions of the team and league followers",
+			standingSubscriptionRepository: func(t *testing.T) *mocks.StandingSubscriptionRepository {
+				t.Helper()
+				m := mocks.NewStandingSubscriptionRepository(t)
+				m.On("List", ctx).Return([]models.StandingSubscription{teamFollower, leagueFollower}, nil).Once()
+				return m
+			},
+			externalAPIClient: func(t *testing.T) *mocks.ExternalAPIClient {
+				t.Helper()
+				m := mocks.NewExternalAPIClient(t)
+				m.On("GetMatches", ctx, mock.Anything).Return([]models.ExternalAPIMatch{fixture}, nil).Once()
+				return m
+			},
+			aliasRepository: func(t *testing.T) *mocks.AliasRepository {
+				t.Helper()
+				m := mocks.NewAliasRepository(t)
+				m.On("FindByExternalTeamID", ctx, fixture.HomeID).Return(&aliasHome, nil).Once()
+				m.On("FindByExternalTeamID", ctx, fixture.AwayID).Return(&aliasAway, nil).Once()
+				return m
+			},
+			matchService: func(t *testing.T) *mocks.MatchService {
+				t.Helper()
+				m := mocks.NewMatchService(t)
+				m.On("Create", ctx, createMatchRequest).Return(matchID, nil).Once()
+				return m
+			},
+			subscriptionService: func(t *testing.T) *mocks.SubscriptionService {
+				t.Helper()
+				m := mocks.NewSubscriptionService(t)
+				m.On("Create", ctx, models.CreateSubscriptionRequest{
+					MatchID:        matchID,
+					URL:            "https://example.com/15/Man%20City-Arsenal",
+					SecretKey:      teamFollower.Key,
+					PayloadVersion: teamFollower.PayloadVersion,
+					DeliveryFormat: teamFollower.DeliveryFormat,
+					AuthHeader:     &authHeader,
+				}).Return(subscriptionID, nil).Once()
+				m.On("Create", ctx, models.CreateSubscriptionRequest{
+					MatchID:        matchID,
+					URL:            "https://example.org/results/15",
+					SecretKey:      leagueFollower.Key,
+					PayloadVersion: leagueFollower.PayloadVersion,
+					DeliveryFormat: leagueFollower.DeliveryFormat,
+				}).Return(uint(0), unexpectedErr).Once()
+				return m
+			},
+			expectedItems: []models.PlanningItem{
+				{
+					StandingSubscriptionID: teamFollower.ID,
+					ExternalMatchID:        fixture.ID,
+					MatchID:                &matchID,
+					SubscriptionID:         &subscriptionID,
+				},
+				{
+					StandingSubscriptionID: leagueFollower.ID,
+					ExternalMatchID:        fixture.ID,
+					MatchID:                &matchID,
+					Error:                  errorMessage(fmt.Errorf("failed to create subscription: %w", unexpectedErr)),
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var standingSubscriptionRepository *mocks.StandingSubscriptionRepository
+			if tt.standingSubscriptionRepository != nil {
+				standingSubscriptionRepository = tt.standingSubscriptionRepository(t)
+			}
+
+			var aliasRepository *mocks.AliasRepository
+			if tt.aliasRepository != nil {
+				aliasRepository = tt.aliasRepository(t)
+			}
+
+			var externalAPIClient *mocks.ExternalAPIClient
+			if tt.externalAPIClient != nil {
+				externalAPIClient = tt.externalAPIClient(t)
+			}
+
+			var matchService *mocks.MatchService
+			if tt.matchService != nil {
+				matchService = tt.matchService(t)
+			}
+
+			var subscriptionService *mocks.SubscriptionService
+			if tt.subscriptionService != nil {
+				subscriptionService = tt.subscriptionService(t)
+			}
+
+			ps := planner.NewPlannerService(
+				config.Planner{DaysAhead: 0},
+				standingSubscriptionRepository,
+				aliasRepository,
+				externalAPIClient,
+				matchService,
+				subscriptionService,
+				loggerinternal.SetupLogger(),
+			)
+
+			report, err := ps.Plan(ctx)
+			if tt.expectedErr != nil {
+				assert.ErrorContains(t, err, tt.expectedErr.Error())
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expectedItems, report.Items)
+		})
+	}
+}
+
+func errorMessage(err error) *string {
+	message := err.Error()
+	return &message
+}
@@ -0,0 +1,120 @@
+package planner
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/andrewshostak/result-service/internal/app/models"
+)
+
+type StandingSubscriptionService struct {
+	standingSubscriptionRepository StandingSubscriptionRepository
+	aliasRepository                AliasRepository
+	logger                         Logger
+}
+
+func NewStandingSubscriptionService(
+	standingSubscriptionRepository StandingSubscriptionRepository,
+	aliasRepository AliasRepository,
+	logger Logger,
+) *StandingSubscriptionService {
+	return &StandingSubscriptionService{
+		standingSubscriptionRepository: standingSubscriptionRepository,
+		aliasRepository:                aliasRepository,
+		logger:                         logger,
+	}
+}
+
+func (s *StandingSubscriptionService) Create(ctx context.Context, request models.CreateStandingSubscriptionRequest) (*models.StandingSubscription, error) {
+	if (request.Alias == nil) == (request.League == nil) {
+		return nil, models.NewUnprocessableContentError(errors.New("either team alias or league has to be followed"))
+	}
+
+	if err := s.validateURLTemplate(request.URLTemplate); err != nil {
+		return nil, models.NewUnprocessableContentError(err)
+	}
+
+	if err := models.ValidateAuthHeader(request.AuthHeader, request.AuthScheme, request.SecretKey); err != nil {
+		return nil, models.NewUnprocessableContentError(err)
+	}
+
+	payloadVersion := request.PayloadVersion
+	if payloadVersion == "" {
+		payloadVersion = models.PayloadV1
+	}
+
+	deliveryFormat := request.DeliveryFormat
+	if deliveryFormat == "" {
+		deliveryFormat = models.FormatWebhook
+	}
+
+	toCreate := models.StandingSubscription{
+		League:         request.League,
+		URLTemplate:    request.URLTemplate,
+		Key:            request.SecretKey,
+		PayloadVersion: payloadVersion,
+		DeliveryFormat: deliveryFormat,
+		AuthHeader:     request.AuthHeader,
+		AuthScheme:     request.AuthScheme,
+	}
+
+	if request.Alias != nil {
+		alias, err := s.aliasRepository.Find(ctx, *request.Alias)
+		if err != nil {
+			return nil, fmt.Errorf("failed to find team alias: %w", err)
+		}
+
+		if alias.ExternalTeam == nil {
+			return nil, models.NewUnprocessableContentError(fmt.Errorf("alias %s doesn't have external team relation", *request.Alias))
+		}
+
+		toCreate.TeamID = &alias.TeamID
+		toCreate.ExternalTeam = alias.ExternalTeam
+	}
+
+	created, err := s.standingSubscriptionRepository.Create(ctx, toCreate)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create standing subscription: %w", err)
+	}
+
+	s.logger.Debug().Uint("standing_subscription_id", created.ID).Msg("standing subscription created")
+
+	return created, nil
+}
+
+func (s *StandingSubscriptionService) List(ctx context.Context) ([]models.StandingSubscription, error) {
+	subscriptions, err := s.standingSubscriptionRepository.List(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list standing subscriptions: %w", err)
+	}
+
+	return subscriptions, nil
+}
+
+func (s *StandingSubscriptionService) Delete(ctx context.Context, id uint) error {
+	if err := s.standingSubscriptionRepository.Delete(ctx, id); err != nil {
+		return fmt.Errorf("failed to delete standing subscription: %w", err)
+	}
+
+	return nil
+}
+
+func (s *StandingSubscriptionService) validateURLTemplate(template string) error {
+	if !strings.Contains(template, PlaceholderMatchID) {
+		return fmt.Errorf("url template has to contain %s placeholder", PlaceholderMatchID)
+	}
+
+	rendered, err := url.ParseRequestURI(renderURL(template, 1, "home", "away"))
+	if err != nil || (rendered.Scheme != "http" && rendered.Scheme != "https") || rendered.Host == "" {
+		return errors.New("url template doesn't render to a valid http url")
+	}
+
+	if rendered.User != nil {
+		return errors.New("url template must not contain credentials")
+	}
+
+	return nil
+}
@@ -0,0 +1,23 @@
+package planner
+
+import (
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+const (
+	PlaceholderMatchID = "{match_id}"
+	PlaceholderHome    = "{home}"
+	PlaceholderAway    = "{away}"
+)
+
+// renderURL substitutes the placeholders of a standing subscription URL template.
+// The match id placeholder is mandatory, as subscription URLs are unique.
+func renderURL(template string, matchID uint, aliasHome string, aliasAway string) string {
+	return strings.NewReplacer(
+		PlaceholderMatchID, strconv.FormatUint(uint64(matchID), 10),
+		PlaceholderHome, url.PathEscape(aliasHome),
+		PlaceholderAway, url.PathEscape(aliasAway),
+	).Replace(template)
+}
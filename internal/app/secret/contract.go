@@ -0,0 +1,20 @@
+package secret
+
+import (
+	"context"
+
+	"github.com/rs/zerolog"
+)
+
+type SubscriptionRepository interface {
+	ReencryptKeys(ctx context.Context) (int64, error)
+	ReencryptHeaders(ctx context.Context) (int64, error)
+}
+
+type StandingSubscriptionRepository interface {
+	ReencryptKeys(ctx context.Context) (int64, error)
+}
+
+type Logger interface {
+	Info() *zerolog.Event
+}
@@ -0,0 +1,52 @@
+package secret
+
+import (
+	"context"
+	"fmt"
+)
+
+// KeyRotationService keeps subscriber secrets encrypted with the current encryption key.
+type KeyRotationService struct {
+	subscriptionRepository         SubscriptionRepository
+	standingSubscriptionRepository StandingSubscriptionRepository
+	logger                         Logger
+}
+
+func NewKeyRotationService(
+	subscriptionRepository SubscriptionRepository,
+	standingSubscriptionRepository StandingSubscriptionRepository,
+	logger Logger,
+) *KeyRotationService {
+	return &KeyRotationService{
+		subscriptionRepository:         subscriptionRepository,
+		standingSubscriptionRepository: standingSubscriptionRepository,
+		logger:                         logger,
+	}
+}
+
+// Rotate re-encrypts keys and custom header values which are not encrypted with the current key. Secrets stored before the encryption are encrypted.
+// Rotation is idempotent, so an interrupted rotation is completed by running it again.
+func (s *KeyRotationService) Rotate(ctx context.Context) error {
+	subscriptions, err := s.subscriptionRepository.ReencryptKeys(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to re-encrypt subscription keys: %w", err)
+	}
+
+	headers, err := s.subscriptionRepository.ReencryptHeaders(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to re-encrypt subscription headers: %w", err)
+	}
+
+	standingSubscriptions, err := s.standingSubscriptionRepository.ReencryptKeys(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to re-encrypt standing subscription keys: %w", err)
+	}
+
+	s.logger.Info().
+		Int64("subscriptions", subscriptions).
+		Int64("subscription_headers", headers).
+		Int64("standing_subscriptions", standingSubscriptions).
+		Msg("subscriber secrets re-encrypted")
+
+	return nil
+}
@@ -0,0 +1,100 @@
+package secret_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/andrewshostak/result-service/internal/app/secret"
+	"github.com/andrewshostak/result-service/internal/app/secret/mocks"
+	loggerinternal "github.com/andrewshostak/result-service/internal/infra/logger"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestKeyRotationService_Rotate(t *testing.T) {
+	ctx := context.Background()
+
+	tests := []struct {
+		name                           string
+		subscriptionRepository         func(t *testing.T) *mocks.SubscriptionRepository
+		standingSubscriptionRepository func(t *testing.T) *mocks.StandingSubscriptionRepository
+		expectedErr                    error
+	}{
+		{
+			name: "it returns an error when subscription keys re-encryption fails",
+			subscriptionRepository: func(t *testing.T) *mocks.SubscriptionRepository {
+				t.Helper()
+				m := mocks.NewSubscriptionRepository(t)
+				m.On("ReencryptKeys", ctx).Return(int64(0), errors.New("database error")).Once()
+				return m
+			},
+			standingSubscriptionRepository: func(t *testing.T) *mocks.StandingSubscriptionRepository {
+				t.Helper()
+				return mocks.NewStandingSubscriptionRepository(t)
+			},
+			expectedErr: fmt.Errorf("failed to re-encrypt subscription keys: %w", errors.New("database error")),
+		},
+		{
+			name: "it returns an error when subscription headers re-encryption fails",
+			subscriptionRepository: func(t *testing.T) *mocks.SubscriptionRepository {
+				t.Helper()
+				m := mocks.NewSubscriptionRepository(t)
+				m.On("ReencryptKeys", ctx).Return(int64(3), nil).Once()
+				m.On("ReencryptHeaders", ctx).Return(int64(0), errors.New("database error")).Once()
+				return m
+			},
+			standingSubscriptionRepository: func(t *testing.T) *mocks.StandingSubscriptionRepository {
+				t.Helper()
+				return mocks.NewStandingSubscriptionRepository(t)
+			},
+			expectedErr: fmt.Errorf("failed to re-encrypt subscription headers: %w", errors.New("database error")),
+		},
+		{
+			name: "it returns an error when standing subscription keys re-encryption fails",
+			subscriptionRepository: func(t *testing.T) *mocks.SubscriptionRepository {
+				t.Helper()
+				m := mocks.NewSubscriptionRepository(t)
+				m.On("ReencryptKeys", ctx).Return(int64(3), nil).Once()
+				m.On("ReencryptHeaders", ctx).Return(int64(2), nil).Once()
+				return m
+			},
+			standingSubscriptionRepository: func(t *testing.T) *mocks.StandingSubscriptionRepository {
+				t.Helper()
+				m := mocks.NewStandingSubscriptionRepository(t)
+				m.On("ReencryptKeys", ctx).Return(int64(0), errors.New("database error")).Once()
+				return m
+			},
+			expectedErr: fmt.Errorf("failed to re-encrypt standing subscription keys: %w", errors.New("database error")),
+		},
+		{
+			name: "success - it re-encrypts keys of subscriptions and standing subscriptions and subscription headers",
+			subscriptionRepository: func(t *testing.T) *mocks.SubscriptionRepository {
+				t.Helper()
+				m := mocks.NewSubscriptionRepository(t)
+				m.On("ReencryptKeys", ctx).Return(int64(3), nil).Once()
+				m.On("ReencryptHeaders", ctx).Return(int64(2), nil).Once()
+				return m
+			},
+			standingSubscriptionRepository: func(t *testing.T) *mocks.StandingSubscriptionRepository {
+				t.Helper()
+				m := mocks.NewStandingSubscriptionRepository(t)
+				m.On("ReencryptKeys", ctx).Return(int64(1), nil).Once()
+				return m
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			service := secret.NewKeyRotationService(tt.subscriptionRepository(t), tt.standingSubscriptionRepository(t), loggerinternal.SetupLogger())
+
+			err := service.Rotate(ctx)
+			if tt.expectedErr != nil {
+				assert.EqualError(t, err, tt.expectedErr.Error())
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
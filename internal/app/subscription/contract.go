@@ -2,11 +2,16 @@ package subscription
 
 import (
 	"context"
+	"time"
 
 	"github.com/andrewshostak/result-service/internal/app/models"
 	"github.com/rs/zerolog"
 )
 
+type UnitOfWork interface {
+	Do(ctx context.Context, fn func(ctx context.Context) error) error
+}
+
 type AliasRepository interface {
 	Find(ctx context.Context, alias string) (*models.Alias, error)
 }
@@ -16,8 +21,42 @@ type SubscriptionRepository interface {
 	Delete(ctx context.Context, id uint) error
 	One(ctx context.Context, matchID uint, key string, baseURL string) (*models.Subscription, error)
 	List(ctx context.Context, matchID uint) ([]models.Subscription, error)
+	Search(ctx context.Context, filter models.SubscriptionFilter) ([]models.Subscription, error)
 	Update(ctx context.Context, id uint, subscription models.Subscription) error
 	Get(ctx context.Context, id uint) (*models.Subscription, error)
+	RotateKey(ctx context.Context, key string, newKey string, previousKeyTill time.Time) (int64, error)
+}
+
+type NotificationAttemptRepository interface {
+	Create(ctx context.Context, attempt models.NotificationAttempt) (*models.NotificationAttempt, error)
+	ListBySubscription(ctx context.Context, subscriptionID uint) ([]models.NotificationAttempt, error)
+}
+
+type MatchEventRepository interface {
+	Create(ctx context.Context, event models.MatchEvent) (*models.MatchEvent, error)
+}
+
+type EventDeliveryRepository interface {
+	Create(ctx context.Context, delivery models.EventDelivery) (*models.EventDelivery, error)
+	Get(ctx context.Context, id uint) (*models.EventDelivery, error)
+	Update(ctx context.Context, id uint, delivery models.EventDelivery) error
+}
+
+type NotificationBatchRepository interface {
+	Join(ctx context.Context, subscriptionID uint, deliverAt time.Time) (*models.NotificationBatch, error)
+	Close(ctx context.Context, id uint) (*models.NotificationBatch, error)
+}
+
+type SubscriberHostRepository interface {
+	Get(ctx context.Context, host string) (*models.SubscriberHost, error)
+	List(ctx context.Context) ([]models.SubscriberHost, error)
+	RecordSuccess(ctx context.Context, host string) error
+	RecordFailure(ctx context.Context, host string, errMessage string, threshold uint) (*models.SubscriberHost, error)
+	Enable(ctx context.Context, host string) error
+}
+
+type OutboxTaskRepository interface {
+	HasPending(ctx context.Context, matchID uint, kind models.OutboxTaskKind) (bool, error)
 }
 
 type MatchRepository interface {
@@ -25,12 +64,25 @@ type MatchRepository interface {
 	Delete(ctx context.Context, id uint) error
 }
 
+type ExternalAPIClient interface {
+	GetMatches(ctx context.Context, date time.Time) ([]models.ExternalAPIMatch, error)
+}
+
 type NotifierClient interface {
-	Notify(ctx context.Context, notification models.SubscriberNotification) error
+	Notify(ctx context.Context, notification models.SubscriberNotification) (*models.NotificationResponse, error)
+	NotifyBatch(ctx context.Context, batch models.BatchNotification) (*models.NotificationResponse, error)
+	Verify(ctx context.Context, verification models.EndpointVerification) error
 }
 
 type TaskClient interface {
 	DeleteResultCheckTask(ctx context.Context, taskName string) error
+	ScheduleSubscriberNotification(ctx context.Context, subscriptionID uint, redeliveryID string) error
+	ScheduleSubscriberNotificationRetry(ctx context.Context, subscriptionID uint, retryID string, scheduleAt time.Time) error
+	ScheduleLiveCheck(ctx context.Context, matchID uint, sequence uint, scheduleAt time.Time) error
+	ScheduleEventDelivery(ctx context.Context, eventDeliveryID uint, attempt uint, scheduleAt time.Time) error
+	ScheduleNotificationBatch(ctx context.Context, notificationBatchID uint, scheduleAt time.Time) error
+	ScheduleKickoffReminder(ctx context.Context, subscriptionID uint, kickoff time.Time, scheduleAt time.Time) error
+	DeleteKickoffReminderTask(ctx context.Context, subscriptionID uint, kickoff time.Time) error
 }
 
 type Logger interface {
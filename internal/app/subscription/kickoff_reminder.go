@@ -0,0 +1,199 @@
+package subscription
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/andrewshostak/result-service/internal/app/models"
+	"github.com/google/uuid"
+)
+
+// KickoffReminderService reminds subscribers about the kickoff the chosen number of minutes before it.
+// The kickoff is confirmed with external api when the reminder is due: the reminder of a kickoff which has moved later
+// is rescheduled, otherwise it is delivered as a match event with the confirmed kickoff.
+type KickoffReminderService struct {
+	unitOfWork              UnitOfWork
+	subscriptionRepository  SubscriptionRepository
+	matchRepository         MatchRepository
+	matchEventRepository    MatchEventRepository
+	eventDeliveryRepository EventDeliveryRepository
+	externalAPIClient       ExternalAPIClient
+	taskClient              TaskClient
+	logger                  Logger
+}
+
+func NewKickoffReminderService(
+	unitOfWork UnitOfWork,
+	subscriptionRepository SubscriptionRepository,
+	matchRepository MatchRepository,
+	matchEventRepository MatchEventRepository,
+	eventDeliveryRepository EventDeliveryRepository,
+	externalAPIClient ExternalAPIClient,
+	taskClient TaskClient,
+	logger Logger,
+) *KickoffReminderService {
+	return &KickoffReminderService{
+		unitOfWork:              unitOfWork,
+		subscriptionRepository:  subscriptionRepository,
+		matchRepository:         matchRepository,
+		matchEventRepository:    matchEventRepository,
+		eventDeliveryRepository: eventDeliveryRepository,
+		externalAPIClient:       externalAPIClient,
+		taskClient:              taskClient,
+		logger:                  logger,
+	}
+}
+
+// Remind handles the reminder scheduled for the kickoff.
+func (s *KickoffReminderService) Remind(ctx context.Context, subscriptionID uint, kickoff time.Time) error {
+	subscription, err := s.subscriptionRepository.Get(ctx, subscriptionID)
+	if errors.As(err, &models.ResourceNotFoundError{}) {
+		s.logger.Info().Uint("subscription_id", subscriptionID).Msg("kickoff reminder skipped: subscription is deleted")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("failed to get subscription by id: %w", err)
+	}
+
+	if subscription.RemindBefore == nil {
+		s.logger.Error().Uint("subscription_id", subscriptionID).Msg("subscription doesn't have a reminder")
+		return nil
+	}
+
+	// endpoints which aren't verified yet and unsubscribed ones don't receive events
+	if subscription.Status == models.UnverifiedSub || subscription.Status == models.UnsubscribedSub {
+		s.logger.Info().Uint("subscription_id", subscriptionID).Str("status", string(subscription.Status)).Msg("kickoff reminder skipped")
+		return nil
+	}
+
+	match, err := s.matchRepository.One(ctx, models.Match{ID: subscription.MatchID})
+	if err != nil {
+		return fmt.Errorf("failed to get match: %w", err)
+	}
+
+	if match.ResultStatus != models.Scheduled {
+		s.logger.Info().Uint("subscription_id", subscriptionID).Msg(fmt.Sprintf("kickoff reminder skipped: match result status is %s", match.ResultStatus))
+		return nil
+	}
+
+	if match.ExternalMatch == nil {
+		return errors.New("match relation external match doesn't exist")
+	}
+
+	observed, err := s.findExternalMatch(ctx, *match)
+	if err != nil {
+		return err
+	}
+
+	if observed == nil {
+		s.logger.Info().Uint("subscription_id", subscriptionID).Msgf("kickoff reminder skipped: external match with id %d is not found", match.ExternalMatch.ID)
+		return nil
+	}
+
+	if observed.Status != models.StatusMatchNotStarted {
+		s.logger.Info().Uint("subscription_id", subscriptionID).Msg(fmt.Sprintf("kickoff reminder skipped: external match status is %s", observed.Status))
+		return nil
+	}
+
+	if !observed.Time.After(time.Now()) {
+		s.logger.Info().Uint("subscription_id", subscriptionID).Time("kickoff", observed.Time).Msg("kickoff reminder skipped: kickoff time has passed")
+		return nil
+	}
+
+	// a kickoff which has moved earlier is reminded right away, as its reminder time has already passed
+	if !observed.Time.Equal(kickoff) && subscription.RemindAt(observed.Time).After(time.Now()) {
+		s.logger.Info().Uint("subscription_id", subscriptionID).Time("kickoff", observed.Time).Msg("kickoff has moved, reminder is rescheduled")
+		return scheduleKickoffReminder(ctx, s.taskClient, *subscription, observed.Time)
+	}
+
+	return s.publish(ctx, *subscription, observed.Time)
+}
+
+// publish stores the reminder as a match event delivered to the subscription only. The event is keyed by the subscription
+// and the kickoff, so a repeated task doesn't deliver the reminder twice.
+func (s *KickoffReminderService) publish(ctx context.Context, subscription models.Subscription, kickoff time.Time) error {
+	var delivery *models.EventDelivery
+
+	err := s.unitOfWork.Do(ctx, func(ctx context.Context) error {
+		event, err := s.matchEventRepository.Create(ctx, models.MatchEvent{
+			MatchID:  subscription.MatchID,
+			Type:     models.EventMatchReminder,
+			Key:      fmt.Sprintf("reminder-%d-%d", subscription.ID, kickoff.Unix()),
+			StartsAt: kickoff,
+		})
+		if errors.As(err, &models.ResourceAlreadyExistsError{}) {
+			s.logger.Debug().Uint("subscription_id", subscription.ID).Msg("kickoff reminder is already published")
+			return nil
+		}
+
+		if err != nil {
+			return fmt.Errorf("failed to create match event: %w", err)
+		}
+
+		delivery, err = s.eventDeliveryRepository.Create(ctx, models.EventDelivery{
+			MatchEventID:   event.ID,
+			SubscriptionID: subscription.ID,
+			DeliveryID:     uuid.NewString(),
+		})
+		if err != nil {
+			return fmt.Errorf("failed to create event delivery: %w", err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("failed to publish kickoff reminder: %w", err)
+	}
+
+	if delivery == nil {
+		return nil
+	}
+
+	err = s.taskClient.ScheduleEventDelivery(ctx, delivery.ID, 0, time.Now())
+	if err == nil || errors.As(err, &models.ResourceAlreadyExistsError{}) {
+		return nil
+	}
+
+	s.logger.Error().Err(err).Uint("event_delivery_id", delivery.ID).Msg("failed to schedule event delivery task")
+
+	errUpdate := s.eventDeliveryRepository.Update(ctx, delivery.ID, models.EventDelivery{Status: models.SchedulingErrorSub})
+	if errUpdate != nil {
+		s.logger.Error().Err(errUpdate).Uint("event_delivery_id", delivery.ID).Msg(fmt.Sprintf("failed to update event delivery status to: %s", string(models.SchedulingErrorSub)))
+	}
+
+	return nil
+}
+
+func (s *KickoffReminderService) findExternalMatch(ctx context.Context, match models.Match) (*models.ExternalAPIMatch, error) {
+	matches, err := s.externalAPIClient.GetMatches(ctx, match.StartsAt)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get matches from external api: %w", err)
+	}
+
+	for i := range matches {
+		if matches[i].ID == match.ExternalMatch.ID {
+			return &matches[i], nil
+		}
+	}
+
+	return nil, nil
+}
+
+// scheduleKickoffReminder creates the reminder task of the kickoff. A reminder which is already due,
+// as the kickoff is sooner than the reminder interval, is scheduled right away.
+func scheduleKickoffReminder(ctx context.Context, taskClient TaskClient, subscription models.Subscription, kickoff time.Time) error {
+	remindAt := subscription.RemindAt(kickoff)
+	if remindAt.Before(time.Now()) {
+		remindAt = time.Now()
+	}
+
+	err := taskClient.ScheduleKickoffReminder(ctx, subscription.ID, kickoff, remindAt)
+	if err != nil && !errors.As(err, &models.ResourceAlreadyExistsError{}) {
+		return fmt.Errorf("failed to schedule kickoff reminder: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,329 @@
+package subscription_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/andrewshostak/result-service/internal/app/models"
+	sub "github.com/andrewshostak/result-service/internal/app/subscription"
+	"github.com/andrewshostak/result-service/internal/app/subscription/mocks"
+	loggerinternal "github.com/andrewshostak/result-service/internal/infra/logger"
+	"github.com/andrewshostak/result-service/testutils"
+	"github.com/brianvoe/gofakeit/v6"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestKickoffReminderService_Remind(t *testing.T) {
+	ctx := context.Background()
+	unexpectedErr := errors.New("unexpected error")
+
+	kickoff := time.Now().Add(30 * time.Minute).Truncate(time.Second)
+	remindBefore := uint(30)
+	externalMatchID := uint(gofakeit.Uint32())
+	eventDeliveryID := uint(gofakeit.Uint16())
+
+	subscription := testutils.FakeSubscription(func(s *models.Subscription) {
+		s.Status = models.PendingSub
+		s.RemindBefore = &remindBefore
+	})
+	match := testutils.FakeMatch(func(m *models.Match) {
+		m.ID = subscription.MatchID
+		m.ResultStatus = models.Scheduled
+		m.StartsAt = kickoff
+		m.ExternalMatch = &models.ExternalMatch{ID: externalMatchID, MatchID: subscription.MatchID}
+	})
+	observed := testutils.FakeExternalAPIMatch(func(m *models.ExternalAPIMatch) {
+		m.ID = externalMatchID
+		m.Time = kickoff
+		m.Status = models.StatusMatchNotStarted
+	})
+
+	observedAt := func(kickoff time.Time) []models.ExternalAPIMatch {
+		moved := observed
+		moved.Time = kickoff
+		return []models.ExternalAPIMatch{moved}
+	}
+
+	reminderEvent := func(kickoff time.Time) models.MatchEvent {
+		return models.MatchEvent{
+			MatchID:  subscription.MatchID,
+			Type:     models.EventMatchReminder,
+			Key:      fmt.Sprintf("reminder-%d-%d", subscription.ID, kickoff.Unix()),
+			StartsAt: kickoff,
+		}
+	}
+
+	withSubscription := func(t *testing.T) *mocks.SubscriptionRepository {
+		t.Helper()
+		m := mocks.NewSubscriptionRepository(t)
+		m.On("Get", ctx, subscription.ID).Return(&subscription, nil).Once()
+		return m
+	}
+
+	withMatch := func(t *testing.T) *mocks.MatchRepository {
+		t.Helper()
+		m := mocks.NewMatchRepository(t)
+		m.On("One", ctx, models.Match{ID: subscription.MatchID}).Return(&match, nil).Once()
+		return m
+	}
+
+	tests := []struct {
+		name                    string
+		subscriptionRepository  func(t *testing.T) *mocks.SubscriptionRepository
+		matchRepository         func(t *testing.T) *mocks.MatchRepository
+		externalAPIClient       func(t *testing.T) *mocks.ExternalAPIClient
+		matchEventRepository    func(t *testing.T) *mocks.MatchEventRepository
+		eventDeliveryRepository func(t *testing.T) *mocks.EventDeliveryRepository
+		taskClient              func(t *testing.T) *mocks.TaskClient
+		expectedErr             error
+	}{
+		{
+			name: "it returns an error when subscription retrieval fails",
+			subscriptionRepository: func(t *testing.T) *mocks.SubscriptionRepository {
+				t.Helper()
+				m := mocks.NewSubscriptionRepository(t)
+				m.On("Get", ctx, subscription.ID).Return(nil, unexpectedErr).Once()
+				return m
+			},
+			expectedErr: fmt.Errorf("failed to get subscription by id: %w", unexpectedErr),
+		},
+		{
+			name: "success - it skips the reminder when subscription is deleted",
+			subscriptionRepository: func(t *testing.T) *mocks.SubscriptionRepository {
+				t.Helper()
+				m := mocks.NewSubscriptionRepository(t)
+				m.On("Get", ctx, subscription.ID).Return(nil, models.NewResourceNotFoundError(errors.New("not found"))).Once()
+				return m
+			},
+		},
+		{
+			name: "success - it skips the reminder when subscriber has unsubscribed",
+			subscriptionRepository: func(t *testing.T) *mocks.SubscriptionRepository {
+				t.Helper()
+				m := mocks.NewSubscriptionRepository(t)
+				unsubscribed := subscription
+				unsubscribed.Status = models.UnsubscribedSub
+				m.On("Get", ctx, subscription.ID).Return(&unsubscribed, nil).Once()
+				return m
+			},
+		},
+		{
+			name:                   "success - it skips the reminder when match result is not scheduled",
+			subscriptionRepository: withSubscription,
+			matchRepository: func(t *testing.T) *mocks.MatchRepository {
+				t.Helper()
+				m := mocks.NewMatchRepository(t)
+				cancelled := match
+				cancelled.ResultStatus = models.Cancelled
+				m.On("One", ctx, models.Match{ID: subscription.MatchID}).Return(&cancelled, nil).Once()
+				return m
+			},
+		},
+		{
+			name:                   "it returns an error when matches retrieval from external api fails",
+			subscriptionRepository: withSubscription,
+			matchRepository:        withMatch,
+			externalAPIClient: func(t *testing.T) *mocks.ExternalAPIClient {
+				t.Helper()
+				m := mocks.NewExternalAPIClient(t)
+				m.On("GetMatches", ctx, kickoff).Return(nil, unexpectedErr).Once()
+				return m
+			},
+			expectedErr: fmt.Errorf("failed to get matches from external api: %w", unexpectedErr),
+		},
+		{
+			name:                   "success - it skips the reminder when match has already started",
+			subscriptionRepository: withSubscription,
+			matchRepository:        withMatch,
+			externalAPIClient: func(t *testing.T) *mocks.ExternalAPIClient {
+				t.Helper()
+				m := mocks.NewExternalAPIClient(t)
+				started := observed
+				started.Status = models.StatusMatchInProgress
+				m.On("GetMatches", ctx, kickoff).Return([]models.ExternalAPIMatch{started}, nil).Once()
+				return m
+			},
+		},
+		{
+			name:                   "success - it reschedules the reminder when kickoff has moved later",
+			subscriptionRepository: withSubscription,
+			matchRepository:        withMatch,
+			externalAPIClient: func(t *testing.T) *mocks.ExternalAPIClient {
+				t.Helper()
+				m := mocks.NewExternalAPIClient(t)
+				m.On("GetMatches", ctx, kickoff).Return(observedAt(kickoff.Add(2*time.Hour)), nil).Once()
+				return m
+			},
+			taskClient: func(t *testing.T) *mocks.TaskClient {
+				t.Helper()
+				m := mocks.NewTaskClient(t)
+				moved := kickoff.Add(2 * time.Hour)
+				m.On("ScheduleKickoffReminder", ctx, subscription.ID, moved, moved.Add(-30*time.Minute)).Return(nil).Once()
+				return m
+			},
+		},
+		{
+			name:                   "success - it publishes the reminder with the confirmed kickoff",
+			subscriptionRepository: withSubscription,
+			matchRepository:        withMatch,
+			externalAPIClient: func(t *testing.T) *mocks.ExternalAPIClient {
+				t.Helper()
+				m := mocks.NewExternalAPIClient(t)
+				m.On("GetMatches", ctx, kickoff).Return(observedAt(kickoff), nil).Once()
+				return m
+			},
+			matchEventRepository: func(t *testing.T) *mocks.MatchEventRepository {
+				t.Helper()
+				m := mocks.NewMatchEventRepository(t)
+				m.On("Create", ctx, reminderEvent(kickoff)).Return(&models.MatchEvent{ID: 7}, nil).Once()
+				return m
+			},
+			eventDeliveryRepository: func(t *testing.T) *mocks.EventDeliveryRepository {
+				t.Helper()
+				m := mocks.NewEventDeliveryRepository(t)
+				m.On("Create", ctx, mock.MatchedBy(func(d models.EventDelivery) bool {
+					return d.MatchEventID == 7 && d.SubscriptionID == subscription.ID && d.DeliveryID != ""
+				})).Return(&models.EventDelivery{ID: eventDeliveryID}, nil).Once()
+				return m
+			},
+			taskClient: func(t *testing.T) *mocks.TaskClient {
+				t.Helper()
+				m := mocks.NewTaskClient(t)
+				m.On("ScheduleEventDelivery", ctx, eventDeliveryID, uint(0), mock.Anything).Return(nil).Once()
+				return m
+			},
+		},
+		{
+			name:                   "success - it publishes the reminder right away when kickoff has moved earlier",
+			subscriptionRepository: withSubscription,
+			matchRepository:        withMatch,
+			externalAPIClient: func(t *testing.T) *mocks.ExternalAPIClient {
+				t.Helper()
+				m := mocks.NewExternalAPIClient(t)
+				m.On("GetMatches", ctx, kickoff).Return(observedAt(kickoff.Add(-10*time.Minute)), nil).Once()
+				return m
+			},
+			matchEventRepository: func(t *testing.T) *mocks.MatchEventRepository {
+				t.Helper()
+				m := mocks.NewMatchEventRepository(t)
+				m.On("Create", ctx, reminderEvent(kickoff.Add(-10*time.Minute))).Return(&models.MatchEvent{ID: 7}, nil).Once()
+				return m
+			},
+			eventDeliveryRepository: func(t *testing.T) *mocks.EventDeliveryRepository {
+				t.Helper()
+				m := mocks.NewEventDeliveryRepository(t)
+				m.On("Create", ctx, mock.Anything).Return(&models.EventDelivery{ID: eventDeliveryID}, nil).Once()
+				return m
+			},
+			taskClient: func(t *testing.T) *mocks.TaskClient {
+				t.Helper()
+				m := mocks.NewTaskClient(t)
+				m.On("ScheduleEventDelivery", ctx, eventDeliveryID, uint(0), mock.Anything).Return(nil).Once()
+				return m
+			},
+		},
+		{
+			name:                   "success - it doesn't deliver the reminder which is already published",
+			subscriptionRepository: withSubscription,
+			matchRepository:        withMatch,
+			externalAPIClient: func(t *testing.T) *mocks.ExternalAPIClient {
+				t.Helper()
+				m := mocks.NewExternalAPIClient(t)
+				m.On("GetMatches", ctx, kickoff).Return(observedAt(kickoff), nil).Once()
+				return m
+			},
+			matchEventRepository: func(t *testing.T) *mocks.MatchEventRepository {
+				t.Helper()
+				m := mocks.NewMatchEventRepository(t)
+				m.On("Create", ctx, reminderEvent(kickoff)).Return(nil, models.NewResourceAlreadyExistsError(errors.New("already exists"))).Once()
+				return m
+			},
+		},
+		{
+			name:                   "success - it marks the event delivery when its scheduling fails",
+			subscriptionRepository: withSubscription,
+			matchRepository:        withMatch,
+			externalAPIClient: func(t *testing.T) *mocks.ExternalAPIClient {
+				t.Helper()
+				m := mocks.NewExternalAPIClient(t)
+				m.On("GetMatches", ctx, kickoff).Return(observedAt(kickoff), nil).Once()
+				return m
+			},
+			matchEventRepository: func(t *testing.T) *mocks.MatchEventRepository {
+				t.Helper()
+				m := mocks.NewMatchEventRepository(t)
+				m.On("Create", ctx, reminderEvent(kickoff)).Return(&models.MatchEvent{ID: 7}, nil).Once()
+				return m
+			},
+			eventDeliveryRepository: func(t *testing.T) *mocks.EventDeliveryRepository {
+				t.Helper()
+				m := mocks.NewEventDeliveryRepository(t)
+				m.On("Create", ctx, mock.Anything).Return(&models.EventDelivery{ID: eventDeliveryID}, nil).Once()
+				m.On("Update", ctx, eventDeliveryID, models.EventDelivery{Status: models.SchedulingErrorSub}).Return(nil).Once()
+				return m
+			},
+			taskClient: func(t *testing.T) *mocks.TaskClient {
+				t.Helper()
+				m := mocks.NewTaskClient(t)
+				m.On("ScheduleEventDelivery", ctx, eventDeliveryID, uint(0), mock.Anything).Return(unexpectedErr).Once()
+				return m
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			unitOfWork := mocks.NewUnitOfWork(t)
+			unitOfWork.On("Do", mock.Anything, mock.Anything).Return(func(ctx context.Context, fn func(ctx context.Context) error) error {
+				return fn(ctx)
+			}).Maybe()
+
+			var matchRepository *mocks.MatchRepository
+			if tt.matchRepository != nil {
+				matchRepository = tt.matchRepository(t)
+			}
+
+			var externalAPIClient *mocks.ExternalAPIClient
+			if tt.externalAPIClient != nil {
+				externalAPIClient = tt.externalAPIClient(t)
+			}
+
+			var matchEventRepository *mocks.MatchEventRepository
+			if tt.matchEventRepository != nil {
+				matchEventRepository = tt.matchEventRepository(t)
+			}
+
+			var eventDeliveryRepository *mocks.EventDeliveryRepository
+			if tt.eventDeliveryRepository != nil {
+				eventDeliveryRepository = tt.eventDeliveryRepository(t)
+			}
+
+			var taskClient *mocks.TaskClient
+			if tt.taskClient != nil {
+				taskClient = tt.taskClient(t)
+			}
+
+			krs := sub.NewKickoffReminderService(
+				unitOfWork,
+				tt.subscriptionRepository(t),
+				matchRepository,
+				matchEventRepository,
+				eventDeliveryRepository,
+				externalAPIClient,
+				taskClient,
+				loggerinternal.SetupLogger(),
+			)
+
+			err := krs.Remind(ctx, subscription.ID, kickoff)
+			if tt.expectedErr != nil {
+				assert.EqualError(t, err, tt.expectedErr.Error())
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,59 @@
+// Code generated by mockery v2.53.3. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	models "github.com/andrewshostak/result-service/internal/app/models"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MatchEventRepository is an autogenerated mock type for the MatchEventRepository type
+type MatchEventRepository struct {
+	mock.Mock
+}
+
+// Create provides a mock function with given fields: ctx, event
+func (_m *MatchEventRepository) Create(ctx context.Context, event models.MatchEvent) (*models.MatchEvent, error) {
+	ret := _m.Called(ctx, event)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Create")
+	}
+
+	var r0 *models.MatchEvent
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, models.MatchEvent) (*models.MatchEvent, error)); ok {
+		return rf(ctx, event)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, models.MatchEvent) *models.MatchEvent); ok {
+		r0 = rf(ctx, event)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.MatchEvent)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, models.MatchEvent) error); ok {
+		r1 = rf(ctx, event)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NewMatchEventRepository creates a new instance of MatchEventRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMatchEventRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MatchEventRepository {
+	mock := &MatchEventRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,89 @@
+// Code generated by mockery v2.53.3. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	models "github.com/andrewshostak/result-service/internal/app/models"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// NotificationAttemptRepository is an autogenerated mock type for the NotificationAttemptRepository type
+type NotificationAttemptRepository struct {
+	mock.Mock
+}
+
+// Create provides a mock function with given fields: ctx, attempt
+func (_m *NotificationAttemptRepository) Create(ctx context.Context, attempt models.NotificationAttempt) (*models.NotificationAttempt, error) {
+	ret := _m.Called(ctx, attempt)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Create")
+	}
+
+	var r0 *models.NotificationAttempt
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, models.NotificationAttempt) (*models.NotificationAttempt, error)); ok {
+		return rf(ctx, attempt)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, models.NotificationAttempt) *models.NotificationAttempt); ok {
+		r0 = rf(ctx, attempt)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.NotificationAttempt)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, models.NotificationAttempt) error); ok {
+		r1 = rf(ctx, attempt)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListBySubscription provides a mock function with given fields: ctx, subscriptionID
+func (_m *NotificationAttemptRepository) ListBySubscription(ctx context.Context, subscriptionID uint) ([]models.NotificationAttempt, error) {
+	ret := _m.Called(ctx, subscriptionID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListBySubscription")
+	}
+
+	var r0 []models.NotificationAttempt
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint) ([]models.NotificationAttempt, error)); ok {
+		return rf(ctx, subscriptionID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint) []models.NotificationAttempt); ok {
+		r0 = rf(ctx, subscriptionID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.NotificationAttempt)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint) error); ok {
+		r1 = rf(ctx, subscriptionID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NewNotificationAttemptRepository creates a new instance of NotificationAttemptRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewNotificationAttemptRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *NotificationAttemptRepository {
+	mock := &NotificationAttemptRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,91 @@
+// Code generated by mockery v2.53.3. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	models "github.com/andrewshostak/result-service/internal/app/models"
+	mock "github.com/stretchr/testify/mock"
+
+	time "time"
+)
+
+// NotificationBatchRepository is an autogenerated mock type for the NotificationBatchRepository type
+type NotificationBatchRepository struct {
+	mock.Mock
+}
+
+// Close provides a mock function with given fields: ctx, id
+func (_m *NotificationBatchRepository) Close(ctx context.Context, id uint) (*models.NotificationBatch, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Close")
+	}
+
+	var r0 *models.NotificationBatch
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint) (*models.NotificationBatch, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint) *models.NotificationBatch); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.NotificationBatch)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Join provides a mock function with given fields: ctx, subscriptionID, deliverAt
+func (_m *NotificationBatchRepository) Join(ctx context.Context, subscriptionID uint, deliverAt time.Time) (*models.NotificationBatch, error) {
+	ret := _m.Called(ctx, subscriptionID, deliverAt)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Join")
+	}
+
+	var r0 *models.NotificationBatch
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint, time.Time) (*models.NotificationBatch, error)); ok {
+		return rf(ctx, subscriptionID, deliverAt)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint, time.Time) *models.NotificationBatch); ok {
+		r0 = rf(ctx, subscriptionID, deliverAt)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.NotificationBatch)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint, time.Time) error); ok {
+		r1 = rf(ctx, subscriptionID, deliverAt)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NewNotificationBatchRepository creates a new instance of NotificationBatchRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewNotificationBatchRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *NotificationBatchRepository {
+	mock := &NotificationBatchRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
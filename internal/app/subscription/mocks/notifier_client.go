@@ -15,16 +15,76 @@ type NotifierClient struct {
 }
 
 // Notify provides a mock function with given fields: ctx, notification
-func (_m *NotifierClient) Notify(ctx context.Context, notification models.SubscriberNotification) error {
+func (_m *NotifierClient) Notify(ctx context.Context, notification models.SubscriberNotification) (*models.NotificationResponse, error) {
 	ret := _m.Called(ctx, notification)
 
 	if len(ret) == 0 {
 		panic("no return value specified for Notify")
 	}
 
-	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, models.SubscriberNotification) error); ok {
+	var r0 *models.NotificationResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, models.SubscriberNotification) (*models.NotificationResponse, error)); ok {
+		return rf(ctx, notification)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, models.SubscriberNotification) *models.NotificationResponse); ok {
 		r0 = rf(ctx, notification)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.NotificationResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, models.SubscriberNotification) error); ok {
+		r1 = rf(ctx, notification)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NotifyBatch provides a mock function with given fields: ctx, batch
+func (_m *NotifierClient) NotifyBatch(ctx context.Context, batch models.BatchNotification) (*models.NotificationResponse, error) {
+	ret := _m.Called(ctx, batch)
+
+	if len(ret) == 0 {
+		panic("no return value specified for NotifyBatch")
+	}
+
+	var r0 *models.NotificationResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, models.BatchNotification) (*models.NotificationResponse, error)); ok {
+		return rf(ctx, batch)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, models.BatchNotification) *models.NotificationResponse); ok {
+		r0 = rf(ctx, batch)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.NotificationResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, models.BatchNotification) error); ok {
+		r1 = rf(ctx, batch)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Verify provides a mock function with given fields: ctx, verification
+func (_m *NotifierClient) Verify(ctx context.Context, verification models.EndpointVerification) error {
+	ret := _m.Called(ctx, verification)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Verify")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, models.EndpointVerification) error); ok {
+		r0 = rf(ctx, verification)
 	} else {
 		r0 = ret.Error(0)
 	}
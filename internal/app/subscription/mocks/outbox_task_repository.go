@@ -0,0 +1,57 @@
+// Code generated by mockery v2.53.3. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	models "github.com/andrewshostak/result-service/internal/app/models"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// OutboxTaskRepository is an autogenerated mock type for the OutboxTaskRepository type
+type OutboxTaskRepository struct {
+	mock.Mock
+}
+
+// HasPending provides a mock function with given fields: ctx, matchID, kind
+func (_m *OutboxTaskRepository) HasPending(ctx context.Context, matchID uint, kind models.OutboxTaskKind) (bool, error) {
+	ret := _m.Called(ctx, matchID, kind)
+
+	if len(ret) == 0 {
+		panic("no return value specified for HasPending")
+	}
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint, models.OutboxTaskKind) (bool, error)); ok {
+		return rf(ctx, matchID, kind)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint, models.OutboxTaskKind) bool); ok {
+		r0 = rf(ctx, matchID, kind)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint, models.OutboxTaskKind) error); ok {
+		r1 = rf(ctx, matchID, kind)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NewOutboxTaskRepository creates a new instance of OutboxTaskRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewOutboxTaskRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *OutboxTaskRepository {
+	mock := &OutboxTaskRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
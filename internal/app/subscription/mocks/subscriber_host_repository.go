@@ -0,0 +1,155 @@
+// Code generated by mockery v2.53.3. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	models "github.com/andrewshostak/result-service/internal/app/models"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// SubscriberHostRepository is an autogenerated mock type for the SubscriberHostRepository type
+type SubscriberHostRepository struct {
+	mock.Mock
+}
+
+// Enable provides a mock function with given fields: ctx, host
+func (_m *SubscriberHostRepository) Enable(ctx context.Context, host string) error {
+	ret := _m.Called(ctx, host)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Enable")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, host)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Get provides a mock function with given fields: ctx, host
+func (_m *SubscriberHostRepository) Get(ctx context.Context, host string) (*models.SubscriberHost, error) {
+	ret := _m.Called(ctx, host)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Get")
+	}
+
+	var r0 *models.SubscriberHost
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*models.SubscriberHost, error)); ok {
+		return rf(ctx, host)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *models.SubscriberHost); ok {
+		r0 = rf(ctx, host)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.SubscriberHost)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, host)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// List provides a mock function with given fields: ctx
+func (_m *SubscriberHostRepository) List(ctx context.Context) ([]models.SubscriberHost, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for List")
+	}
+
+	var r0 []models.SubscriberHost
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]models.SubscriberHost, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []models.SubscriberHost); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.SubscriberHost)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RecordFailure provides a mock function with given fields: ctx, host, errMessage, threshold
+func (_m *SubscriberHostRepository) RecordFailure(ctx context.Context, host string, errMessage string, threshold uint) (*models.SubscriberHost, error) {
+	ret := _m.Called(ctx, host, errMessage, threshold)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RecordFailure")
+	}
+
+	var r0 *models.SubscriberHost
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, uint) (*models.SubscriberHost, error)); ok {
+		return rf(ctx, host, errMessage, threshold)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, uint) *models.SubscriberHost); ok {
+		r0 = rf(ctx, host, errMessage, threshold)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.SubscriberHost)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, uint) error); ok {
+		r1 = rf(ctx, host, errMessage, threshold)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RecordSuccess provides a mock function with given fields: ctx, host
+func (_m *SubscriberHostRepository) RecordSuccess(ctx context.Context, host string) error {
+	ret := _m.Called(ctx, host)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RecordSuccess")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, host)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// NewSubscriberHostRepository creates a new instance of SubscriberHostRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewSubscriberHostRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *SubscriberHostRepository {
+	mock := &SubscriberHostRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
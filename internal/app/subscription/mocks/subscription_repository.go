@@ -7,6 +7,8 @@ import (
 
 	models "github.com/andrewshostak/result-service/internal/app/models"
 	mock "github.com/stretchr/testify/mock"
+
+	time "time"
 )
 
 // SubscriptionRepository is an autogenerated mock type for the SubscriptionRepository type
@@ -152,6 +154,64 @@ func (_m *SubscriptionRepository) One(ctx context.Context, matchID uint, key str
 	return r0, r1
 }
 
+// RotateKey provides a mock function with given fields: ctx, key, newKey, previousKeyTill
+func (_m *SubscriptionRepository) RotateKey(ctx context.Context, key string, newKey string, previousKeyTill time.Time) (int64, error) {
+	ret := _m.Called(ctx, key, newKey, previousKeyTill)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RotateKey")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, time.Time) (int64, error)); ok {
+		return rf(ctx, key, newKey, previousKeyTill)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, time.Time) int64); ok {
+		r0 = rf(ctx, key, newKey, previousKeyTill)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, time.Time) error); ok {
+		r1 = rf(ctx, key, newKey, previousKeyTill)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Search provides a mock function with given fields: ctx, filter
+func (_m *SubscriptionRepository) Search(ctx context.Context, filter models.SubscriptionFilter) ([]models.Subscription, error) {
+	ret := _m.Called(ctx, filter)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Search")
+	}
+
+	var r0 []models.Subscription
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, models.SubscriptionFilter) ([]models.Subscription, error)); ok {
+		return rf(ctx, filter)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, models.SubscriptionFilter) []models.Subscription); ok {
+		r0 = rf(ctx, filter)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.Subscription)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, models.SubscriptionFilter) error); ok {
+		r1 = rf(ctx, filter)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // Update provides a mock function with given fields: ctx, id, _a2
 func (_m *SubscriptionRepository) Update(ctx context.Context, id uint, _a2 models.Subscription) error {
 	ret := _m.Called(ctx, id, _a2)
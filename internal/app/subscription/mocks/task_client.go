@@ -6,6 +6,8 @@ import (
 	context "context"
 
 	mock "github.com/stretchr/testify/mock"
+
+	time "time"
 )
 
 // TaskClient is an autogenerated mock type for the TaskClient type
@@ -13,6 +15,24 @@ type TaskClient struct {
 	mock.Mock
 }
 
+// DeleteKickoffReminderTask provides a mock function with given fields: ctx, subscriptionID, kickoff
+func (_m *TaskClient) DeleteKickoffReminderTask(ctx context.Context, subscriptionID uint, kickoff time.Time) error {
+	ret := _m.Called(ctx, subscriptionID, kickoff)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteKickoffReminderTask")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint, time.Time) error); ok {
+		r0 = rf(ctx, subscriptionID, kickoff)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // DeleteResultCheckTask provides a mock function with given fields: ctx, taskName
 func (_m *TaskClient) DeleteResultCheckTask(ctx context.Context, taskName string) error {
 	ret := _m.Called(ctx, taskName)
@@ -31,6 +51,114 @@ func (_m *TaskClient) DeleteResultCheckTask(ctx context.Context, taskName string
 	return r0
 }
 
+// ScheduleEventDelivery provides a mock function with given fields: ctx, eventDeliveryID, attempt, scheduleAt
+func (_m *TaskClient) ScheduleEventDelivery(ctx context.Context, eventDeliveryID uint, attempt uint, scheduleAt time.Time) error {
+	ret := _m.Called(ctx, eventDeliveryID, attempt, scheduleAt)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ScheduleEventDelivery")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint, uint, time.Time) error); ok {
+		r0 = rf(ctx, eventDeliveryID, attempt, scheduleAt)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ScheduleKickoffReminder provides a mock function with given fields: ctx, subscriptionID, kickoff, scheduleAt
+func (_m *TaskClient) ScheduleKickoffReminder(ctx context.Context, subscriptionID uint, kickoff time.Time, scheduleAt time.Time) error {
+	ret := _m.Called(ctx, subscriptionID, kickoff, scheduleAt)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ScheduleKickoffReminder")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint, time.Time, time.Time) error); ok {
+		r0 = rf(ctx, subscriptionID, kickoff, scheduleAt)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ScheduleLiveCheck provides a mock function with given fields: ctx, matchID, sequence, scheduleAt
+func (_m *TaskClient) ScheduleLiveCheck(ctx context.Context, matchID uint, sequence uint, scheduleAt time.Time) error {
+	ret := _m.Called(ctx, matchID, sequence, scheduleAt)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ScheduleLiveCheck")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint, uint, time.Time) error); ok {
+		r0 = rf(ctx, matchID, sequence, scheduleAt)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ScheduleNotificationBatch provides a mock function with given fields: ctx, notificationBatchID, scheduleAt
+func (_m *TaskClient) ScheduleNotificationBatch(ctx context.Context, notificationBatchID uint, scheduleAt time.Time) error {
+	ret := _m.Called(ctx, notificationBatchID, scheduleAt)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ScheduleNotificationBatch")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint, time.Time) error); ok {
+		r0 = rf(ctx, notificationBatchID, scheduleAt)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ScheduleSubscriberNotification provides a mock function with given fields: ctx, subscriptionID, redeliveryID
+func (_m *TaskClient) ScheduleSubscriberNotification(ctx context.Context, subscriptionID uint, redeliveryID string) error {
+	ret := _m.Called(ctx, subscriptionID, redeliveryID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ScheduleSubscriberNotification")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint, string) error); ok {
+		r0 = rf(ctx, subscriptionID, redeliveryID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ScheduleSubscriberNotificationRetry provides a mock function with given fields: ctx, subscriptionID, retryID, scheduleAt
+func (_m *TaskClient) ScheduleSubscriberNotificationRetry(ctx context.Context, subscriptionID uint, retryID string, scheduleAt time.Time) error {
+	ret := _m.Called(ctx, subscriptionID, retryID, scheduleAt)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ScheduleSubscriberNotificationRetry")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint, string, time.Time) error); ok {
+		r0 = rf(ctx, subscriptionID, retryID, scheduleAt)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // NewTaskClient creates a new instance of TaskClient. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewTaskClient(t interface {
@@ -0,0 +1,96 @@
+package subscription
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/andrewshostak/result-service/internal/app/models"
+	"github.com/google/uuid"
+)
+
+type SubscriberHostService struct {
+	unitOfWork               UnitOfWork
+	subscriberHostRepository SubscriberHostRepository
+	subscriptionRepository   SubscriptionRepository
+	taskClient               TaskClient
+	logger                   Logger
+}
+
+func NewSubscriberHostService(
+	unitOfWork UnitOfWork,
+	subscriberHostRepository SubscriberHostRepository,
+	subscriptionRepository SubscriptionRepository,
+	taskClient TaskClient,
+	logger Logger,
+) *SubscriberHostService {
+	return &SubscriberHostService{
+		unitOfWork:               unitOfWork,
+		subscriberHostRepository: subscriberHostRepository,
+		subscriptionRepository:   subscriptionRepository,
+		taskClient:               taskClient,
+		logger:                   logger,
+	}
+}
+
+func (s *SubscriberHostService) List(ctx context.Context) ([]models.SubscriberHost, error) {
+	hosts, err := s.subscriberHostRepository.List(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list subscriber hosts: %w", err)
+	}
+
+	return hosts, nil
+}
+
+// Enable lifts the suspension of the host and schedules notifications of its suspended subscriptions again.
+// The host and its subscriptions are enabled together, so a failed request changes nothing and can be repeated.
+// It returns the number of re-enabled subscriptions. Suspended event deliveries are not repeated, as the events are outdated.
+func (s *SubscriberHostService) Enable(ctx context.Context, host string) (uint, error) {
+	host = strings.ToLower(host)
+
+	var suspended []models.Subscription
+	err := s.unitOfWork.Do(ctx, func(ctx context.Context) error {
+		if err := s.subscriberHostRepository.Enable(ctx, host); err != nil {
+			return fmt.Errorf("failed to enable subscriber host: %w", err)
+		}
+
+		suspendedStatus := models.SuspendedSub
+		var err error
+		suspended, err = s.subscriptionRepository.Search(ctx, models.SubscriptionFilter{Status: &suspendedStatus, Host: host})
+		if err != nil {
+			return fmt.Errorf("failed to list suspended subscriptions: %w", err)
+		}
+
+		for _, subscription := range suspended {
+			if err := s.subscriptionRepository.Update(ctx, subscription.ID, models.Subscription{Status: models.PendingSub}); err != nil {
+				return fmt.Errorf("failed to update subscription status to %s: %w", models.PendingSub, err)
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	// a subscription which isn't scheduled is left with scheduling error, so the reconciler picks it up
+	var enabled uint
+	for _, subscription := range suspended {
+		if err := s.taskClient.ScheduleSubscriberNotification(ctx, subscription.ID, uuid.NewString()); err != nil {
+			s.logger.Error().Err(err).Uint("subscription_id", subscription.ID).Msg("failed to schedule subscriber notification")
+
+			errUpdate := s.subscriptionRepository.Update(ctx, subscription.ID, models.Subscription{Status: models.SchedulingErrorSub})
+			if errUpdate != nil {
+				s.logger.Error().Err(errUpdate).Uint("subscription_id", subscription.ID).Msg(fmt.Sprintf("failed to update subscription status to: %s", string(models.SchedulingErrorSub)))
+			}
+
+			continue
+		}
+
+		enabled++
+	}
+
+	s.logger.Info().Str("host", host).Uint("number_of_subscriptions", enabled).Msg("subscriber host enabled")
+
+	return enabled, nil
+}
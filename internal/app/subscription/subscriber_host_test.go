@@ -0,0 +1,171 @@
+package subscription_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/andrewshostak/result-service/internal/app/models"
+	sub "github.com/andrewshostak/result-service/internal/app/subscription"
+	"github.com/andrewshostak/result-service/internal/app/subscription/mocks"
+	loggerinternal "github.com/andrewshostak/result-service/internal/infra/logger"
+	"github.com/brianvoe/gofakeit/v6"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestSubscriberHostService_Enable(t *testing.T) {
+	ctx := context.Background()
+	host := "hooks.example.com"
+	unexpectedErr := errors.New("unexpected error")
+
+	firstID, secondID := uint(gofakeit.Uint8())+1, uint(gofakeit.Uint8())+300
+	suspendedStatus := models.SuspendedSub
+	filter := models.SubscriptionFilter{Status: &suspendedStatus, Host: host}
+	suspended := []models.Subscription{
+		{ID: firstID, Url: "https://Hooks.Example.com/results", Status: models.SuspendedSub},
+		{ID: secondID, Url: "https://hooks.example.com/other", Status: models.SuspendedSub},
+	}
+	notificationID := mock.MatchedBy(func(id string) bool { return id != "" })
+
+	tests := []struct {
+		name                     string
+		input                    string
+		subscriberHostRepository func(t *testing.T) *mocks.SubscriberHostRepository
+		subscriptionRepository   func(t *testing.T) *mocks.SubscriptionRepository
+		taskClient               func(t *testing.T) *mocks.TaskClient
+		expected                 uint
+		expectedErr              error
+	}{
+		{
+			name:  "it returns an error when subscriber host is not found",
+			input: host,
+			subscriberHostRepository: func(t *testing.T) *mocks.SubscriberHostRepository {
+				t.Helper()
+				m := mocks.NewSubscriberHostRepository(t)
+				m.On("Enable", ctx, host).Return(models.NewResourceNotFoundError(errors.New("not found"))).Once()
+				return m
+			},
+			expectedErr: fmt.Errorf("failed to enable subscriber host: %w", models.NewResourceNotFoundError(errors.New("not found"))),
+		},
+		{
+			name:  "it returns an error when suspended subscriptions search fails",
+			input: host,
+			subscriberHostRepository: func(t *testing.T) *mocks.SubscriberHostRepository {
+				t.Helper()
+				m := mocks.NewSubscriberHostRepository(t)
+				m.On("Enable", ctx, host).Return(nil).Once()
+				return m
+			},
+			subscriptionRepository: func(t *testing.T) *mocks.SubscriptionRepository {
+				t.Helper()
+				m := mocks.NewSubscriptionRepository(t)
+				m.On("Search", ctx, filter).Return(nil, unexpectedErr).Once()
+				return m
+			},
+			expectedErr: fmt.Errorf("failed to list suspended subscriptions: %w", unexpectedErr),
+		},
+		{
+			name:  "it returns an error and schedules nothing when subscription status update fails",
+			input: host,
+			subscriberHostRepository: func(t *testing.T) *mocks.SubscriberHostRepository {
+				t.Helper()
+				m := mocks.NewSubscriberHostRepository(t)
+				m.On("Enable", ctx, host).Return(nil).Once()
+				return m
+			},
+			subscriptionRepository: func(t *testing.T) *mocks.SubscriptionRepository {
+				t.Helper()
+				m := mocks.NewSubscriptionRepository(t)
+				m.On("Search", ctx, filter).Return(suspended, nil).Once()
+				m.On("Update", ctx, firstID, models.Subscription{Status: models.PendingSub}).Return(nil).Once()
+				m.On("Update", ctx, secondID, models.Subscription{Status: models.PendingSub}).Return(unexpectedErr).Once()
+				return m
+			},
+			expectedErr: fmt.Errorf("failed to update subscription status to %s: %w", models.PendingSub, unexpectedErr),
+		},
+		{
+			name:  "success - it marks subscription with scheduling error when notification scheduling fails",
+			input: host,
+			subscriberHostRepository: func(t *testing.T) *mocks.SubscriberHostRepository {
+				t.Helper()
+				m := mocks.NewSubscriberHostRepository(t)
+				m.On("Enable", ctx, host).Return(nil).Once()
+				return m
+			},
+			subscriptionRepository: func(t *testing.T) *mocks.SubscriptionRepository {
+				t.Helper()
+				m := mocks.NewSubscriptionRepository(t)
+				m.On("Search", ctx, filter).Return(suspended, nil).Once()
+				m.On("Update", ctx, firstID, models.Subscription{Status: models.PendingSub}).Return(nil).Once()
+				m.On("Update", ctx, secondID, models.Subscription{Status: models.PendingSub}).Return(nil).Once()
+				m.On("Update", ctx, firstID, models.Subscription{Status: models.SchedulingErrorSub}).Return(nil).Once()
+				return m
+			},
+			taskClient: func(t *testing.T) *mocks.TaskClient {
+				t.Helper()
+				m := mocks.NewTaskClient(t)
+				m.On("ScheduleSubscriberNotification", ctx, firstID, notificationID).Return(unexpectedErr).Once()
+				m.On("ScheduleSubscriberNotification", ctx, secondID, notificationID).Return(nil).Once()
+				return m
+			},
+			expected: 1,
+		},
+		{
+			name:  "success - it schedules notifications of suspended subscriptions of the host",
+			input: "HOOKS.example.com",
+			subscriberHostRepository: func(t *testing.T) *mocks.SubscriberHostRepository {
+				t.Helper()
+				m := mocks.NewSubscriberHostRepository(t)
+				m.On("Enable", ctx, host).Return(nil).Once()
+				return m
+			},
+			subscriptionRepository: func(t *testing.T) *mocks.SubscriptionRepository {
+				t.Helper()
+				m := mocks.NewSubscriptionRepository(t)
+				m.On("Search", ctx, filter).Return(suspended, nil).Once()
+				m.On("Update", ctx, firstID, models.Subscription{Status: models.PendingSub}).Return(nil).Once()
+				m.On("Update", ctx, secondID, models.Subscription{Status: models.PendingSub}).Return(nil).Once()
+				return m
+			},
+			taskClient: func(t *testing.T) *mocks.TaskClient {
+				t.Helper()
+				m := mocks.NewTaskClient(t)
+				m.On("ScheduleSubscriberNotification", ctx, firstID, notificationID).Return(nil).Once()
+				m.On("ScheduleSubscriberNotification", ctx, secondID, notificationID).Return(nil).Once()
+				return m
+			},
+			expected: 2,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var subscriptionRepository *mocks.SubscriptionRepository
+			if tt.subscriptionRepository != nil {
+				subscriptionRepository = tt.subscriptionRepository(t)
+			}
+
+			var taskClient *mocks.TaskClient
+			if tt.taskClient != nil {
+				taskClient = tt.taskClient(t)
+			}
+
+			unitOfWork := mocks.NewUnitOfWork(t)
+			unitOfWork.On("Do", mock.Anything, mock.Anything).Return(func(ctx context.Context, fn func(ctx context.Context) error) error {
+				return fn(ctx)
+			}).Maybe()
+
+			shs := sub.NewSubscriberHostService(unitOfWork, tt.subscriberHostRepository(t), subscriptionRepository, taskClient, loggerinternal.SetupLogger())
+
+			actual, err := shs.Enable(ctx, tt.input)
+			if tt.expectedErr != nil {
+				assert.EqualError(t, err, tt.expectedErr.Error())
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, tt.expected, actual)
+		})
+	}
+}
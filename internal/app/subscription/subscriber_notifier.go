@@ -2,30 +2,54 @@ package subscription
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"maps"
+	"math/rand/v2"
+	"net/http"
 	"time"
 
+	"github.com/andrewshostak/result-service/config"
 	"github.com/andrewshostak/result-service/internal/app/models"
+	"github.com/google/uuid"
 )
 
 type SubscriberNotifierService struct {
-	subscriptionRepository SubscriptionRepository
-	matchRepository        MatchRepository
-	notifierClient         NotifierClient
-	logger                 Logger
+	config                        config.Notification
+	subscriptionRepository        SubscriptionRepository
+	matchRepository               MatchRepository
+	notificationAttemptRepository NotificationAttemptRepository
+	eventDeliveryRepository       EventDeliveryRepository
+	subscriberHostRepository      SubscriberHostRepository
+	notificationBatchRepository   NotificationBatchRepository
+	notifierClient                NotifierClient
+	taskClient                    TaskClient
+	logger                        Logger
 }
 
 func NewSubscriberNotifierService(
+	config config.Notification,
 	subscriptionRepository SubscriptionRepository,
 	matchRepository MatchRepository,
+	notificationAttemptRepository NotificationAttemptRepository,
+	eventDeliveryRepository EventDeliveryRepository,
+	subscriberHostRepository SubscriberHostRepository,
+	notificationBatchRepository NotificationBatchRepository,
 	notifierClient NotifierClient,
+	taskClient TaskClient,
 	logger Logger,
 ) *SubscriberNotifierService {
 	return &SubscriberNotifierService{
-		subscriptionRepository: subscriptionRepository,
-		matchRepository:        matchRepository,
-		notifierClient:         notifierClient,
-		logger:                 logger,
+		config:                        config,
+		subscriptionRepository:        subscriptionRepository,
+		matchRepository:               matchRepository,
+		notificationAttemptRepository: notificationAttemptRepository,
+		eventDeliveryRepository:       eventDeliveryRepository,
+		subscriberHostRepository:      subscriberHostRepository,
+		notificationBatchRepository:   notificationBatchRepository,
+		notifierClient:                notifierClient,
+		taskClient:                    taskClient,
+		logger:                        logger,
 	}
 }
 
@@ -40,6 +64,33 @@ func (s *SubscriberNotifierService) NotifySubscriber(ctx context.Context, subscr
 		return nil
 	}
 
+	if sub.Status == models.UnsubscribedSub {
+		s.logger.Info().Uint("subscription_id", sub.ID).Msg("subscriber has unsubscribed")
+		return nil
+	}
+
+	host := urlHost(sub.Url)
+	suspended, err := s.isHostSuspended(ctx, host)
+	if err != nil {
+		return err
+	}
+
+	if suspended {
+		errMessage := suspensionError(host)
+		if err := s.subscriptionRepository.Update(ctx, sub.ID, models.Subscription{Status: models.SuspendedSub, SubscriberError: &errMessage}); err != nil {
+			return fmt.Errorf("failed to update subscription status to %s: %w", string(models.SuspendedSub), err)
+		}
+
+		s.logger.Info().Uint("subscription_id", sub.ID).Str("host", host).Msg("subscriber notification suspended")
+
+		return nil
+	}
+
+	// a subscription joins a batch once, after the batch it is notified individually
+	if sub.BatchURL != nil && sub.NotificationBatchID == nil {
+		return s.joinBatch(ctx, *sub)
+	}
+
 	m, err := s.matchRepository.One(ctx, models.Match{ID: sub.MatchID})
 	if err != nil {
 		return fmt.Errorf("failed to get match: %w", err)
@@ -49,31 +100,24 @@ func (s *SubscriberNotifierService) NotifySubscriber(ctx context.Context, subscr
 		return fmt.Errorf("match relation external match doesn't exist")
 	}
 
-	err = s.notifierClient.Notify(ctx, models.SubscriberNotification{
-		Url:  sub.Url,
-		Key:  sub.Key,
-		Home: uint(m.ExternalMatch.HomeScore),
-		Away: uint(m.ExternalMatch.AwayScore),
-	})
+	notification := s.resultNotification(*sub, *m)
+
+	executedAt := time.Now()
+	response, err := s.notifierClient.Notify(ctx, notification)
+	s.saveAttempt(ctx, sub.ID, notification, executedAt, response, err)
 	if err != nil {
 		s.logger.Error().Err(err).Uint("subscription_id", sub.ID).Msg("failed to notify subscriber")
 
-		errMessage := err.Error()
-		errUpdate := s.subscriptionRepository.Update(ctx, sub.ID, models.Subscription{
-			Status:          models.SubscriberErrorSub,
-			SubscriberError: &errMessage,
-		})
-		if errUpdate != nil {
-			s.logger.Error().Err(errUpdate).Uint("subscription_id", sub.ID).Msg(fmt.Sprintf("failed to update subscription status to: %s", string(models.SubscriberErrorSub)))
-		}
-
-		return fmt.Errorf("failed to notify subscriber: %w", err)
+		return s.handleFailedNotification(ctx, *sub, host, response, err)
 	}
 
+	s.recordSuccess(ctx, host)
+
 	notifiedAt := time.Now()
 	errUpdate := s.subscriptionRepository.Update(ctx, sub.ID, models.Subscription{
-		Status:     models.SuccessfulSub,
-		NotifiedAt: &notifiedAt,
+		Status:           models.SuccessfulSub,
+		NotifiedAt:       &notifiedAt,
+		DeliveryAttempts: sub.DeliveryAttempts,
 	})
 	if errUpdate != nil {
 		s.logger.Error().Err(errUpdate).Uint("subscription_id", sub.ID).Msg(fmt.Sprintf("failed to update subscription status to: %s", string(models.SuccessfulSub)))
@@ -85,6 +129,555 @@ func (s *SubscriberNotifierService) NotifySubscriber(ctx context.Context, subscr
 	return nil
 }
 
+// NotifyBatch delivers result notifications of the batch subscriptions in a single request to the batch url.
+// Notifications which are not acknowledged by the subscriber fall back to individual delivery with its retry policy,
+// so a batch is never repeated as a whole.
+func (s *SubscriberNotifierService) NotifyBatch(ctx context.Context, notificationBatchID uint) error {
+	batch, err := s.notificationBatchRepository.Close(ctx, notificationBatchID)
+	if err != nil {
+		return fmt.Errorf("failed to close notification batch: %w", err)
+	}
+
+	pendingStatus := models.PendingSub
+	subscriptions, err := s.subscriptionRepository.Search(ctx, models.SubscriptionFilter{NotificationBatchID: &batch.ID, Status: &pendingStatus})
+	if err != nil {
+		return fmt.Errorf("failed to list subscriptions of notification batch: %w", err)
+	}
+
+	if len(subscriptions) == 0 {
+		s.logger.Info().Uint("notification_batch_id", batch.ID).Msg("notification batch has no pending subscriptions")
+		return nil
+	}
+
+	host := urlHost(batch.BatchURL)
+	suspended, err := s.isHostSuspended(ctx, host)
+	if err != nil {
+		return err
+	}
+
+	// notifications of a suspended host are suspended by the individual delivery
+	if suspended {
+		s.fallback(ctx, subscriptions)
+		return nil
+	}
+
+	items := make([]models.BatchNotificationItem, 0, len(subscriptions))
+	batched := make([]models.Subscription, 0, len(subscriptions))
+	var unbatched []models.Subscription
+	for _, subscription := range subscriptions {
+		// the batch request is signed and authenticated as the first batched subscription,
+		// so a subscription which differs from it is delivered individually
+		if len(batched) > 0 && !isSameRequest(batched[0], subscription) {
+			unbatched = append(unbatched, subscription)
+			continue
+		}
+
+		m, err := s.matchRepository.One(ctx, models.Match{ID: subscription.MatchID})
+		if err != nil || m.ExternalMatch == nil {
+			s.logger.Error().Err(err).Uint("subscription_id", subscription.ID).Msg("failed to get match result of batched subscription")
+			unbatched = append(unbatched, subscription)
+			continue
+		}
+
+		items = append(items, models.BatchNotificationItem{SubscriptionID: subscription.ID, Notification: s.resultNotification(subscription, *m)})
+		batched = append(batched, subscription)
+	}
+
+	if len(items) == 0 {
+		s.fallback(ctx, unbatched)
+		return nil
+	}
+
+	executedAt := time.Now()
+	response, err := s.notifierClient.NotifyBatch(ctx, models.BatchNotification{
+		DeliveryID:  uuid.NewString(),
+		Url:         batch.BatchURL,
+		Key:         batched[0].Key,
+		PreviousKey: s.previousKey(batched[0]),
+		Headers:     batched[0].Headers,
+		AuthHeader:  batched[0].AuthHeader,
+		AuthScheme:  batched[0].AuthScheme,
+		Items:       items,
+	})
+	if err != nil {
+		s.logger.Error().Err(err).Uint("notification_batch_id", batch.ID).Msg("failed to deliver notification batch")
+
+		// a transient failure counts against the host as for individual deliveries
+		if !s.isGone(response) && !s.isPermanentFailure(response, err) {
+			s.recordFailure(ctx, host, err.Error())
+		}
+	} else {
+		s.recordSuccess(ctx, host)
+	}
+
+	acknowledged := map[string]struct{}{}
+	if err == nil && response != nil {
+		for _, deliveryID := range response.Acknowledged {
+			acknowledged[deliveryID] = struct{}{}
+		}
+	}
+
+	notifiedAt := time.Now()
+	var numberOfAcknowledged int
+	for i, item := range items {
+		if _, ok := acknowledged[item.Notification.DeliveryID]; !ok {
+			itemErr := err
+			if itemErr == nil {
+				itemErr = errors.New("notification is not acknowledged in the batch response")
+			}
+
+			s.saveAttempt(ctx, item.SubscriptionID, item.Notification, executedAt, response, itemErr)
+			unbatched = append(unbatched, batched[i])
+			continue
+		}
+
+		s.saveAttempt(ctx, item.SubscriptionID, item.Notification, executedAt, response, nil)
+		numberOfAcknowledged++
+
+		notified := models.Subscription{Status: models.SuccessfulSub, NotifiedAt: &notifiedAt, DeliveryAttempts: batched[i].DeliveryAttempts}
+		if err := s.subscriptionRepository.Update(ctx, item.SubscriptionID, notified); err != nil {
+			s.logger.Error().Err(err).Uint("subscription_id", item.SubscriptionID).Msg(fmt.Sprintf("failed to update subscription status to: %s", string(models.SuccessfulSub)))
+		}
+	}
+
+	s.fallback(ctx, unbatched)
+
+	s.logger.Info().
+		Uint("notification_batch_id", batch.ID).
+		Int("number_of_items", len(items)).
+		Int("number_of_acknowledged", numberOfAcknowledged).
+		Msg("notification batch delivered")
+
+	return nil
+}
+
+// NotifyEvent delivers the match event to the subscriber. Delivery id stays the same across the attempts,
+// so the subscriber is able to process the event only once.
+func (s *SubscriberNotifierService) NotifyEvent(ctx context.Context, eventDeliveryID uint) error {
+	delivery, err := s.eventDeliveryRepository.Get(ctx, eventDeliveryID)
+	if err != nil {
+		return fmt.Errorf("failed to get event delivery by id: %w", err)
+	}
+
+	if delivery.Status == models.SuccessfulSub {
+		s.logger.Error().Uint("event_delivery_id", delivery.ID).Msg("event is already delivered")
+		return nil
+	}
+
+	if delivery.MatchEvent == nil {
+		return fmt.Errorf("event delivery relation match event doesn't exist")
+	}
+
+	sub, err := s.subscriptionRepository.Get(ctx, delivery.SubscriptionID)
+	if err != nil {
+		return fmt.Errorf("failed to get subscription by id: %w", err)
+	}
+
+	host := urlHost(sub.Url)
+	suspended, err := s.isHostSuspended(ctx, host)
+	if err != nil {
+		return err
+	}
+
+	if sub.Status == models.UnsubscribedSub || suspended {
+		skipped := models.EventDelivery{Status: models.UnsubscribedSub, DeliveryAttempts: delivery.DeliveryAttempts}
+		if suspended {
+			errMessage := suspensionError(host)
+			skipped = models.EventDelivery{Status: models.SuspendedSub, SubscriberError: &errMessage, DeliveryAttempts: delivery.DeliveryAttempts}
+		}
+
+		if err := s.eventDeliveryRepository.Update(ctx, delivery.ID, skipped); err != nil {
+			return fmt.Errorf("failed to update event delivery status to %s: %w", string(skipped.Status), err)
+		}
+
+		s.logger.Info().Uint("event_delivery_id", delivery.ID).Str("status", string(skipped.Status)).Msg("event delivery skipped")
+
+		return nil
+	}
+
+	m, err := s.matchRepository.One(ctx, models.Match{ID: sub.MatchID})
+	if err != nil {
+		return fmt.Errorf("failed to get match: %w", err)
+	}
+
+	if m.ExternalMatch == nil {
+		return fmt.Errorf("match relation external match doesn't exist")
+	}
+
+	event := delivery.MatchEvent
+	notification := s.notification(*sub, *m)
+	notification.DeliveryID = delivery.DeliveryID
+	notification.EventType = event.Type
+	notification.StartsAt = event.StartsAt
+	notification.Home = uint(event.HomeScore)
+	notification.Away = uint(event.AwayScore)
+
+	executedAt := time.Now()
+	response, err := s.notifierClient.Notify(ctx, notification)
+	s.saveAttempt(ctx, sub.ID, notification, executedAt, response, err)
+	if err != nil {
+		s.logger.Error().Err(err).Uint("event_delivery_id", delivery.ID).Msg("failed to deliver event")
+
+		return s.handleFailedEventDelivery(ctx, *delivery, host, response, err)
+	}
+
+	s.recordSuccess(ctx, host)
+
+	notifiedAt := time.Now()
+	errUpdate := s.eventDeliveryRepository.Update(ctx, delivery.ID, models.EventDelivery{
+		Status:           models.SuccessfulSub,
+		NotifiedAt:       &notifiedAt,
+		DeliveryAttempts: delivery.DeliveryAttempts,
+	})
+	if errUpdate != nil {
+		return fmt.Errorf("failed to update event delivery status to %s: %w", string(models.SuccessfulSub), errUpdate)
+	}
+
+	s.logger.Debug().Uint("event_delivery_id", delivery.ID).Msg("event delivered")
+
+	return nil
+}
+
+// handleFailedEventDelivery applies the same retry policy as to the subscription notifications.
+// Each retry is a task named after the attempt number, so the attempt is scheduled only once.
+func (s *SubscriberNotifierService) handleFailedEventDelivery(
+	ctx context.Context,
+	delivery models.EventDelivery,
+	host string,
+	response *models.NotificationResponse,
+	notifyErr error,
+) error {
+	errMessage := notifyErr.Error()
+	failed := models.EventDelivery{SubscriberError: &errMessage, DeliveryAttempts: delivery.DeliveryAttempts + 1}
+
+	switch {
+	case s.isGone(response):
+		failed.Status = models.UnsubscribedSub
+		if err := s.unsubscribe(ctx, delivery.SubscriptionID, errMessage); err != nil {
+			return err
+		}
+	case s.isPermanentFailure(response, notifyErr):
+		failed.Status = models.SubscriberErrorSub
+	case s.recordFailure(ctx, host, errMessage):
+		failed.Status = models.SuspendedSub
+	case failed.DeliveryAttempts >= s.config.MaxAttempts:
+		failed.Status = models.DeadLetterSub
+	default:
+		failed.Status = models.PendingSub
+
+		scheduleAt := time.Now().Add(s.retryDelay(failed.DeliveryAttempts, response))
+		err := s.taskClient.ScheduleEventDelivery(ctx, delivery.ID, failed.DeliveryAttempts, scheduleAt)
+		if err != nil && !errors.As(err, &models.ResourceAlreadyExistsError{}) {
+			failed.Status = models.SchedulingErrorSub
+			if errUpdate := s.eventDeliveryRepository.Update(ctx, delivery.ID, failed); errUpdate != nil {
+				s.logger.Error().Err(errUpdate).Uint("event_delivery_id", delivery.ID).Msg(fmt.Sprintf("failed to update event delivery status to: %s", string(models.SchedulingErrorSub)))
+			}
+
+			return fmt.Errorf("failed to schedule event delivery retry: %w", err)
+		}
+
+		s.logger.Info().Uint("event_delivery_id", delivery.ID).Uint("attempts", failed.DeliveryAttempts).Time("schedule_at", scheduleAt).Msg("event delivery retry scheduled")
+	}
+
+	if err := s.eventDeliveryRepository.Update(ctx, delivery.ID, failed); err != nil {
+		return fmt.Errorf("failed to update event delivery status to %s: %w", string(failed.Status), err)
+	}
+
+	return nil
+}
+
+// handleFailedNotification applies the retry policy to a failed notification.
+// Transient failures are retried with exponential backoff until max attempts are reached, then the subscription is dead-lettered.
+// Transient failures are counted against the subscriber host as well, and the subscription is suspended once the host is.
+// Permanent failures are not retried, 410 Gone unsubscribes the subscriber. A handled failure returns nil, so the queue doesn't retry the task on its own.
+func (s *SubscriberNotifierService) handleFailedNotification(
+	ctx context.Context,
+	subscription models.Subscription,
+	host string,
+	response *models.NotificationResponse,
+	notifyErr error,
+) error {
+	errMessage := notifyErr.Error()
+	failed := models.Subscription{SubscriberError: &errMessage, DeliveryAttempts: subscription.DeliveryAttempts + 1}
+
+	switch {
+	case s.isGone(response):
+		failed.Status = models.UnsubscribedSub
+	case s.isPermanentFailure(response, notifyErr):
+		failed.Status = models.SubscriberErrorSub
+	case s.recordFailure(ctx, host, errMessage):
+		failed.Status = models.SuspendedSub
+	case failed.DeliveryAttempts >= s.config.MaxAttempts:
+		failed.Status = models.DeadLetterSub
+	default:
+		failed.Status = models.PendingSub
+
+		scheduleAt := time.Now().Add(s.retryDelay(failed.DeliveryAttempts, response))
+		if err := s.taskClient.ScheduleSubscriberNotificationRetry(ctx, subscription.ID, uuid.NewString(), scheduleAt); err != nil {
+			failed.Status = models.SchedulingErrorSub
+			if errUpdate := s.subscriptionRepository.Update(ctx, subscription.ID, failed); errUpdate != nil {
+				s.logger.Error().Err(errUpdate).Uint("subscription_id", subscription.ID).Msg(fmt.Sprintf("failed to update subscription status to: %s", string(models.SchedulingErrorSub)))
+			}
+
+			return fmt.Errorf("failed to schedule subscriber notification retry: %w", err)
+		}
+
+		s.logger.Info().Uint("subscription_id", subscription.ID).Uint("attempts", failed.DeliveryAttempts).Time("schedule_at", scheduleAt).Msg("subscriber notification retry scheduled")
+	}
+
+	if err := s.subscriptionRepository.Update(ctx, subscription.ID, failed); err != nil {
+		return fmt.Errorf("failed to update subscription status to %s: %w", string(failed.Status), err)
+	}
+
+	return nil
+}
+
+// joinBatch adds the result notification to the open batch of the subscription batch url, the batch is delivered when its window is over.
+func (s *SubscriberNotifierService) joinBatch(ctx context.Context, subscription models.Subscription) error {
+	batch, err := s.notificationBatchRepository.Join(ctx, subscription.ID, time.Now().Add(s.config.BatchWindow))
+	if err != nil {
+		return fmt.Errorf("failed to join notification batch: %w", err)
+	}
+
+	err = s.taskClient.ScheduleNotificationBatch(ctx, batch.ID, batch.DeliverAt)
+	if err != nil && !errors.As(err, &models.ResourceAlreadyExistsError{}) {
+		// the subscription has joined the batch, so it is notified individually when the scheduling error is reconciled
+		errUpdate := s.subscriptionRepository.Update(ctx, subscription.ID, models.Subscription{Status: models.SchedulingErrorSub})
+		if errUpdate != nil {
+			s.logger.Error().Err(errUpdate).Uint("subscription_id", subscription.ID).Msg(fmt.Sprintf("failed to update subscription status to: %s", string(models.SchedulingErrorSub)))
+		}
+
+		return fmt.Errorf("failed to schedule notification batch: %w", err)
+	}
+
+	s.logger.Debug().Uint("subscription_id", subscription.ID).Uint("notification_batch_id", batch.ID).Msg("subscriber notification batched")
+
+	return nil
+}
+
+// isSameRequest reports whether notifications of the subscriptions are sent with the same key and headers.
+func isSameRequest(a, b models.Subscription) bool {
+	return a.Key == b.Key &&
+		maps.Equal(a.Headers, b.Headers) &&
+		isSameOptional(a.AuthHeader, b.AuthHeader) &&
+		isSameOptional(a.AuthScheme, b.AuthScheme)
+}
+
+func isSameOptional(a, b *string) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+
+	return *a == *b
+}
+
+// fallback schedules individual notifications of the subscriptions which weren't notified by the batch.
+func (s *SubscriberNotifierService) fallback(ctx context.Context, subscriptions []models.Subscription) {
+	for _, subscription := range subscriptions {
+		if err := s.taskClient.ScheduleSubscriberNotification(ctx, subscription.ID, uuid.NewString()); err != nil {
+			s.logger.Error().Err(err).Uint("subscription_id", subscription.ID).Msg("failed to schedule individual subscriber notification")
+
+			errUpdate := s.subscriptionRepository.Update(ctx, subscription.ID, models.Subscription{Status: models.SchedulingErrorSub})
+			if errUpdate != nil {
+				s.logger.Error().Err(errUpdate).Uint("subscription_id", subscription.ID).Msg(fmt.Sprintf("failed to update subscription status to: %s", string(models.SchedulingErrorSub)))
+			}
+		}
+	}
+}
+
+// isPermanentFailure reports whether the subscriber rejected the notification, so repeating it doesn't make sense.
+// Client errors are permanent except for timeouts and rate limiting, network errors and server errors are transient.
+// A destination which isn't allowed is permanent as well.
+func (s *SubscriberNotifierService) isPermanentFailure(response *models.NotificationResponse, notifyErr error) bool {
+	if errors.Is(notifyErr, models.ErrDestinationNotAllowed) {
+		return true
+	}
+
+	if response == nil || response.StatusCode == nil {
+		return false
+	}
+
+	statusCode := *response.StatusCode
+	if statusCode == http.StatusRequestTimeout || statusCode == http.StatusTooManyRequests {
+		return false
+	}
+
+	return statusCode >= http.StatusBadRequest && statusCode < http.StatusInternalServerError
+}
+
+// isGone reports whether the subscriber responded with 410 Gone, which means the endpoint is removed for good.
+func (s *SubscriberNotifierService) isGone(response *models.NotificationResponse) bool {
+	return response != nil && response.StatusCode != nil && *response.StatusCode == http.StatusGone
+}
+
+// unsubscribe marks the subscription as unsubscribed, so no more notifications and events are delivered to it.
+func (s *SubscriberNotifierService) unsubscribe(ctx context.Context, subscriptionID uint, errMessage string) error {
+	err := s.subscriptionRepository.Update(ctx, subscriptionID, models.Subscription{Status: models.UnsubscribedSub, SubscriberError: &errMessage})
+	if err != nil {
+		return fmt.Errorf("failed to update subscription status to %s: %w", string(models.UnsubscribedSub), err)
+	}
+
+	s.logger.Info().Uint("subscription_id", subscriptionID).Msg("subscriber unsubscribed")
+
+	return nil
+}
+
+// isHostSuspended reports whether deliveries to the host are suspended. Hosts without delivery history are not.
+func (s *SubscriberNotifierService) isHostSuspended(ctx context.Context, host string) (bool, error) {
+	if host == "" {
+		return false, nil
+	}
+
+	subscriberHost, err := s.subscriberHostRepository.Get(ctx, host)
+	if errors.As(err, &models.ResourceNotFoundError{}) {
+		return false, nil
+	}
+
+	if err != nil {
+		return false, fmt.Errorf("failed to get subscriber host: %w", err)
+	}
+
+	return subscriberHost.SuspendedAt != nil, nil
+}
+
+// recordSuccess resets the failures of the host. Failure to record it is only logged, as the delivery itself already happened.
+func (s *SubscriberNotifierService) recordSuccess(ctx context.Context, host string) {
+	if host == "" {
+		return
+	}
+
+	if err := s.subscriberHostRepository.RecordSuccess(ctx, host); err != nil {
+		s.logger.Error().Err(err).Str("host", host).Msg("failed to record subscriber host success")
+	}
+}
+
+// recordFailure counts the transient failure against the host and reports whether deliveries to the host are suspended.
+func (s *SubscriberNotifierService) recordFailure(ctx context.Context, host string, errMessage string) bool {
+	if host == "" {
+		return false
+	}
+
+	subscriberHost, err := s.subscriberHostRepository.RecordFailure(ctx, host, errMessage, s.config.HostFailureThreshold)
+	if err != nil {
+		s.logger.Error().Err(err).Str("host", host).Msg("failed to record subscriber host failure")
+		return false
+	}
+
+	if subscriberHost.SuspendedAt == nil {
+		return false
+	}
+
+	s.logger.Info().Str("host", host).Uint("consecutive_failures", subscriberHost.ConsecutiveFailures).Msg("deliveries to subscriber host are suspended")
+
+	return true
+}
+
+// retryDelay returns exponentially growing delay with jitter. Retry-After of the subscriber is honored when it is longer,
+// up to the max retry delay, so a subscriber can't postpone its notification indefinitely.
+func (s *SubscriberNotifierService) retryDelay(attempts uint, response *models.NotificationResponse) time.Duration {
+	delay := s.config.RetryBaseDelay
+	for i := uint(1); i < attempts && delay < s.config.RetryMaxDelay; i++ {
+		delay *= 2
+	}
+
+	if delay > s.config.RetryMaxDelay {
+		delay = s.config.RetryMaxDelay
+	}
+
+	delay = delay/2 + rand.N(delay/2+1)
+
+	if response != nil && response.RetryAfter != nil && *response.RetryAfter > delay {
+		delay = min(*response.RetryAfter, s.config.RetryMaxDelay)
+	}
+
+	return delay
+}
+
+// saveAttempt stores the notification attempt in the delivery history.
+// Failure to save it is only logged, as the delivery itself already happened.
+func (s *SubscriberNotifierService) saveAttempt(
+	ctx context.Context,
+	subscriptionID uint,
+	notification models.SubscriberNotification,
+	executedAt time.Time,
+	response *models.NotificationResponse,
+	notifyErr error,
+) {
+	attempt := models.NotificationAttempt{
+		SubscriptionID: subscriptionID,
+		DeliveryID:     notification.DeliveryID,
+		EventType:      notification.EventType,
+		ExecutedAt:     executedAt,
+	}
+
+	if response != nil {
+		attempt.RequestPayload = response.RequestPayload
+		attempt.ResponseStatus = response.StatusCode
+		attempt.ResponseBody = response.Body
+		attempt.Latency = response.Latency
+	}
+
+	if notifyErr != nil {
+		errMessage := notifyErr.Error()
+		attempt.ErrorMessage = &errMessage
+	}
+
+	if _, err := s.notificationAttemptRepository.Create(ctx, attempt); err != nil {
+		s.logger.Error().Err(err).Uint("subscription_id", subscriptionID).Str("delivery_id", notification.DeliveryID).Msg("failed to save notification attempt")
+	}
+}
+
+// notification returns the notification of the subscription about the match without event specific data.
+func (s *SubscriberNotifierService) notification(subscription models.Subscription, match models.Match) models.SubscriberNotification {
+	return models.SubscriberNotification{
+		PayloadVersion:  subscription.PayloadVersion,
+		DeliveryFormat:  subscription.DeliveryFormat,
+		Channel:         subscription.Channel,
+		HTTPMethod:      subscription.HTTPMethod,
+		Headers:         subscription.Headers,
+		AuthHeader:      subscription.AuthHeader,
+		AuthScheme:      subscription.AuthScheme,
+		Url:             subscription.Url,
+		Key:             subscription.Key,
+		PreviousKey:     s.previousKey(subscription),
+		MatchID:         match.ID,
+		ExternalMatchID: match.ExternalMatch.ID,
+		StartsAt:        match.StartsAt,
+		HomeTeam:        s.team(match.HomeTeam, match.HomeTeamID),
+		AwayTeam:        s.team(match.AwayTeam, match.AwayTeamID),
+	}
+}
+
+// resultNotification returns the notification of the subscription about the received match result.
+func (s *SubscriberNotifierService) resultNotification(subscription models.Subscription, match models.Match) models.SubscriberNotification {
+	notification := s.notification(subscription, match)
+	notification.DeliveryID = subscription.DeliveryID
+	notification.EventType = models.EventResultFinished
+	notification.FinishType = match.ExternalMatch.FinishType
+	notification.Home = uint(match.ExternalMatch.HomeScore)
+	notification.Away = uint(match.ExternalMatch.AwayScore)
+
+	return notification
+}
+
+// previousKey returns the previous key of the subscription while its rotation window lasts.
+func (s *SubscriberNotifierService) previousKey(subscription models.Subscription) *string {
+	if subscription.PreviousKey == nil || subscription.PreviousKeyTill == nil || subscription.PreviousKeyTill.Before(time.Now()) {
+		return nil
+	}
+
+	return subscription.PreviousKey
+}
+
+// team returns the loaded team of the match or a team without aliases when the relation is not loaded.
+func (s *SubscriberNotifierService) team(team *models.Team, teamID uint) models.Team {
+	if team == nil {
+		return models.Team{ID: teamID}
+	}
+
+	return *team
+}
+
 func (s *SubscriberNotifierService) isNotified(subscription models.Subscription) bool {
 	return subscription.Status == models.SuccessfulSub
 }
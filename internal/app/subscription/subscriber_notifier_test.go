@@ -4,9 +4,11 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"net/url"
 	"testing"
 	"time"
 
+	"github.com/andrewshostak/result-service/config"
 	"github.com/andrewshostak/result-service/internal/app/models"
 	sub "github.com/andrewshostak/result-service/internal/app/subscription"
 	"github.com/andrewshostak/result-service/internal/app/subscription/mocks"
@@ -15,6 +17,7 @@ import (
 	"github.com/brianvoe/gofakeit/v6"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
 )
 
 func TestSubscriberNotifierService_NotifySubscriber(t *testing.T) {
@@ -23,6 +26,8 @@ func TestSubscriberNotifierService_NotifySubscriber(t *testing.T) {
 
 	errorMessage := "unexpected error"
 	unexpectedErr := errors.New(errorMessage)
+	blockedErr := fmt.Errorf("failed to send request to notify subscribers: %w: 10.0.0.1", models.ErrDestinationNotAllowed)
+	blockedErrorMessage := blockedErr.Error()
 
 	subscription := testutils.FakeSubscription(func(s *models.Subscription) {
 		s.ID = subscriptionID
@@ -44,15 +49,98 @@ func TestSubscriberNotifierService_NotifySubscriber(t *testing.T) {
 		n.Away = uint(match.ExternalMatch.AwayScore)
 		n.Url = subscription.Url
 		n.Key = subscription.Key
+		n.EventType = models.EventResultFinished
+		n.PayloadVersion = subscription.PayloadVersion
+		n.DeliveryFormat = subscription.DeliveryFormat
+		n.MatchID = match.ID
+		n.ExternalMatchID = externalMatch.ID
+		n.StartsAt = match.StartsAt
+		n.HomeTeam = models.Team{ID: match.HomeTeamID}
+		n.AwayTeam = models.Team{ID: match.AwayTeamID}
+		n.FinishType = externalMatch.FinishType
+		n.DeliveryID = subscription.DeliveryID
 	})
 
+	previousKey := gofakeit.Password(true, true, true, false, false, 10)
+	previousKeyTill := time.Now().Add(time.Hour)
+	rotatedSubscription := subscription
+	rotatedSubscription.PreviousKey = &previousKey
+	rotatedSubscription.PreviousKeyTill = &previousKeyTill
+
+	dualSignedNotification := notification
+	dualSignedNotification.PreviousKey = &previousKey
+
+	okStatusCode, unavailableStatusCode := 200, 503
+	responseBody := "unavailable"
+	response := models.NotificationResponse{
+		RequestPayload: []byte(fmt.Sprintf(`{"home":%d,"away":%d}`, notification.Home, notification.Away)),
+		StatusCode:     &okStatusCode,
+		Latency:        150 * time.Millisecond,
+	}
+	failedResponse := models.NotificationResponse{
+		RequestPayload: response.RequestPayload,
+		StatusCode:     &unavailableStatusCode,
+		Body:           &responseBody,
+		Latency:        30 * time.Millisecond,
+	}
+
+	badRequestStatusCode, tooManyRequestsStatusCode := 400, 429
+	rejectedResponse := failedResponse
+	rejectedResponse.StatusCode = &badRequestStatusCode
+	retryAfter := 45 * time.Minute
+	rateLimitedResponse := failedResponse
+	rateLimitedResponse.StatusCode = &tooManyRequestsStatusCode
+	rateLimitedResponse.RetryAfter = &retryAfter
+	longRetryAfter := 2 * time.Hour
+	longRateLimitedResponse := rateLimitedResponse
+	longRateLimitedResponse.RetryAfter = &longRetryAfter
+
+	notificationConfig := config.Notification{MaxAttempts: 3, RetryBaseDelay: time.Minute, RetryMaxDelay: time.Hour, HostFailureThreshold: 10, BatchWindow: 10 * time.Second}
+	exhaustedSubscription := subscription
+
+	host := subscriptionHost(t, subscription)
+	suspendedAt := time.Now()
+	suspensionMessage := fmt.Sprintf("deliveries to host %s are suspended", host)
+	goneStatusCode := 410
+	goneResponse := failedResponse
+	goneResponse.StatusCode = &goneStatusCode
+	unsubscribedSubscription := subscription
+	unsubscribedSubscription.Status = models.UnsubscribedSub
+	exhaustedSubscription.DeliveryAttempts = notificationConfig.MaxAttempts - 1
+
+	batchURL := subscription.Url + "/batch"
+	batchedSubscription := subscription
+	batchedSubscription.BatchURL = &batchURL
+	notificationBatch := models.NotificationBatch{ID: uint(gofakeit.Uint16()), BatchURL: batchURL, Status: models.BatchOpen, DeliverAt: time.Now().Add(notificationConfig.BatchWindow)}
+
+	attempt := models.NotificationAttempt{
+		SubscriptionID: subscriptionID,
+		EventType:      models.EventResultFinished,
+		RequestPayload: response.RequestPayload,
+		ResponseStatus: response.StatusCode,
+		Latency:        response.Latency,
+	}
+	failedAttempt := models.NotificationAttempt{
+		SubscriptionID: subscriptionID,
+		EventType:      models.EventResultFinished,
+		RequestPayload: failedResponse.RequestPayload,
+		ResponseStatus: failedResponse.StatusCode,
+		ResponseBody:   failedResponse.Body,
+		Latency:        failedResponse.Latency,
+		ErrorMessage:   &errorMessage,
+	}
+
 	tests := []struct {
-		name                   string
-		input                  uint
-		matchRepository        func(t *testing.T) *mocks.MatchRepository
-		notifierClient         func(t *testing.T) *mocks.NotifierClient
-		subscriptionRepository func(t *testing.T) *mocks.SubscriptionRepository
-		expectedErr            error
+		name                          string
+		input                         uint
+		matchRepository               func(t *testing.T) *mocks.MatchRepository
+		notifierClient                func(t *testing.T) *mocks.NotifierClient
+		subscriptionRepository        func(t *testing.T) *mocks.SubscriptionRepository
+		notificationAttemptRepository func(t *testing.T) *mocks.NotificationAttemptRepository
+		subscriberHostRepository      func(t *testing.T) *mocks.SubscriberHostRepository
+		notificationBatchRepository   func(t *testing.T) *mocks.NotificationBatchRepository
+		taskClient                    func(t *testing.T) *mocks.TaskClient
+		expectedErr                   error
 	}{
 		{
 			name:  "success - it returns nil when processing unnotified subscription",
@@ -64,6 +152,13 @@ func TestSubscriberNotifierService_NotifySubscriber(t *testing.T) {
 				m.On("Update", ctx, subscriptionID, mock.MatchedBy(subscriptionMatchedFunc)).Return(nil).Once()
 				return m
 			},
+			subscriberHostRepository: func(t *testing.T) *mocks.SubscriberHostRepository {
+				t.Helper()
+				m := mocks.NewSubscriberHostRepository(t)
+				m.On("Get", ctx, host).Return(&models.SubscriberHost{Host: host, ConsecutiveFailures: 3}, nil).Once()
+				m.On("RecordSuccess", ctx, host).Return(nil).Once()
+				return m
+			},
 			matchRepository: func(t *testing.T) *mocks.MatchRepository {
 				t.Helper()
 				m := mocks.NewMatchRepository(t)
@@ -73,11 +168,75 @@ func TestSubscriberNotifierService_NotifySubscriber(t *testing.T) {
 			notifierClient: func(t *testing.T) *mocks.NotifierClient {
 				t.Helper()
 				m := mocks.NewNotifierClient(t)
-				m.On("Notify", ctx, notification).Return(nil).Once()
+				m.On("Notify", ctx, notification).Return(&response, nil).Once()
+				return m
+			},
+			notificationAttemptRepository: func(t *testing.T) *mocks.NotificationAttemptRepository {
+				t.Helper()
+				m := mocks.NewNotificationAttemptRepository(t)
+				m.On("Create", ctx, attemptMatcher(attempt)).Return(&attempt, nil).Once()
 				return m
 			},
 			expectedErr: nil,
 		},
+		{
+			name:  "success - it passes previous key of subscription during its rotation window",
+			input: subscriptionID,
+			subscriptionRepository: func(t *testing.T) *mocks.SubscriptionRepository {
+				t.Helper()
+				m := mocks.NewSubscriptionRepository(t)
+				m.On("Get", ctx, subscriptionID).Return(&rotatedSubscription, nil).Once()
+				m.On("Update", ctx, subscriptionID, mock.MatchedBy(subscriptionMatchedFunc)).Return(nil).Once()
+				return m
+			},
+			matchRepository: func(t *testing.T) *mocks.MatchRepository {
+				t.Helper()
+				m := mocks.NewMatchRepository(t)
+				m.On("One", ctx, models.Match{ID: matchID}).Return(&match, nil).Once()
+				return m
+			},
+			notifierClient: func(t *testing.T) *mocks.NotifierClient {
+				t.Helper()
+				m := mocks.NewNotifierClient(t)
+				m.On("Notify", ctx, dualSignedNotification).Return(&response, nil).Once()
+				return m
+			},
+			notificationAttemptRepository: func(t *testing.T) *mocks.NotificationAttemptRepository {
+				t.Helper()
+				m := mocks.NewNotificationAttemptRepository(t)
+				m.On("Create", ctx, attemptMatcher(attempt)).Return(&attempt, nil).Once()
+				return m
+			},
+		},
+		{
+			name:  "success - it returns nil when notification attempt saving fails",
+			input: subscriptionID,
+			subscriptionRepository: func(t *testing.T) *mocks.SubscriptionRepository {
+				t.Helper()
+				m := mocks.NewSubscriptionRepository(t)
+				m.On("Get", ctx, subscriptionID).Return(&subscription, nil).Once()
+				m.On("Update", ctx, subscriptionID, mock.MatchedBy(subscriptionMatchedFunc)).Return(nil).Once()
+				return m
+			},
+			matchRepository: func(t *testing.T) *mocks.MatchRepository {
+				t.Helper()
+				m := mocks.NewMatchRepository(t)
+				m.On("One", ctx, models.Match{ID: matchID}).Return(&match, nil).Once()
+				return m
+			},
+			notifierClient: func(t *testing.T) *mocks.NotifierClient {
+				t.Helper()
+				m := mocks.NewNotifierClient(t)
+				m.On("Notify", ctx, notification).Return(&response, nil).Once()
+				return m
+			},
+			notificationAttemptRepository: func(t *testing.T) *mocks.NotificationAttemptRepository {
+				t.Helper()
+				m := mocks.NewNotificationAttemptRepository(t)
+				m.On("Create", ctx, attemptMatcher(attempt)).Return(nil, unexpectedErr).Once()
+				return m
+			},
+		},
 		{
 			name:  "it returns an error when subscription retrieval fails",
 			input: subscriptionID,
@@ -146,16 +305,17 @@ func TestSubscriberNotifierService_NotifySubscriber(t *testing.T) {
 			expectedErr: errors.New("match relation external match doesn't exist"),
 		},
 		{
-			name:  "it returns an error when notifier fails and subscription update fails",
+			name:  "success - it schedules a retry when notification fails with transient error",
 			input: subscriptionID,
 			subscriptionRepository: func(t *testing.T) *mocks.SubscriptionRepository {
 				t.Helper()
 				m := mocks.NewSubscriptionRepository(t)
 				m.On("Get", ctx, subscriptionID).Return(&subscription, nil).Once()
 				m.On("Update", ctx, subscriptionID, models.Subscription{
-					Status:          models.SubscriberErrorSub,
-					SubscriberError: &errorMessage,
-				}).Return(unexpectedErr).Once()
+					Status:           models.PendingSub,
+					SubscriberError:  &errorMessage,
+					DeliveryAttempts: 1,
+				}).Return(nil).Once()
 				return m
 			},
 			matchRepository: func(t *testing.T) *mocks.MatchRepository {
@@ -167,21 +327,33 @@ func TestSubscriberNotifierService_NotifySubscriber(t *testing.T) {
 			notifierClient: func(t *testing.T) *mocks.NotifierClient {
 				t.Helper()
 				m := mocks.NewNotifierClient(t)
-				m.On("Notify", ctx, notification).Return(unexpectedErr).Once()
+				m.On("Notify", ctx, notification).Return(&failedResponse, unexpectedErr).Once()
+				return m
+			},
+			notificationAttemptRepository: func(t *testing.T) *mocks.NotificationAttemptRepository {
+				t.Helper()
+				m := mocks.NewNotificationAttemptRepository(t)
+				m.On("Create", ctx, attemptMatcher(failedAttempt)).Return(&failedAttempt, nil).Once()
+				return m
+			},
+			taskClient: func(t *testing.T) *mocks.TaskClient {
+				t.Helper()
+				m := mocks.NewTaskClient(t)
+				m.On("ScheduleSubscriberNotificationRetry", ctx, subscriptionID, mock.AnythingOfType("string"), scheduleAtMatcher(notificationConfig.RetryBaseDelay/2, notificationConfig.RetryBaseDelay)).Return(nil).Once()
 				return m
 			},
-			expectedErr: fmt.Errorf("failed to notify subscriber: %w", unexpectedErr),
 		},
 		{
-			name:  "it returns an error when notifier fails and subscription update succeeds",
+			name:  "success - it honors Retry-After of the subscriber when it is longer than the backoff",
 			input: subscriptionID,
 			subscriptionRepository: func(t *testing.T) *mocks.SubscriptionRepository {
 				t.Helper()
 				m := mocks.NewSubscriptionRepository(t)
 				m.On("Get", ctx, subscriptionID).Return(&subscription, nil).Once()
 				m.On("Update", ctx, subscriptionID, models.Subscription{
-					Status:          models.SubscriberErrorSub,
-					SubscriberError: &errorMessage,
+					Status:           models.PendingSub,
+					SubscriberError:  &errorMessage,
+					DeliveryAttempts: 1,
 				}).Return(nil).Once()
 				return m
 			},
@@ -194,19 +366,34 @@ func TestSubscriberNotifierService_NotifySubscriber(t *testing.T) {
 			notifierClient: func(t *testing.T) *mocks.NotifierClient {
 				t.Helper()
 				m := mocks.NewNotifierClient(t)
-				m.On("Notify", ctx, notification).Return(unexpectedErr).Once()
+				m.On("Notify", ctx, notification).Return(&rateLimitedResponse, unexpectedErr).Once()
+				return m
+			},
+			notificationAttemptRepository: func(t *testing.T) *mocks.NotificationAttemptRepository {
+				t.Helper()
+				m := mocks.NewNotificationAttemptRepository(t)
+				m.On("Create", ctx, mock.Anything).Return(&failedAttempt, nil).Once()
+				return m
+			},
+			taskClient: func(t *testing.T) *mocks.TaskClient {
+				t.Helper()
+				m := mocks.NewTaskClient(t)
+				m.On("ScheduleSubscriberNotificationRetry", ctx, subscriptionID, mock.AnythingOfType("string"), scheduleAtMatcher(retryAfter, retryAfter)).Return(nil).Once()
 				return m
 			},
-			expectedErr: fmt.Errorf("failed to notify subscriber: %w", unexpectedErr),
 		},
 		{
-			name:  "it returns an error when notifier succeeds but subscription update fails",
+			name:  "success - it caps Retry-After of the subscriber at the max retry delay",
 			input: subscriptionID,
 			subscriptionRepository: func(t *testing.T) *mocks.SubscriptionRepository {
 				t.Helper()
 				m := mocks.NewSubscriptionRepository(t)
 				m.On("Get", ctx, subscriptionID).Return(&subscription, nil).Once()
-				m.On("Update", ctx, subscriptionID, mock.MatchedBy(subscriptionMatchedFunc)).Return(unexpectedErr).Once()
+				m.On("Update", ctx, subscriptionID, models.Subscription{
+					Status:           models.PendingSub,
+					SubscriberError:  &errorMessage,
+					DeliveryAttempts: 1,
+				}).Return(nil).Once()
 				return m
 			},
 			matchRepository: func(t *testing.T) *mocks.MatchRepository {
@@ -218,56 +405,1255 @@ func TestSubscriberNotifierService_NotifySubscriber(t *testing.T) {
 			notifierClient: func(t *testing.T) *mocks.NotifierClient {
 				t.Helper()
 				m := mocks.NewNotifierClient(t)
-				m.On("Notify", ctx, notification).Return(nil).Once()
+				m.On("Notify", ctx, notification).Return(&longRateLimitedResponse, unexpectedErr).Once()
+				return m
+			},
+			notificationAttemptRepository: func(t *testing.T) *mocks.NotificationAttemptRepository {
+				t.Helper()
+				m := mocks.NewNotificationAttemptRepository(t)
+				m.On("Create", ctx, mock.Anything).Return(&failedAttempt, nil).Once()
+				return m
+			},
+			taskClient: func(t *testing.T) *mocks.TaskClient {
+				t.Helper()
+				m := mocks.NewTaskClient(t)
+				m.On("ScheduleSubscriberNotificationRetry", ctx, subscriptionID, mock.AnythingOfType("string"), scheduleAtMatcher(notificationConfig.RetryMaxDelay, notificationConfig.RetryMaxDelay)).Return(nil).Once()
 				return m
 			},
-			expectedErr: fmt.Errorf("failed to update subscription status to %s: %w", string(models.SuccessfulSub), unexpectedErr),
 		},
-	}
-
-	for _, tt := range tests {
-		t.Run(tt.name, func(t *testing.T) {
-			var matchRepository *mocks.MatchRepository
-			if tt.matchRepository != nil {
-				matchRepository = tt.matchRepository(t)
-			}
-
-			var subscriptionRepository *mocks.SubscriptionRepository
-			if tt.subscriptionRepository != nil {
-				subscriptionRepository = tt.subscriptionRepository(t)
-			}
-
-			var notifierClient *mocks.NotifierClient
-			if tt.notifierClient != nil {
-				notifierClient = tt.notifierClient(t)
-			}
-
-			logger := loggerinternal.SetupLogger()
-
-			sns := sub.NewSubscriberNotifierService(subscriptionRepository, matchRepository, notifierClient, logger)
-
-			err := sns.NotifySubscriber(ctx, tt.input)
-			if tt.expectedErr != nil {
-				assert.ErrorContains(t, err, tt.expectedErr.Error())
-			} else {
-				assert.NoError(t, err)
-			}
-		})
-	}
-}
-
-func subscriptionMatchedFunc(actual models.Subscription) bool {
-	if actual.SubscriberError != actual.SubscriberError {
-		return false
-	}
-
-	if actual.Status != models.SuccessfulSub {
-		return false
-	}
-
-	if actual.NotifiedAt.After(time.Now()) {
-		return false
-	}
-
-	return true
+		{
+			name:  "success - it doesn't retry when notification fails with permanent error",
+			input: subscriptionID,
+			subscriptionRepository: func(t *testing.T) *mocks.SubscriptionRepository {
+				t.Helper()
+				m := mocks.NewSubscriptionRepository(t)
+				m.On("Get", ctx, subscriptionID).Return(&subscription, nil).Once()
+				m.On("Update", ctx, subscriptionID, models.Subscription{
+					Status:           models.SubscriberErrorSub,
+					SubscriberError:  &errorMessage,
+					DeliveryAttempts: 1,
+				}).Return(nil).Once()
+				return m
+			},
+			matchRepository: func(t *testing.T) *mocks.MatchRepository {
+				t.Helper()
+				m := mocks.NewMatchRepository(t)
+				m.On("One", ctx, models.Match{ID: matchID}).Return(&match, nil).Once()
+				return m
+			},
+			notifierClient: func(t *testing.T) *mocks.NotifierClient {
+				t.Helper()
+				m := mocks.NewNotifierClient(t)
+				m.On("Notify", ctx, notification).Return(&rejectedResponse, unexpectedErr).Once()
+				return m
+			},
+			notificationAttemptRepository: func(t *testing.T) *mocks.NotificationAttemptRepository {
+				t.Helper()
+				m := mocks.NewNotificationAttemptRepository(t)
+				m.On("Create", ctx, mock.Anything).Return(&failedAttempt, nil).Once()
+				return m
+			},
+		},
+		{
+			name:  "success - it doesn't retry when notification destination is not allowed",
+			input: subscriptionID,
+			subscriptionRepository: func(t *testing.T) *mocks.SubscriptionRepository {
+				t.Helper()
+				m := mocks.NewSubscriptionRepository(t)
+				m.On("Get", ctx, subscriptionID).Return(&subscription, nil).Once()
+				m.On("Update", ctx, subscriptionID, models.Subscription{
+					Status:           models.SubscriberErrorSub,
+					SubscriberError:  &blockedErrorMessage,
+					DeliveryAttempts: 1,
+				}).Return(nil).Once()
+				return m
+			},
+			matchRepository: func(t *testing.T) *mocks.MatchRepository {
+				t.Helper()
+				m := mocks.NewMatchRepository(t)
+				m.On("One", ctx, models.Match{ID: matchID}).Return(&match, nil).Once()
+				return m
+			},
+			notifierClient: func(t *testing.T) *mocks.NotifierClient {
+				t.Helper()
+				m := mocks.NewNotifierClient(t)
+				m.On("Notify", ctx, notification).Return(&models.NotificationResponse{}, blockedErr).Once()
+				return m
+			},
+			notificationAttemptRepository: func(t *testing.T) *mocks.NotificationAttemptRepository {
+				t.Helper()
+				m := mocks.NewNotificationAttemptRepository(t)
+				m.On("Create", ctx, mock.Anything).Return(&failedAttempt, nil).Once()
+				return m
+			},
+		},
+		{
+			name:  "success - it dead-letters subscription when max attempts are reached",
+			input: subscriptionID,
+			subscriptionRepository: func(t *testing.T) *mocks.SubscriptionRepository {
+				t.Helper()
+				m := mocks.NewSubscriptionRepository(t)
+				m.On("Get", ctx, subscriptionID).Return(&exhaustedSubscription, nil).Once()
+				m.On("Update", ctx, subscriptionID, models.Subscription{
+					Status:           models.DeadLetterSub,
+					SubscriberError:  &errorMessage,
+					DeliveryAttempts: notificationConfig.MaxAttempts,
+				}).Return(nil).Once()
+				return m
+			},
+			matchRepository: func(t *testing.T) *mocks.MatchRepository {
+				t.Helper()
+				m := mocks.NewMatchRepository(t)
+				m.On("One", ctx, models.Match{ID: matchID}).Return(&match, nil).Once()
+				return m
+			},
+			notifierClient: func(t *testing.T) *mocks.NotifierClient {
+				t.Helper()
+				m := mocks.NewNotifierClient(t)
+				m.On("Notify", ctx, notification).Return(nil, unexpectedErr).Once()
+				return m
+			},
+			notificationAttemptRepository: func(t *testing.T) *mocks.NotificationAttemptRepository {
+				t.Helper()
+				m := mocks.NewNotificationAttemptRepository(t)
+				m.On("Create", ctx, mock.Anything).Return(&failedAttempt, nil).Once()
+				return m
+			},
+		},
+		{
+			name:  "it returns an error when retry scheduling fails",
+			input: subscriptionID,
+			subscriptionRepository: func(t *testing.T) *mocks.SubscriptionRepository {
+				t.Helper()
+				m := mocks.NewSubscriptionRepository(t)
+				m.On("Get", ctx, subscriptionID).Return(&subscription, nil).Once()
+				m.On("Update", ctx, subscriptionID, models.Subscription{
+					Status:           models.SchedulingErrorSub,
+					SubscriberError:  &errorMessage,
+					DeliveryAttempts: 1,
+				}).Return(nil).Once()
+				return m
+			},
+			matchRepository: func(t *testing.T) *mocks.MatchRepository {
+				t.Helper()
+				m := mocks.NewMatchRepository(t)
+				m.On("One", ctx, models.Match{ID: matchID}).Return(&match, nil).Once()
+				return m
+			},
+			notifierClient: func(t *testing.T) *mocks.NotifierClient {
+				t.Helper()
+				m := mocks.NewNotifierClient(t)
+				m.On("Notify", ctx, notification).Return(&failedResponse, unexpectedErr).Once()
+				return m
+			},
+			notificationAttemptRepository: func(t *testing.T) *mocks.NotificationAttemptRepository {
+				t.Helper()
+				m := mocks.NewNotificationAttemptRepository(t)
+				m.On("Create", ctx, attemptMatcher(failedAttempt)).Return(&failedAttempt, nil).Once()
+				return m
+			},
+			taskClient: func(t *testing.T) *mocks.TaskClient {
+				t.Helper()
+				m := mocks.NewTaskClient(t)
+				m.On("ScheduleSubscriberNotificationRetry", ctx, subscriptionID, mock.AnythingOfType("string"), mock.AnythingOfType("time.Time")).Return(unexpectedErr).Once()
+				return m
+			},
+			expectedErr: fmt.Errorf("failed to schedule subscriber notification retry: %w", unexpectedErr),
+		},
+		{
+			name:  "success - it returns nil when subscriber has unsubscribed",
+			input: subscriptionID,
+			subscriptionRepository: func(t *testing.T) *mocks.SubscriptionRepository {
+				t.Helper()
+				m := mocks.NewSubscriptionRepository(t)
+				m.On("Get", ctx, subscriptionID).Return(&unsubscribedSubscription, nil).Once()
+				return m
+			},
+		},
+		{
+			name:  "it returns an error when subscriber host retrieval fails",
+			input: subscriptionID,
+			subscriptionRepository: func(t *testing.T) *mocks.SubscriptionRepository {
+				t.Helper()
+				m := mocks.NewSubscriptionRepository(t)
+				m.On("Get", ctx, subscriptionID).Return(&subscription, nil).Once()
+				return m
+			},
+			subscriberHostRepository: func(t *testing.T) *mocks.SubscriberHostRepository {
+				t.Helper()
+				m := mocks.NewSubscriberHostRepository(t)
+				m.On("Get", ctx, host).Return(nil, unexpectedErr).Once()
+				return m
+			},
+			expectedErr: fmt.Errorf("failed to get subscriber host: %w", unexpectedErr),
+		},
+		{
+			name:  "success - it suspends the notification when subscriber host is suspended",
+			input: subscriptionID,
+			subscriptionRepository: func(t *testing.T) *mocks.SubscriptionRepository {
+				t.Helper()
+				m := mocks.NewSubscriptionRepository(t)
+				m.On("Get", ctx, subscriptionID).Return(&subscription, nil).Once()
+				m.On("Update", ctx, subscriptionID, models.Subscription{Status: models.SuspendedSub, SubscriberError: &suspensionMessage}).Return(nil).Once()
+				return m
+			},
+			subscriberHostRepository: func(t *testing.T) *mocks.SubscriberHostRepository {
+				t.Helper()
+				m := mocks.NewSubscriberHostRepository(t)
+				m.On("Get", ctx, host).Return(&models.SubscriberHost{Host: host, SuspendedAt: &suspendedAt}, nil).Once()
+				return m
+			},
+		},
+		{
+			name:  "success - it suspends the subscription when subscriber host reaches the failure threshold",
+			input: subscriptionID,
+			subscriptionRepository: func(t *testing.T) *mocks.SubscriptionRepository {
+				t.Helper()
+				m := mocks.NewSubscriptionRepository(t)
+				m.On("Get", ctx, subscriptionID).Return(&subscription, nil).Once()
+				m.On("Update", ctx, subscriptionID, models.Subscription{
+					Status:           models.SuspendedSub,
+					SubscriberError:  &errorMessage,
+					DeliveryAttempts: 1,
+				}).Return(nil).Once()
+				return m
+			},
+			matchRepository: func(t *testing.T) *mocks.MatchRepository {
+				t.Helper()
+				m := mocks.NewMatchRepository(t)
+				m.On("One", ctx, models.Match{ID: matchID}).Return(&match, nil).Once()
+				return m
+			},
+			notifierClient: func(t *testing.T) *mocks.NotifierClient {
+				t.Helper()
+				m := mocks.NewNotifierClient(t)
+				m.On("Notify", ctx, notification).Return(&failedResponse, unexpectedErr).Once()
+				return m
+			},
+			notificationAttemptRepository: func(t *testing.T) *mocks.NotificationAttemptRepository {
+				t.Helper()
+				m := mocks.NewNotificationAttemptRepository(t)
+				m.On("Create", ctx, attemptMatcher(failedAttempt)).Return(&failedAttempt, nil).Once()
+				return m
+			},
+			subscriberHostRepository: func(t *testing.T) *mocks.SubscriberHostRepository {
+				t.Helper()
+				m := mocks.NewSubscriberHostRepository(t)
+				m.On("Get", ctx, host).Return(nil, models.NewResourceNotFoundError(unexpectedErr)).Once()
+				m.On("RecordFailure", ctx, host, errorMessage, notificationConfig.HostFailureThreshold).
+					Return(&models.SubscriberHost{Host: host, ConsecutiveFailures: 10, SuspendedAt: &suspendedAt}, nil).
+					Once()
+				return m
+			},
+		},
+		{
+			name:  "success - it unsubscribes the subscriber which responded with 410 Gone",
+			input: subscriptionID,
+			subscriptionRepository: func(t *testing.T) *mocks.SubscriptionRepository {
+				t.Helper()
+				m := mocks.NewSubscriptionRepository(t)
+				m.On("Get", ctx, subscriptionID).Return(&subscription, nil).Once()
+				m.On("Update", ctx, subscriptionID, models.Subscription{
+					Status:           models.UnsubscribedSub,
+					SubscriberError:  &errorMessage,
+					DeliveryAttempts: 1,
+				}).Return(nil).Once()
+				return m
+			},
+			matchRepository: func(t *testing.T) *mocks.MatchRepository {
+				t.Helper()
+				m := mocks.NewMatchRepository(t)
+				m.On("One", ctx, models.Match{ID: matchID}).Return(&match, nil).Once()
+				return m
+			},
+			notifierClient: func(t *testing.T) *mocks.NotifierClient {
+				t.Helper()
+				m := mocks.NewNotifierClient(t)
+				m.On("Notify", ctx, notification).Return(&goneResponse, unexpectedErr).Once()
+				return m
+			},
+			notificationAttemptRepository: func(t *testing.T) *mocks.NotificationAttemptRepository {
+				t.Helper()
+				m := mocks.NewNotificationAttemptRepository(t)
+				m.On("Create", ctx, mock.Anything).Return(&failedAttempt, nil).Once()
+				return m
+			},
+			subscriberHostRepository: func(t *testing.T) *mocks.SubscriberHostRepository {
+				t.Helper()
+				m := mocks.NewSubscriberHostRepository(t)
+				m.On("Get", ctx, host).Return(nil, models.NewResourceNotFoundError(unexpectedErr)).Once()
+				return m
+			},
+		},
+		{
+			name:  "it returns an error when notifier fails and subscription update fails",
+			input: subscriptionID,
+			subscriptionRepository: func(t *testing.T) *mocks.SubscriptionRepository {
+				t.Helper()
+				m := mocks.NewSubscriptionRepository(t)
+				m.On("Get", ctx, subscriptionID).Return(&subscription, nil).Once()
+				m.On("Update", ctx, subscriptionID, models.Subscription{
+					Status:           models.SubscriberErrorSub,
+					SubscriberError:  &errorMessage,
+					DeliveryAttempts: 1,
+				}).Return(unexpectedErr).Once()
+				return m
+			},
+			matchRepository: func(t *testing.T) *mocks.MatchRepository {
+				t.Helper()
+				m := mocks.NewMatchRepository(t)
+				m.On("One", ctx, models.Match{ID: matchID}).Return(&match, nil).Once()
+				return m
+			},
+			notifierClient: func(t *testing.T) *mocks.NotifierClient {
+				t.Helper()
+				m := mocks.NewNotifierClient(t)
+				m.On("Notify", ctx, notification).Return(&rejectedResponse, unexpectedErr).Once()
+				return m
+			},
+			notificationAttemptRepository: func(t *testing.T) *mocks.NotificationAttemptRepository {
+				t.Helper()
+				m := mocks.NewNotificationAttemptRepository(t)
+				m.On("Create", ctx, mock.Anything).Return(&failedAttempt, nil).Once()
+				return m
+			},
+			expectedErr: fmt.Errorf("failed to update subscription status to %s: %w", models.SubscriberErrorSub, unexpectedErr),
+		},
+		{
+			name:  "it returns an error when notifier succeeds but subscription update fails",
+			input: subscriptionID,
+			subscriptionRepository: func(t *testing.T) *mocks.SubscriptionRepository {
+				t.Helper()
+				m := mocks.NewSubscriptionRepository(t)
+				m.On("Get", ctx, subscriptionID).Return(&subscription, nil).Once()
+				m.On("Update", ctx, subscriptionID, mock.MatchedBy(subscriptionMatchedFunc)).Return(unexpectedErr).Once()
+				return m
+			},
+			matchRepository: func(t *testing.T) *mocks.MatchRepository {
+				t.Helper()
+				m := mocks.NewMatchRepository(t)
+				m.On("One", ctx, models.Match{ID: matchID}).Return(&match, nil).Once()
+				return m
+			},
+			notifierClient: func(t *testing.T) *mocks.NotifierClient {
+				t.Helper()
+				m := mocks.NewNotifierClient(t)
+				m.On("Notify", ctx, notification).Return(&response, nil).Once()
+				return m
+			},
+			notificationAttemptRepository: func(t *testing.T) *mocks.NotificationAttemptRepository {
+				t.Helper()
+				m := mocks.NewNotificationAttemptRepository(t)
+				m.On("Create", ctx, attemptMatcher(attempt)).Return(&attempt, nil).Once()
+				return m
+			},
+			expectedErr: fmt.Errorf("failed to update subscription status to %s: %w", string(models.SuccessfulSub), unexpectedErr),
+		},
+		{
+			name:  "it returns an error when subscription fails to join notification batch",
+			input: subscriptionID,
+			subscriptionRepository: func(t *testing.T) *mocks.SubscriptionRepository {
+				t.Helper()
+				m := mocks.NewSubscriptionRepository(t)
+				m.On("Get", ctx, subscriptionID).Return(&batchedSubscription, nil).Once()
+				return m
+			},
+			notificationBatchRepository: func(t *testing.T) *mocks.NotificationBatchRepository {
+				t.Helper()
+				m := mocks.NewNotificationBatchRepository(t)
+				m.On("Join", ctx, subscriptionID, mock.AnythingOfType("time.Time")).Return(nil, unexpectedErr).Once()
+				return m
+			},
+			expectedErr: fmt.Errorf("failed to join notification batch: %w", unexpectedErr),
+		},
+		{
+			name:  "it marks subscription with scheduling error when notification batch scheduling fails",
+			input: subscriptionID,
+			subscriptionRepository: func(t *testing.T) *mocks.SubscriptionRepository {
+				t.Helper()
+				m := mocks.NewSubscriptionRepository(t)
+				m.On("Get", ctx, subscriptionID).Return(&batchedSubscription, nil).Once()
+				m.On("Update", ctx, subscriptionID, models.Subscription{Status: models.SchedulingErrorSub}).Return(nil).Once()
+				return m
+			},
+			notificationBatchRepository: func(t *testing.T) *mocks.NotificationBatchRepository {
+				t.Helper()
+				m := mocks.NewNotificationBatchRepository(t)
+				m.On("Join", ctx, subscriptionID, mock.AnythingOfType("time.Time")).Return(&notificationBatch, nil).Once()
+				return m
+			},
+			taskClient: func(t *testing.T) *mocks.TaskClient {
+				t.Helper()
+				m := mocks.NewTaskClient(t)
+				m.On("ScheduleNotificationBatch", ctx, notificationBatch.ID, notificationBatch.DeliverAt).Return(unexpectedErr).Once()
+				return m
+			},
+			expectedErr: fmt.Errorf("failed to schedule notification batch: %w", unexpectedErr),
+		},
+		{
+			name:  "success - it joins notification batch when batch task already exists",
+			input: subscriptionID,
+			subscriptionRepository: func(t *testing.T) *mocks.SubscriptionRepository {
+				t.Helper()
+				m := mocks.NewSubscriptionRepository(t)
+				m.On("Get", ctx, subscriptionID).Return(&batchedSubscription, nil).Once()
+				return m
+			},
+			notificationBatchRepository: func(t *testing.T) *mocks.NotificationBatchRepository {
+				t.Helper()
+				m := mocks.NewNotificationBatchRepository(t)
+				m.On("Join", ctx, subscriptionID, mock.AnythingOfType("time.Time")).Return(&notificationBatch, nil).Once()
+				return m
+			},
+			taskClient: func(t *testing.T) *mocks.TaskClient {
+				t.Helper()
+				m := mocks.NewTaskClient(t)
+				m.On("ScheduleNotificationBatch", ctx, notificationBatch.ID, notificationBatch.DeliverAt).Return(models.NewResourceAlreadyExistsError(errors.New("already exists"))).Once()
+				return m
+			},
+		},
+		{
+			name:  "success - it delivers notification of batched subscription individually after the batch",
+			input: subscriptionID,
+			subscriptionRepository: func(t *testing.T) *mocks.SubscriptionRepository {
+				t.Helper()
+				m := mocks.NewSubscriptionRepository(t)
+				notBatched := batchedSubscription
+				notBatched.NotificationBatchID = &notificationBatch.ID
+				m.On("Get", ctx, subscriptionID).Return(&notBatched, nil).Once()
+				m.On("Update", ctx, subscriptionID, mock.MatchedBy(subscriptionMatchedFunc)).Return(nil).Once()
+				return m
+			},
+			matchRepository: func(t *testing.T) *mocks.MatchRepository {
+				t.Helper()
+				m := mocks.NewMatchRepository(t)
+				m.On("One", ctx, models.Match{ID: matchID}).Return(&match, nil).Once()
+				return m
+			},
+			notifierClient: func(t *testing.T) *mocks.NotifierClient {
+				t.Helper()
+				m := mocks.NewNotifierClient(t)
+				m.On("Notify", ctx, notification).Return(&response, nil).Once()
+				return m
+			},
+			notificationAttemptRepository: func(t *testing.T) *mocks.NotificationAttemptRepository {
+				t.Helper()
+				m := mocks.NewNotificationAttemptRepository(t)
+				m.On("Create", ctx, attemptMatcher(attempt)).Return(&attempt, nil).Once()
+				return m
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var matchRepository *mocks.MatchRepository
+			if tt.matchRepository != nil {
+				matchRepository = tt.matchRepository(t)
+			}
+
+			var subscriptionRepository *mocks.SubscriptionRepository
+			if tt.subscriptionRepository != nil {
+				subscriptionRepository = tt.subscriptionRepository(t)
+			}
+
+			var notifierClient *mocks.NotifierClient
+			if tt.notifierClient != nil {
+				notifierClient = tt.notifierClient(t)
+			}
+
+			var notificationAttemptRepository *mocks.NotificationAttemptRepository
+			if tt.notificationAttemptRepository != nil {
+				notificationAttemptRepository = tt.notificationAttemptRepository(t)
+			}
+
+			var taskClient *mocks.TaskClient
+			if tt.taskClient != nil {
+				taskClient = tt.taskClient(t)
+			}
+
+			subscriberHostRepository := healthySubscriberHostRepository(t)
+			if tt.subscriberHostRepository != nil {
+				subscriberHostRepository = tt.subscriberHostRepository(t)
+			}
+
+			var notificationBatchRepository *mocks.NotificationBatchRepository
+			if tt.notificationBatchRepository != nil {
+				notificationBatchRepository = tt.notificationBatchRepository(t)
+			}
+
+			logger := loggerinternal.SetupLogger()
+
+			sns := sub.NewSubscriberNotifierService(notificationConfig, subscriptionRepository, matchRepository, notificationAttemptRepository, nil, subscriberHostRepository, notificationBatchRepository, notifierClient, taskClient, logger)
+
+			err := sns.NotifySubscriber(ctx, tt.input)
+			if tt.expectedErr != nil {
+				assert.ErrorContains(t, err, tt.expectedErr.Error())
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestSubscriberNotifierService_NotifyEvent(t *testing.T) {
+	ctx := context.Background()
+	subscriptionID, matchID, eventDeliveryID := uint(gofakeit.Uint8()), uint(gofakeit.Uint8()), uint(gofakeit.Uint16())
+	unexpectedErr := errors.New("unexpected error")
+
+	notificationConfig := config.Notification{MaxAttempts: 3, RetryBaseDelay: time.Minute, RetryMaxDelay: time.Hour}
+
+	subscription := testutils.FakeSubscription(func(s *models.Subscription) {
+		s.ID = subscriptionID
+		s.MatchID = matchID
+		s.PayloadVersion = models.PayloadV2
+	})
+
+	externalMatch := testutils.FakeExternalMatch(func(m *models.ExternalMatch) {
+		m.MatchID = matchID
+	})
+
+	match := testutils.FakeMatch(func(m *models.Match) {
+		m.ID = matchID
+		m.ExternalMatch = &externalMatch
+	})
+
+	event := models.MatchEvent{
+		ID:        uint(gofakeit.Uint16()),
+		MatchID:   matchID,
+		Type:      models.EventMatchGoal,
+		Key:       "goal-1-0",
+		HomeScore: 1,
+		StartsAt:  match.StartsAt,
+	}
+
+	delivery := models.EventDelivery{
+		ID:               eventDeliveryID,
+		MatchEventID:     event.ID,
+		SubscriptionID:   subscriptionID,
+		DeliveryID:       gofakeit.UUID(),
+		Status:           models.PendingSub,
+		DeliveryAttempts: 1,
+		MatchEvent:       &event,
+	}
+
+	notification := models.SubscriberNotification{
+		DeliveryID:      delivery.DeliveryID,
+		EventType:       models.EventMatchGoal,
+		PayloadVersion:  subscription.PayloadVersion,
+		DeliveryFormat:  subscription.DeliveryFormat,
+		Url:             subscription.Url,
+		Key:             subscription.Key,
+		MatchID:         matchID,
+		ExternalMatchID: externalMatch.ID,
+		StartsAt:        event.StartsAt,
+		HomeTeam:        models.Team{ID: match.HomeTeamID},
+		AwayTeam:        models.Team{ID: match.AwayTeamID},
+		Home:            1,
+		Away:            0,
+	}
+
+	okStatusCode, unavailableStatusCode, badRequestStatusCode, goneStatusCode := 200, 503, 400, 410
+	response := models.NotificationResponse{StatusCode: &okStatusCode}
+	unavailableResponse := models.NotificationResponse{StatusCode: &unavailableStatusCode}
+	rejectedResponse := models.NotificationResponse{StatusCode: &badRequestStatusCode}
+	goneResponse := models.NotificationResponse{StatusCode: &goneStatusCode}
+
+	errMessage := unexpectedErr.Error()
+
+	host := subscriptionHost(t, subscription)
+	suspendedAt := time.Now()
+	suspensionMessage := fmt.Sprintf("deliveries to host %s are suspended", host)
+
+	tests := []struct {
+		name                     string
+		expectedErr              error
+		eventDeliveryRepository  func(t *testing.T) *mocks.EventDeliveryRepository
+		subscriptionRepository   func(t *testing.T) *mocks.SubscriptionRepository
+		matchRepository          func(t *testing.T) *mocks.MatchRepository
+		notifierClient           func(t *testing.T) *mocks.NotifierClient
+		subscriberHostRepository func(t *testing.T) *mocks.SubscriberHostRepository
+		taskClient               func(t *testing.T) *mocks.TaskClient
+	}{
+		{
+			name: "it returns an error when event delivery retrieval fails",
+			eventDeliveryRepository: func(t *testing.T) *mocks.EventDeliveryRepository {
+				t.Helper()
+				m := mocks.NewEventDeliveryRepository(t)
+				m.On("Get", ctx, eventDeliveryID).Return(nil, unexpectedErr).Once()
+				return m
+			},
+			expectedErr: fmt.Errorf("failed to get event delivery by id: %w", unexpectedErr),
+		},
+		{
+			name: "success - it doesn't deliver the event which is already delivered",
+			eventDeliveryRepository: func(t *testing.T) *mocks.EventDeliveryRepository {
+				t.Helper()
+				m := mocks.NewEventDeliveryRepository(t)
+				delivered := delivery
+				delivered.Status = models.SuccessfulSub
+				m.On("Get", ctx, eventDeliveryID).Return(&delivered, nil).Once()
+				return m
+			},
+		},
+		{
+			name: "success - it delivers the event with the same delivery id and marks the delivery successful",
+			eventDeliveryRepository: func(t *testing.T) *mocks.EventDeliveryRepository {
+				t.Helper()
+				m := mocks.NewEventDeliveryRepository(t)
+				m.On("Get", ctx, eventDeliveryID).Return(&delivery, nil).Once()
+				m.On("Update", ctx, eventDeliveryID, mock.MatchedBy(func(actual models.EventDelivery) bool {
+					return actual.Status == models.SuccessfulSub && actual.NotifiedAt != nil && actual.DeliveryAttempts == delivery.DeliveryAttempts
+				})).Return(nil).Once()
+				return m
+			},
+			subscriptionRepository: func(t *testing.T) *mocks.SubscriptionRepository {
+				t.Helper()
+				m := mocks.NewSubscriptionRepository(t)
+				m.On("Get", ctx, subscriptionID).Return(&subscription, nil).Once()
+				return m
+			},
+			matchRepository: func(t *testing.T) *mocks.MatchRepository {
+				t.Helper()
+				m := mocks.NewMatchRepository(t)
+				m.On("One", ctx, models.Match{ID: matchID}).Return(&match, nil).Once()
+				return m
+			},
+			notifierClient: func(t *testing.T) *mocks.NotifierClient {
+				t.Helper()
+				m := mocks.NewNotifierClient(t)
+				m.On("Notify", ctx, notification).Return(&response, nil).Once()
+				return m
+			},
+		},
+		{
+			name: "it returns an error when delivery update fails",
+			eventDeliveryRepository: func(t *testing.T) *mocks.EventDeliveryRepository {
+				t.Helper()
+				m := mocks.NewEventDeliveryRepository(t)
+				m.On("Get", ctx, eventDeliveryID).Return(&delivery, nil).Once()
+				m.On("Update", ctx, eventDeliveryID, mock.Anything).Return(unexpectedErr).Once()
+				return m
+			},
+			subscriptionRepository: func(t *testing.T) *mocks.SubscriptionRepository {
+				t.Helper()
+				m := mocks.NewSubscriptionRepository(t)
+				m.On("Get", ctx, subscriptionID).Return(&subscription, nil).Once()
+				return m
+			},
+			matchRepository: func(t *testing.T) *mocks.MatchRepository {
+				t.Helper()
+				m := mocks.NewMatchRepository(t)
+				m.On("One", ctx, models.Match{ID: matchID}).Return(&match, nil).Once()
+				return m
+			},
+			notifierClient: func(t *testing.T) *mocks.NotifierClient {
+				t.Helper()
+				m := mocks.NewNotifierClient(t)
+				m.On("Notify", ctx, notification).Return(&response, nil).Once()
+				return m
+			},
+			expectedErr: fmt.Errorf("failed to update event delivery status to %s: %w", string(models.SuccessfulSub), unexpectedErr),
+		},
+		{
+			name: "success - it schedules the next attempt when delivery fails temporarily",
+			eventDeliveryRepository: func(t *testing.T) *mocks.EventDeliveryRepository {
+				t.Helper()
+				m := mocks.NewEventDeliveryRepository(t)
+				m.On("Get", ctx, eventDeliveryID).Return(&delivery, nil).Once()
+				m.On("Update", ctx, eventDeliveryID, models.EventDelivery{
+					Status:           models.PendingSub,
+					DeliveryAttempts: 2,
+					SubscriberError:  &errMessage,
+				}).Return(nil).Once()
+				return m
+			},
+			subscriptionRepository: func(t *testing.T) *mocks.SubscriptionRepository {
+				t.Helper()
+				m := mocks.NewSubscriptionRepository(t)
+				m.On("Get", ctx, subscriptionID).Return(&subscription, nil).Once()
+				return m
+			},
+			matchRepository: func(t *testing.T) *mocks.MatchRepository {
+				t.Helper()
+				m := mocks.NewMatchRepository(t)
+				m.On("One", ctx, models.Match{ID: matchID}).Return(&match, nil).Once()
+				return m
+			},
+			notifierClient: func(t *testing.T) *mocks.NotifierClient {
+				t.Helper()
+				m := mocks.NewNotifierClient(t)
+				m.On("Notify", ctx, notification).Return(&unavailableResponse, unexpectedErr).Once()
+				return m
+			},
+			taskClient: func(t *testing.T) *mocks.TaskClient {
+				t.Helper()
+				m := mocks.NewTaskClient(t)
+				m.On("ScheduleEventDelivery", ctx, eventDeliveryID, uint(2), scheduleAtMatcher(time.Minute, 2*time.Minute)).Return(nil).Once()
+				return m
+			},
+		},
+		{
+			name: "it returns an error when the next attempt scheduling fails",
+			eventDeliveryRepository: func(t *testing.T) *mocks.EventDeliveryRepository {
+				t.Helper()
+				m := mocks.NewEventDeliveryRepository(t)
+				m.On("Get", ctx, eventDeliveryID).Return(&delivery, nil).Once()
+				m.On("Update", ctx, eventDeliveryID, models.EventDelivery{
+					Status:           models.SchedulingErrorSub,
+					DeliveryAttempts: 2,
+					SubscriberError:  &errMessage,
+				}).Return(nil).Once()
+				return m
+			},
+			subscriptionRepository: func(t *testing.T) *mocks.SubscriptionRepository {
+				t.Helper()
+				m := mocks.NewSubscriptionRepository(t)
+				m.On("Get", ctx, subscriptionID).Return(&subscription, nil).Once()
+				return m
+			},
+			matchRepository: func(t *testing.T) *mocks.MatchRepository {
+				t.Helper()
+				m := mocks.NewMatchRepository(t)
+				m.On("One", ctx, models.Match{ID: matchID}).Return(&match, nil).Once()
+				return m
+			},
+			notifierClient: func(t *testing.T) *mocks.NotifierClient {
+				t.Helper()
+				m := mocks.NewNotifierClient(t)
+				m.On("Notify", ctx, notification).Return(&unavailableResponse, unexpectedErr).Once()
+				return m
+			},
+			taskClient: func(t *testing.T) *mocks.TaskClient {
+				t.Helper()
+				m := mocks.NewTaskClient(t)
+				m.On("ScheduleEventDelivery", ctx, eventDeliveryID, uint(2), mock.Anything).Return(unexpectedErr).Once()
+				return m
+			},
+			expectedErr: fmt.Errorf("failed to schedule event delivery retry: %w", unexpectedErr),
+		},
+		{
+			name: "success - it doesn't retry the delivery rejected by the subscriber",
+			eventDeliveryRepository: func(t *testing.T) *mocks.EventDeliveryRepository {
+				t.Helper()
+				m := mocks.NewEventDeliveryRepository(t)
+				m.On("Get", ctx, eventDeliveryID).Return(&delivery, nil).Once()
+				m.On("Update", ctx, eventDeliveryID, models.EventDelivery{
+					Status:           models.SubscriberErrorSub,
+					DeliveryAttempts: 2,
+					SubscriberError:  &errMessage,
+				}).Return(nil).Once()
+				return m
+			},
+			subscriptionRepository: func(t *testing.T) *mocks.SubscriptionRepository {
+				t.Helper()
+				m := mocks.NewSubscriptionRepository(t)
+				m.On("Get", ctx, subscriptionID).Return(&subscription, nil).Once()
+				return m
+			},
+			matchRepository: func(t *testing.T) *mocks.MatchRepository {
+				t.Helper()
+				m := mocks.NewMatchRepository(t)
+				m.On("One", ctx, models.Match{ID: matchID}).Return(&match, nil).Once()
+				return m
+			},
+			notifierClient: func(t *testing.T) *mocks.NotifierClient {
+				t.Helper()
+				m := mocks.NewNotifierClient(t)
+				m.On("Notify", ctx, notification).Return(&rejectedResponse, unexpectedErr).Once()
+				return m
+			},
+		},
+		{
+			name: "success - it unsubscribes the subscriber which responded with 410 Gone",
+			eventDeliveryRepository: func(t *testing.T) *mocks.EventDeliveryRepository {
+				t.Helper()
+				m := mocks.NewEventDeliveryRepository(t)
+				m.On("Get", ctx, eventDeliveryID).Return(&delivery, nil).Once()
+				m.On("Update", ctx, eventDeliveryID, models.EventDelivery{
+					Status:           models.UnsubscribedSub,
+					DeliveryAttempts: 2,
+					SubscriberError:  &errMessage,
+				}).Return(nil).Once()
+				return m
+			},
+			subscriptionRepository: func(t *testing.T) *mocks.SubscriptionRepository {
+				t.Helper()
+				m := mocks.NewSubscriptionRepository(t)
+				m.On("Get", ctx, subscriptionID).Return(&subscription, nil).Once()
+				m.On("Update", ctx, subscriptionID, models.Subscription{Status: models.UnsubscribedSub, SubscriberError: &errMessage}).Return(nil).Once()
+				return m
+			},
+			matchRepository: func(t *testing.T) *mocks.MatchRepository {
+				t.Helper()
+				m := mocks.NewMatchRepository(t)
+				m.On("One", ctx, models.Match{ID: matchID}).Return(&match, nil).Once()
+				return m
+			},
+			notifierClient: func(t *testing.T) *mocks.NotifierClient {
+				t.Helper()
+				m := mocks.NewNotifierClient(t)
+				m.On("Notify", ctx, notification).Return(&goneResponse, unexpectedErr).Once()
+				return m
+			},
+		},
+		{
+			name: "success - it doesn't deliver the event when subscriber host is suspended",
+			eventDeliveryRepository: func(t *testing.T) *mocks.EventDeliveryRepository {
+				t.Helper()
+				m := mocks.NewEventDeliveryRepository(t)
+				m.On("Get", ctx, eventDeliveryID).Return(&delivery, nil).Once()
+				m.On("Update", ctx, eventDeliveryID, models.EventDelivery{
+					Status:           models.SuspendedSub,
+					DeliveryAttempts: 1,
+					SubscriberError:  &suspensionMessage,
+				}).Return(nil).Once()
+				return m
+			},
+			subscriptionRepository: func(t *testing.T) *mocks.SubscriptionRepository {
+				t.Helper()
+				m := mocks.NewSubscriptionRepository(t)
+				m.On("Get", ctx, subscriptionID).Return(&subscription, nil).Once()
+				return m
+			},
+			subscriberHostRepository: func(t *testing.T) *mocks.SubscriberHostRepository {
+				t.Helper()
+				m := mocks.NewSubscriberHostRepository(t)
+				m.On("Get", ctx, host).Return(&models.SubscriberHost{Host: host, SuspendedAt: &suspendedAt}, nil).Once()
+				return m
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var eventDeliveryRepository *mocks.EventDeliveryRepository
+			if tt.eventDeliveryRepository != nil {
+				eventDeliveryRepository = tt.eventDeliveryRepository(t)
+			}
+
+			var subscriptionRepository *mocks.SubscriptionRepository
+			if tt.subscriptionRepository != nil {
+				subscriptionRepository = tt.subscriptionRepository(t)
+			}
+
+			var matchRepository *mocks.MatchRepository
+			if tt.matchRepository != nil {
+				matchRepository = tt.matchRepository(t)
+			}
+
+			var notifierClient *mocks.NotifierClient
+			if tt.notifierClient != nil {
+				notifierClient = tt.notifierClient(t)
+			}
+
+			var taskClient *mocks.TaskClient
+			if tt.taskClient != nil {
+				taskClient = tt.taskClient(t)
+			}
+
+			notificationAttemptRepository := mocks.NewNotificationAttemptRepository(t)
+			notificationAttemptRepository.On("Create", ctx, mock.Anything).Return(&models.NotificationAttempt{}, nil).Maybe()
+
+			subscriberHostRepository := healthySubscriberHostRepository(t)
+			if tt.subscriberHostRepository != nil {
+				subscriberHostRepository = tt.subscriberHostRepository(t)
+			}
+
+			sns := sub.NewSubscriberNotifierService(
+				notificationConfig,
+				subscriptionRepository,
+				matchRepository,
+				notificationAttemptRepository,
+				eventDeliveryRepository,
+				subscriberHostRepository,
+				nil,
+				notifierClient,
+				taskClient,
+				loggerinternal.SetupLogger(),
+			)
+
+			err := sns.NotifyEvent(ctx, eventDeliveryID)
+			if tt.expectedErr != nil {
+				assert.ErrorContains(t, err, tt.expectedErr.Error())
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestSubscriberNotifierService_NotifyBatch(t *testing.T) {
+	ctx := context.Background()
+	matchID, notificationBatchID := uint(gofakeit.Uint8()), uint(gofakeit.Uint16())
+	firstID, secondID := uint(gofakeit.Uint8())+1, uint(gofakeit.Uint8())+300
+	unexpectedErr := errors.New("unexpected error")
+
+	notificationConfig := config.Notification{MaxAttempts: 3, RetryBaseDelay: time.Minute, RetryMaxDelay: time.Hour, HostFailureThreshold: 10}
+
+	batchURL := "https://hooks.example.com/batch"
+	batch := models.NotificationBatch{ID: notificationBatchID, BatchURL: batchURL, Status: models.BatchClosed}
+	key := gofakeit.Password(true, true, true, false, false, 10)
+	authHeader, otherAuthHeader := "Authorization", "X-Api-Key"
+	headers := map[string]string{"X-Tenant": "prognoz"}
+	subscriptions := []models.Subscription{
+		testutils.FakeSubscription(func(s *models.Subscription) {
+			s.ID = firstID
+			s.MatchID = matchID
+			s.Url = "https://hooks.example.com/results/1"
+			s.Key = key
+			s.Headers = headers
+			s.AuthHeader = &authHeader
+			s.BatchURL = &batchURL
+			s.NotificationBatchID = &notificationBatchID
+			s.Status = models.PendingSub
+		}),
+		testutils.FakeSubscription(func(s *models.Subscription) {
+			s.ID = secondID
+			s.MatchID = matchID
+			s.Url = "https://hooks.example.com/results/2"
+			s.Key = key
+			s.Headers = headers
+			s.AuthHeader = &authHeader
+			s.BatchURL = &batchURL
+			s.NotificationBatchID = &notificationBatchID
+			s.Status = models.PendingSub
+		}),
+	}
+
+	otherAuthID := secondID + 1
+	otherAuthSubscription := subscriptions[1]
+	otherAuthSubscription.ID = otherAuthID
+	otherAuthSubscription.Url = "https://hooks.example.com/results/3"
+	otherAuthSubscription.AuthHeader = &otherAuthHeader
+
+	externalMatch := testutils.FakeExternalMatch(func(m *models.ExternalMatch) {
+		m.MatchID = matchID
+	})
+	match := testutils.FakeMatch(func(m *models.Match) {
+		m.ID = matchID
+		m.ExternalMatch = &externalMatch
+	})
+
+	pendingStatus := models.PendingSub
+	filter := models.SubscriptionFilter{NotificationBatchID: &notificationBatchID, Status: &pendingStatus}
+	notificationID := mock.MatchedBy(func(id string) bool { return id != "" })
+	batchMatcher := mock.MatchedBy(func(actual models.BatchNotification) bool {
+		return actual.DeliveryID != "" && actual.Url == batchURL && actual.Key == key &&
+			actual.AuthHeader != nil && *actual.AuthHeader == authHeader && actual.Headers["X-Tenant"] == "prognoz" && len(actual.Items) == 2 &&
+			actual.Items[0].SubscriptionID == firstID && actual.Items[1].SubscriptionID == secondID
+	})
+
+	statusCode := 200
+	acknowledgeFirst := func(_ context.Context, batch models.BatchNotification) (*models.NotificationResponse, error) {
+		return &models.NotificationResponse{StatusCode: &statusCode, Acknowledged: []string{batch.Items[0].Notification.DeliveryID}}, nil
+	}
+
+	tests := []struct {
+		name                        string
+		notificationBatchRepository func(t *testing.T) *mocks.NotificationBatchRepository
+		subscriptionRepository      func(t *testing.T) *mocks.SubscriptionRepository
+		matchRepository             func(t *testing.T) *mocks.MatchRepository
+		subscriberHostRepository    func(t *testing.T) *mocks.SubscriberHostRepository
+		notifierClient              func(t *testing.T) *mocks.NotifierClient
+		taskClient                  func(t *testing.T) *mocks.TaskClient
+		expectedErr                 error
+	}{
+		{
+			name: "it returns an error when notification batch is not found",
+			notificationBatchRepository: func(t *testing.T) *mocks.NotificationBatchRepository {
+				t.Helper()
+				m := mocks.NewNotificationBatchRepository(t)
+				m.On("Close", ctx, notificationBatchID).Return(nil, models.NewResourceNotFoundError(errors.New("not found"))).Once()
+				return m
+			},
+			expectedErr: fmt.Errorf("failed to close notification batch: %w", models.NewResourceNotFoundError(errors.New("not found"))),
+		},
+		{
+			name: "it returns an error when subscriptions search fails",
+			notificationBatchRepository: func(t *testing.T) *mocks.NotificationBatchRepository {
+				t.Helper()
+				m := mocks.NewNotificationBatchRepository(t)
+				m.On("Close", ctx, notificationBatchID).Return(&batch, nil).Once()
+				return m
+			},
+			subscriptionRepository: func(t *testing.T) *mocks.SubscriptionRepository {
+				t.Helper()
+				m := mocks.NewSubscriptionRepository(t)
+				m.On("Search", ctx, filter).Return(nil, unexpectedErr).Once()
+				return m
+			},
+			expectedErr: fmt.Errorf("failed to list subscriptions of notification batch: %w", unexpectedErr),
+		},
+		{
+			name: "success - it does nothing when notification batch has no pending subscriptions",
+			notificationBatchRepository: func(t *testing.T) *mocks.NotificationBatchRepository {
+				t.Helper()
+				m := mocks.NewNotificationBatchRepository(t)
+				m.On("Close", ctx, notificationBatchID).Return(&batch, nil).Once()
+				return m
+			},
+			subscriptionRepository: func(t *testing.T) *mocks.SubscriptionRepository {
+				t.Helper()
+				m := mocks.NewSubscriptionRepository(t)
+				m.On("Search", ctx, filter).Return([]models.Subscription{}, nil).Once()
+				return m
+			},
+		},
+		{
+			name: "success - it schedules individual notifications when host is suspended",
+			notificationBatchRepository: func(t *testing.T) *mocks.NotificationBatchRepository {
+				t.Helper()
+				m := mocks.NewNotificationBatchRepository(t)
+				m.On("Close", ctx, notificationBatchID).Return(&batch, nil).Once()
+				return m
+			},
+			subscriptionRepository: func(t *testing.T) *mocks.SubscriptionRepository {
+				t.Helper()
+				m := mocks.NewSubscriptionRepository(t)
+				m.On("Search", ctx, filter).Return(subscriptions, nil).Once()
+				return m
+			},
+			subscriberHostRepository: func(t *testing.T) *mocks.SubscriberHostRepository {
+				t.Helper()
+				suspendedAt := time.Now()
+				m := mocks.NewSubscriberHostRepository(t)
+				m.On("Get", ctx, "hooks.example.com").Return(&models.SubscriberHost{Host: "hooks.example.com", SuspendedAt: &suspendedAt}, nil).Once()
+				return m
+			},
+			taskClient: func(t *testing.T) *mocks.TaskClient {
+				t.Helper()
+				m := mocks.NewTaskClient(t)
+				m.On("ScheduleSubscriberNotification", ctx, firstID, notificationID).Return(nil).Once()
+				m.On("ScheduleSubscriberNotification", ctx, secondID, notificationID).Return(nil).Once()
+				return m
+			},
+		},
+		{
+			name: "success - it schedules individual notifications and records host failure when batch delivery fails",
+			notificationBatchRepository: func(t *testing.T) *mocks.NotificationBatchRepository {
+				t.Helper()
+				m := mocks.NewNotificationBatchRepository(t)
+				m.On("Close", ctx, notificationBatchID).Return(&batch, nil).Once()
+				return m
+			},
+			subscriptionRepository: func(t *testing.T) *mocks.SubscriptionRepository {
+				t.Helper()
+				m := mocks.NewSubscriptionRepository(t)
+				m.On("Search", ctx, filter).Return(subscriptions, nil).Once()
+				m.On("Update", ctx, secondID, models.Subscription{Status: models.SchedulingErrorSub}).Return(nil).Once()
+				return m
+			},
+			matchRepository: func(t *testing.T) *mocks.MatchRepository {
+				t.Helper()
+				m := mocks.NewMatchRepository(t)
+				m.On("One", ctx, models.Match{ID: matchID}).Return(&match, nil).Twice()
+				return m
+			},
+			notifierClient: func(t *testing.T) *mocks.NotifierClient {
+				t.Helper()
+				m := mocks.NewNotifierClient(t)
+				m.On("NotifyBatch", ctx, batchMatcher).Return(nil, unexpectedErr).Once()
+				return m
+			},
+			subscriberHostRepository: func(t *testing.T) *mocks.SubscriberHostRepository {
+				t.Helper()
+				m := mocks.NewSubscriberHostRepository(t)
+				m.On("Get", ctx, "hooks.example.com").Return(nil, models.NewResourceNotFoundError(errors.New("not found"))).Once()
+				m.On("RecordFailure", ctx, "hooks.example.com", unexpectedErr.Error(), notificationConfig.HostFailureThreshold).
+					Return(&models.SubscriberHost{Host: "hooks.example.com", ConsecutiveFailures: 1}, nil).
+					Once()
+				return m
+			},
+			taskClient: func(t *testing.T) *mocks.TaskClient {
+				t.Helper()
+				m := mocks.NewTaskClient(t)
+				m.On("ScheduleSubscriberNotification", ctx, firstID, notificationID).Return(nil).Once()
+				m.On("ScheduleSubscriberNotification", ctx, secondID, notificationID).Return(unexpectedErr).Once()
+				return m
+			},
+		},
+		{
+			name: "success - it marks acknowledged subscriptions as successful and schedules the rest individually",
+			notificationBatchRepository: func(t *testing.T) *mocks.NotificationBatchRepository {
+				t.Helper()
+				m := mocks.NewNotificationBatchRepository(t)
+				m.On("Close", ctx, notificationBatchID).Return(&batch, nil).Once()
+				return m
+			},
+			subscriptionRepository: func(t *testing.T) *mocks.SubscriptionRepository {
+				t.Helper()
+				m := mocks.NewSubscriptionRepository(t)
+				m.On("Search", ctx, filter).Return(subscriptions, nil).Once()
+				m.On("Update", ctx, firstID, mock.MatchedBy(subscriptionMatchedFunc)).Return(nil).Once()
+				return m
+			},
+			matchRepository: func(t *testing.T) *mocks.MatchRepository {
+				t.Helper()
+				m := mocks.NewMatchRepository(t)
+				m.On("One", ctx, models.Match{ID: matchID}).Return(&match, nil).Twice()
+				return m
+			},
+			notifierClient: func(t *testing.T) *mocks.NotifierClient {
+				t.Helper()
+				m := mocks.NewNotifierClient(t)
+				m.On("NotifyBatch", ctx, batchMatcher).Return(acknowledgeFirst, nil).Once()
+				return m
+			},
+			taskClient: func(t *testing.T) *mocks.TaskClient {
+				t.Helper()
+				m := mocks.NewTaskClient(t)
+				m.On("ScheduleSubscriberNotification", ctx, secondID, notificationID).Return(nil).Once()
+				return m
+			},
+		},
+		{
+			name: "success - it delivers individually subscription with headers other than of the batch",
+			notificationBatchRepository: func(t *testing.T) *mocks.NotificationBatchRepository {
+				t.Helper()
+				m := mocks.NewNotificationBatchRepository(t)
+				m.On("Close", ctx, notificationBatchID).Return(&batch, nil).Once()
+				return m
+			},
+			subscriptionRepository: func(t *testing.T) *mocks.SubscriptionRepository {
+				t.Helper()
+				m := mocks.NewSubscriptionRepository(t)
+				m.On("Search", ctx, filter).Return([]models.Subscription{subscriptions[0], otherAuthSubscription, subscriptions[1]}, nil).Once()
+				m.On("Update", ctx, firstID, mock.MatchedBy(subscriptionMatchedFunc)).Return(nil).Once()
+				return m
+			},
+			matchRepository: func(t *testing.T) *mocks.MatchRepository {
+				t.Helper()
+				m := mocks.NewMatchRepository(t)
+				m.On("One", ctx, models.Match{ID: matchID}).Return(&match, nil).Twice()
+				return m
+			},
+			notifierClient: func(t *testing.T) *mocks.NotifierClient {
+				t.Helper()
+				m := mocks.NewNotifierClient(t)
+				m.On("NotifyBatch", ctx, batchMatcher).Return(acknowledgeFirst, nil).Once()
+				return m
+			},
+			taskClient: func(t *testing.T) *mocks.TaskClient {
+				t.Helper()
+				m := mocks.NewTaskClient(t)
+				m.On("ScheduleSubscriberNotification", ctx, otherAuthID, notificationID).Return(nil).Once()
+				m.On("ScheduleSubscriberNotification", ctx, secondID, notificationID).Return(nil).Once()
+				return m
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var subscriptionRepository *mocks.SubscriptionRepository
+			if tt.subscriptionRepository != nil {
+				subscriptionRepository = tt.subscriptionRepository(t)
+			}
+
+			var matchRepository *mocks.MatchRepository
+			if tt.matchRepository != nil {
+				matchRepository = tt.matchRepository(t)
+			}
+
+			var notifierClient *mocks.NotifierClient
+			if tt.notifierClient != nil {
+				notifierClient = tt.notifierClient(t)
+			}
+
+			var taskClient *mocks.TaskClient
+			if tt.taskClient != nil {
+				taskClient = tt.taskClient(t)
+			}
+
+			notificationAttemptRepository := mocks.NewNotificationAttemptRepository(t)
+			notificationAttemptRepository.On("Create", ctx, mock.Anything).Return(&models.NotificationAttempt{}, nil).Maybe()
+
+			subscriberHostRepository := healthySubscriberHostRepository(t)
+			if tt.subscriberHostRepository != nil {
+				subscriberHostRepository = tt.subscriberHostRepository(t)
+			}
+
+			sns := sub.NewSubscriberNotifierService(
+				notificationConfig,
+				subscriptionRepository,
+				matchRepository,
+				notificationAttemptRepository,
+				nil,
+				subscriberHostRepository,
+				tt.notificationBatchRepository(t),
+				notifierClient,
+				taskClient,
+				loggerinternal.SetupLogger(),
+			)
+
+			err := sns.NotifyBatch(ctx, notificationBatchID)
+			if tt.expectedErr != nil {
+				assert.EqualError(t, err, tt.expectedErr.Error())
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+// healthySubscriberHostRepository is a repository of hosts which are never suspended, for cases not concerned with host health.
+func healthySubscriberHostRepository(t *testing.T) *mocks.SubscriberHostRepository {
+	t.Helper()
+
+	m := mocks.NewSubscriberHostRepository(t)
+	m.On("Get", mock.Anything, mock.Anything).Return(nil, models.NewResourceNotFoundError(errors.New("not found"))).Maybe()
+	m.On("RecordSuccess", mock.Anything, mock.Anything).Return(nil).Maybe()
+	m.On("RecordFailure", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(&models.SubscriberHost{ConsecutiveFailures: 1}, nil).Maybe()
+
+	return m
+}
+
+func subscriptionHost(t *testing.T, subscription models.Subscription) string {
+	t.Helper()
+
+	parsed, err := url.Parse(subscription.Url)
+	require.NoError(t, err)
+
+	return parsed.Hostname()
+}
+
+func subscriptionMatchedFunc(actual models.Subscription) bool {
+	if actual.SubscriberError != actual.SubscriberError {
+		return false
+	}
+
+	if actual.Status != models.SuccessfulSub {
+		return false
+	}
+
+	if actual.NotifiedAt.After(time.Now()) {
+		return false
+	}
+
+	return true
+}
+
+// notificationMatcher matches a notification ignoring its generated delivery id.
+// attemptMatcher matches a notification attempt ignoring its generated delivery id and execution time.
+func attemptMatcher(expected models.NotificationAttempt) any {
+	return mock.MatchedBy(func(actual models.NotificationAttempt) bool {
+		if actual.DeliveryID == "" || actual.ExecutedAt.IsZero() {
+			return false
+		}
+
+		expected.DeliveryID = actual.DeliveryID
+		expected.ExecutedAt = actual.ExecutedAt
+
+		return assert.ObjectsAreEqual(expected, actual)
+	})
+}
+
+// scheduleAtMatcher matches a schedule time which is within the given delay range from now.
+func scheduleAtMatcher(minDelay, maxDelay time.Duration) any {
+	return mock.MatchedBy(func(actual time.Time) bool {
+		delay := time.Until(actual)
+
+		return delay > minDelay-time.Second && delay <= maxDelay
+	})
 }
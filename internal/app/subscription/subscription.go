@@ -4,64 +4,505 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"net/http"
+	"net/mail"
+	"net/url"
+	"slices"
+	"strings"
+	"time"
 
+	"github.com/andrewshostak/result-service/config"
 	"github.com/andrewshostak/result-service/internal/app/models"
+	"github.com/google/uuid"
+)
+
+const (
+	telegramScheme = "telegram:"
+	mailtoScheme   = "mailto:"
 )
 
 type SubscriptionService struct {
-	subscriptionRepository SubscriptionRepository
-	matchRepository        MatchRepository
-	aliasRepository        AliasRepository
-	taskClient             TaskClient
-	logger                 Logger
+	config                        config.Subscription
+	subscriptionRepository        SubscriptionRepository
+	notificationAttemptRepository NotificationAttemptRepository
+	matchRepository               MatchRepository
+	outboxTaskRepository          OutboxTaskRepository
+	aliasRepository               AliasRepository
+	taskClient                    TaskClient
+	notifierClient                NotifierClient
+	logger                        Logger
 }
 
 func NewSubscriptionService(
+	config config.Subscription,
 	subscriptionRepository SubscriptionRepository,
+	notificationAttemptRepository NotificationAttemptRepository,
 	matchRepository MatchRepository,
+	outboxTaskRepository OutboxTaskRepository,
 	aliasRepository AliasRepository,
 	taskClient TaskClient,
+	notifierClient NotifierClient,
 	logger Logger,
 ) *SubscriptionService {
 	return &SubscriptionService{
-		subscriptionRepository: subscriptionRepository,
-		matchRepository:        matchRepository,
-		aliasRepository:        aliasRepository,
-		taskClient:             taskClient,
-		logger:                 logger,
+		config:                        config,
+		subscriptionRepository:        subscriptionRepository,
+		notificationAttemptRepository: notificationAttemptRepository,
+		matchRepository:               matchRepository,
+		outboxTaskRepository:          outboxTaskRepository,
+		aliasRepository:               aliasRepository,
+		taskClient:                    taskClient,
+		notifierClient:                notifierClient,
+		logger:                        logger,
 	}
 }
 
-func (s *SubscriptionService) Create(ctx context.Context, request models.CreateSubscriptionRequest) error {
+// Create creates a subscription and returns its id. A repeated request of the same match returns the id of the existing subscription.
+func (s *SubscriptionService) Create(ctx context.Context, request models.CreateSubscriptionRequest) (uint, error) {
 	match, err := s.matchRepository.One(ctx, models.Match{ID: request.MatchID})
 	if err != nil {
-		return fmt.Errorf("failed to get a match: %w", err)
+		return 0, fmt.Errorf("failed to get a match: %w", err)
+	}
+
+	allowed, err := s.isSubscriptionAllowed(ctx, *match)
+	if err != nil {
+		return 0, err
+	}
+
+	if !allowed {
+		return 0, models.NewUnprocessableContentError(errors.New("match result status doesn't allow to create a subscription"))
+	}
+
+	payloadVersion := request.PayloadVersion
+	if payloadVersion == "" {
+		payloadVersion = models.PayloadV1
+	}
+
+	deliveryFormat := request.DeliveryFormat
+	if deliveryFormat == "" {
+		deliveryFormat = models.FormatWebhook
+	}
+
+	channel := request.Channel
+	if channel == "" {
+		channel = models.ChannelWebhook
+	}
+
+	if err := s.validateChannel(channel, deliveryFormat, request); err != nil {
+		return 0, models.NewUnprocessableContentError(err)
+	}
+
+	httpMethod := request.HTTPMethod
+	if channel == models.ChannelWebhook && httpMethod == "" {
+		httpMethod = http.MethodPatch
+	}
+
+	// chat and email channels describe every event in a message, so only webhooks need a payload which tells events apart
+	eventTypes := s.eventTypes(request.EventTypes)
+	distinguishesEvents := channel != models.ChannelWebhook || payloadVersion != models.PayloadV1 || deliveryFormat != models.FormatWebhook
+	if len(eventTypes) > 1 && !distinguishesEvents {
+		return 0, models.NewUnprocessableContentError(errors.New("event types other than result.finished require payload version v2 or cloudevents delivery format"))
 	}
 
-	if !s.isMatchResultScheduled(*match) {
-		return models.NewUnprocessableContentError(errors.New("match result status doesn't allow to create a subscription"))
+	if request.RemindBefore != nil && !distinguishesEvents {
+		return 0, models.NewUnprocessableContentError(errors.New("kickoff reminder requires payload version v2 or cloudevents delivery format"))
 	}
 
-	_, err = s.subscriptionRepository.Create(ctx, models.Subscription{
-		MatchID: request.MatchID,
-		Key:     request.SecretKey,
-		Url:     request.URL,
+	// live check is scheduled before the subscription is created, so a retried request doesn't leave the match untracked
+	if slices.ContainsFunc(eventTypes, models.NotificationEventType.IsLive) {
+		err = s.taskClient.ScheduleLiveCheck(ctx, match.ID, 1, match.StartsAt)
+		if err != nil && !errors.As(err, &models.ResourceAlreadyExistsError{}) {
+			return 0, fmt.Errorf("failed to schedule live check: %w", err)
+		}
+	}
+
+	// the verification is requested by the subscriber, as existing consumers don't echo the challenge.
+	// Only webhook endpoints can echo it, destinations of other channels are validated by their format
+	status := models.PendingSub
+	if request.Verify {
+		status = models.UnverifiedSub
+	}
+
+	created, err := s.subscriptionRepository.Create(ctx, models.Subscription{
+		MatchID:        request.MatchID,
+		Key:            request.SecretKey,
+		Url:            request.URL,
+		PayloadVersion: payloadVersion,
+		DeliveryFormat: deliveryFormat,
+		EventTypes:     eventTypes,
+		Channel:        channel,
+		HTTPMethod:     httpMethod,
+		Headers:        request.Headers,
+		AuthHeader:     request.AuthHeader,
+		AuthScheme:     request.AuthScheme,
+		BatchURL:       request.BatchURL,
+		RemindBefore:   request.RemindBefore,
+		Status:         status,
 	})
 
 	if errors.As(err, &models.ResourceAlreadyExistsError{}) {
-		s.logger.Error().Uint("subscription_id", match.ID).Msg("subscription already exists")
+		return s.existingSubscriptionID(ctx, request, *match, err)
+	}
+
+	if err != nil {
+		return 0, fmt.Errorf("failed to create subscription: %w", err)
+	}
+
+	s.logger.Debug().Uint("subscription_id", created.ID).Msg("subscription created")
+
+	// the subscription stays unverified when the verification fails, it is repeated with Verify
+	if status == models.UnverifiedSub {
+		if err := s.verify(ctx, *created); err != nil {
+			s.logger.Info().Err(err).Uint("subscription_id", created.ID).Msg("subscriber endpoint is not verified")
+		}
+	}
+
+	if err := s.scheduleKickoffReminder(ctx, *created, *match); err != nil {
+		return 0, err
+	}
+
+	return created.ID, nil
+}
+
+// Verify repeats the verification handshake of an unverified subscription.
+func (s *SubscriptionService) Verify(ctx context.Context, id uint) error {
+	subscription, err := s.subscriptionRepository.Get(ctx, id)
+	if err != nil {
+		return fmt.Errorf("failed to get subscription: %w", err)
+	}
+
+	if subscription.Status != models.UnverifiedSub {
+		return models.NewUnprocessableContentError(errors.New("subscription is already verified"))
+	}
+
+	return s.verify(ctx, *subscription)
+}
+
+// verify sends a challenge to the subscriber endpoint. When the endpoint echoes it, the subscription becomes pending,
+// otherwise the reason is kept as the subscriber error and is returned as unprocessable content.
+func (s *SubscriptionService) verify(ctx context.Context, subscription models.Subscription) error {
+	verifyCtx, cancel := context.WithTimeout(ctx, s.config.VerificationTimeout)
+	defer cancel()
+
+	errVerify := s.notifierClient.Verify(verifyCtx, models.EndpointVerification{
+		SubscriptionID: subscription.ID,
+		DeliveryID:     uuid.NewString(),
+		Challenge:      uuid.NewString(),
+		HTTPMethod:     subscription.HTTPMethod,
+		Headers:        subscription.Headers,
+		AuthHeader:     subscription.AuthHeader,
+		AuthScheme:     subscription.AuthScheme,
+		Url:            subscription.Url,
+		Key:            subscription.Key,
+	})
+	if errVerify != nil {
+		errMessage := errVerify.Error()
+		err := s.subscriptionRepository.Update(ctx, subscription.ID, models.Subscription{Status: models.UnverifiedSub, SubscriberError: &errMessage})
+		if err != nil {
+			return fmt.Errorf("failed to update subscription verification error: %w", err)
+		}
+
+		return models.NewUnprocessableContentError(fmt.Errorf("endpoint verification failed: %w", errVerify))
+	}
+
+	if err := s.subscriptionRepository.Update(ctx, subscription.ID, models.Subscription{Status: models.PendingSub}); err != nil {
+		return fmt.Errorf("failed to update subscription status to %s: %w", models.PendingSub, err)
+	}
+
+	s.logger.Debug().Uint("subscription_id", subscription.ID).Msg("subscriber endpoint verified")
+
+	return nil
+}
+
+// Test sends a sample notification to the subscriber through the channel of the subscription. The subscription isn't changed,
+// and the exchange isn't stored in the delivery history. An unsuccessful delivery is described by the result, not returned as an error.
+func (s *SubscriptionService) Test(ctx context.Context, id uint) (*models.TestDeliveryResult, error) {
+	subscription, err := s.subscriptionRepository.Get(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get subscription: %w", err)
+	}
+
+	return s.test(ctx, models.SubscriberNotification{
+		PayloadVersion: subscription.PayloadVersion,
+		DeliveryFormat: subscription.DeliveryFormat,
+		Channel:        subscription.Channel,
+		HTTPMethod:     subscription.HTTPMethod,
+		Headers:        subscription.Headers,
+		AuthHeader:     subscription.AuthHeader,
+		AuthScheme:     subscription.AuthScheme,
+		Url:            subscription.Url,
+		Key:            subscription.Key,
+	}), nil
+}
+
+// TestURL sends a sample notification to the destination without a subscription, so integrators can try their handler out before subscribing.
+func (s *SubscriptionService) TestURL(ctx context.Context, request models.TestDeliveryRequest) (*models.TestDeliveryResult, error) {
+	payloadVersion := request.PayloadVersion
+	if payloadVersion == "" {
+		payloadVersion = models.PayloadV1
+	}
+
+	deliveryFormat := request.DeliveryFormat
+	if deliveryFormat == "" {
+		deliveryFormat = models.FormatWebhook
+	}
+
+	channel := request.Channel
+	if channel == "" {
+		channel = models.ChannelWebhook
+	}
+
+	err := s.validateChannel(channel, deliveryFormat, models.CreateSubscriptionRequest{
+		URL:        request.URL,
+		SecretKey:  request.SecretKey,
+		HTTPMethod: request.HTTPMethod,
+		Headers:    request.Headers,
+		AuthHeader: request.AuthHeader,
+		AuthScheme: request.AuthScheme,
+	})
+	if err != nil {
+		return nil, models.NewUnprocessableContentError(err)
+	}
+
+	httpMethod := request.HTTPMethod
+	if channel == models.ChannelWebhook && httpMethod == "" {
+		httpMethod = http.MethodPatch
+	}
+
+	return s.test(ctx, models.SubscriberNotification{
+		PayloadVersion: payloadVersion,
+		DeliveryFormat: deliveryFormat,
+		Channel:        channel,
+		HTTPMethod:     httpMethod,
+		Headers:        request.Headers,
+		AuthHeader:     request.AuthHeader,
+		AuthScheme:     request.AuthScheme,
+		Url:            request.URL,
+		Key:            request.SecretKey,
+	}), nil
+}
+
+// test fills the destination with a made-up finished match, so the sample can't be taken for a real result, and sends it.
+func (s *SubscriptionService) test(ctx context.Context, notification models.SubscriberNotification) *models.TestDeliveryResult {
+	testCtx, cancel := context.WithTimeout(ctx, s.config.TestDeliveryTimeout)
+	defer cancel()
+
+	finishType := models.FinishRegularTime
+	notification.DeliveryID = uuid.NewString()
+	notification.EventType = models.EventResultFinished
+	notification.StartsAt = time.Now().UTC().Add(-2 * time.Hour).Truncate(time.Minute)
+	notification.HomeTeam = models.Team{Aliases: []string{"Home Team"}}
+	notification.AwayTeam = models.Team{Aliases: []string{"Away Team"}}
+	notification.FinishType = &finishType
+	notification.Home = 2
+	notification.Away = 1
+	notification.Test = true
+
+	result := models.TestDeliveryResult{DeliveryID: notification.DeliveryID}
+
+	response, err := s.notifierClient.Notify(testCtx, notification)
+	if response != nil {
+		result.StatusCode = response.StatusCode
+		result.Body = response.Body
+		result.Latency = response.Latency
+	}
+
+	if err != nil {
+		errMessage := err.Error()
+		result.Error = &errMessage
+	}
+
+	s.logger.Debug().Str("delivery_id", result.DeliveryID).Err(err).Msg("test delivery sent")
+
+	return &result
+}
+
+// existingSubscriptionID returns the id of the subscription which has the requested url.
+// The url of a subscription of another match is reported as already existing.
+// The kickoff reminder is scheduled again, as the request may be retried after its scheduling has failed.
+func (s *SubscriptionService) existingSubscriptionID(ctx context.Context, request models.CreateSubscriptionRequest, match models.Match, errCreate error) (uint, error) {
+	existing, err := s.subscriptionRepository.Search(ctx, models.SubscriptionFilter{MatchID: &request.MatchID, URL: request.URL})
+	if err != nil {
+		return 0, fmt.Errorf("failed to find existing subscription: %w", err)
+	}
+
+	if len(existing) == 0 {
+		return 0, fmt.Errorf("failed to create subscription: %w", errCreate)
+	}
+
+	s.logger.Debug().Uint("subscription_id", existing[0].ID).Msg("subscription already exists")
+
+	if err := s.scheduleKickoffReminder(ctx, existing[0], match); err != nil {
+		return 0, err
+	}
+
+	return existing[0].ID, nil
+}
+
+// scheduleKickoffReminder schedules the reminder of the subscription which has requested it. No reminder is scheduled after the kickoff.
+func (s *SubscriptionService) scheduleKickoffReminder(ctx context.Context, subscription models.Subscription, match models.Match) error {
+	if subscription.RemindBefore == nil || !match.StartsAt.After(time.Now()) {
 		return nil
 	}
 
+	return scheduleKickoffReminder(ctx, s.taskClient, subscription, match.StartsAt)
+}
+
+func (s *SubscriptionService) Get(ctx context.Context, id uint) (*models.Subscription, error) {
+	subscription, err := s.subscriptionRepository.Get(ctx, id)
 	if err != nil {
-		return fmt.Errorf("failed to create subscription: %w", err)
+		return nil, fmt.Errorf("failed to get subscription: %w", err)
 	}
 
-	s.logger.Debug().Uint("subscription_id", match.ID).Msg("subscription created")
+	return subscription, nil
+}
+
+func (s *SubscriptionService) List(ctx context.Context, filter models.SubscriptionFilter) ([]models.Subscription, error) {
+	subscriptions, err := s.subscriptionRepository.Search(ctx, filter)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list subscriptions: %w", err)
+	}
+
+	return subscriptions, nil
+}
+
+// eventTypes returns unique requested event types. Result is always delivered, so result.finished is always included.
+func (s *SubscriptionService) eventTypes(requested []models.NotificationEventType) []models.NotificationEventType {
+	eventTypes := []models.NotificationEventType{models.EventResultFinished}
+	for _, eventType := range requested {
+		if !slices.Contains(eventTypes, eventType) {
+			eventTypes = append(eventTypes, eventType)
+		}
+	}
+
+	return eventTypes
+}
+
+// validateChannel checks that the url is a destination of the channel and that webhook options are given to webhooks only.
+func (s *SubscriptionService) validateChannel(channel models.NotificationChannel, deliveryFormat models.DeliveryFormat, request models.CreateSubscriptionRequest) error {
+	if channel != models.ChannelWebhook {
+		if request.HTTPMethod != "" || len(request.Headers) > 0 {
+			return errors.New("http method and headers are supported by webhook channel only")
+		}
+
+		if request.AuthHeader != nil || request.AuthScheme != nil {
+			return errors.New("auth header is supported by webhook channel only")
+		}
+
+		if deliveryFormat != models.FormatWebhook {
+			return errors.New("cloudevents delivery formats are supported by webhook channel only")
+		}
+
+		if request.BatchURL != nil {
+			return errors.New("batch url is supported by webhook channel only")
+		}
+
+		if request.Verify {
+			return errors.New("verification is supported by webhook channel only")
+		}
+	}
+
+	switch channel {
+	case models.ChannelWebhook:
+		if err := validateURL(request.URL, "http", "https"); err != nil {
+			return err
+		}
+
+		for name, value := range request.Headers {
+			if !models.IsHeaderName(name) || strings.ContainsAny(value, "\r\n") {
+				return fmt.Errorf("header %q is not valid", name)
+			}
+		}
+
+		if err := models.ValidateAuthHeader(request.AuthHeader, request.AuthScheme, request.SecretKey); err != nil {
+			return err
+		}
+
+		if request.BatchURL != nil {
+			if err := validateBatchURL(request.URL, *request.BatchURL, deliveryFormat); err != nil {
+				return err
+			}
+		}
+	case models.ChannelSlack, models.ChannelDiscord:
+		if err := validateURL(request.URL, "https"); err != nil {
+			return fmt.Errorf("url of %s channel must be an https incoming webhook url: %w", channel, err)
+		}
+	case models.ChannelTelegram:
+		chatID, ok := strings.CutPrefix(request.URL, telegramScheme)
+		if !ok || chatID == "" {
+			return errors.New("url of telegram channel must be telegram:<chat_id>")
+		}
+	case models.ChannelEmail:
+		address, ok := strings.CutPrefix(request.URL, mailtoScheme)
+		parsed, err := mail.ParseAddress(address)
+		if !ok || err != nil || parsed.Address != address {
+			return errors.New("url of email channel must be mailto:<address>")
+		}
+	default:
+		return fmt.Errorf("notification channel %s is not supported", channel)
+	}
+
+	return nil
+}
+
+// validateURL checks that the url is an absolute url of one of the schemes, and that it doesn't carry credentials,
+// which would be sent to the destination and leak in logs. Addresses the url resolves to are checked on every delivery.
+func validateURL(rawURL string, schemes ...string) error {
+	parsed, err := url.ParseRequestURI(rawURL)
+	if err != nil {
+		return errors.New("url is not valid")
+	}
+
+	if !slices.Contains(schemes, parsed.Scheme) {
+		return fmt.Errorf("url scheme %q is not allowed, allowed schemes: %s", parsed.Scheme, strings.Join(schemes, ", "))
+	}
+
+	if parsed.Hostname() == "" {
+		return errors.New("url has to contain a host")
+	}
+
+	if parsed.User != nil {
+		return errors.New("url must not contain credentials")
+	}
+
+	return nil
+}
+
+// validateBatchURL checks that the batch url shares the base url (scheme and host) of the subscription url.
+// Batch items carry the payload only, so the batch isn't available for cloudevents delivery formats.
+func validateBatchURL(rawURL string, batchURL string, deliveryFormat models.DeliveryFormat) error {
+	if deliveryFormat != models.FormatWebhook {
+		return errors.New("batch url is supported by webhook delivery format only")
+	}
+
+	if err := validateURL(batchURL, "http", "https"); err != nil {
+		return fmt.Errorf("batch url is not valid: %w", err)
+	}
+
+	parsed, _ := url.Parse(rawURL)
+	parsedBatch, _ := url.Parse(batchURL)
+	if parsed.Scheme != parsedBatch.Scheme || !strings.EqualFold(parsed.Host, parsedBatch.Host) {
+		return errors.New("batch url has to share the scheme and the host of the url")
+	}
 
 	return nil
 }
 
+// urlHost returns the lowercased host of an http url, destinations of channels without a url host have no host.
+func urlHost(rawURL string) string {
+	parsed, err := url.Parse(rawURL)
+	if err != nil || (parsed.Scheme != "http" && parsed.Scheme != "https") {
+		return ""
+	}
+
+	return strings.ToLower(parsed.Hostname())
+}
+
+// suspensionError describes why a delivery to the host isn't made.
+func suspensionError(host string) string {
+	return fmt.Sprintf("deliveries to host %s are suspended", host)
+}
+
 func (s *SubscriptionService) Delete(ctx context.Context, request models.DeleteSubscriptionRequest) error {
 	aliasHome, err := s.aliasRepository.Find(ctx, request.AliasHome)
 	if err != nil {
@@ -79,39 +520,55 @@ func (s *SubscriptionService) Delete(ctx context.Context, request models.DeleteS
 		AwayTeamID: aliasAway.TeamID,
 	})
 	if err != nil {
-		s.logger.Error().
-			Err(err).
-			Uint("home_team_id", aliasHome.TeamID).
-			Uint("away_team_id", aliasAway.TeamID).
-			Time("starts_at", request.StartsAt).
-			Msg("failed to find match")
-		return nil
+		return fmt.Errorf("failed to find match: %w", err)
 	}
 
 	subscription, err := s.subscriptionRepository.One(ctx, match.ID, request.SecretKey, request.BaseURL)
 	if err != nil {
-		s.logger.Error().
-			Err(err).
-			Uint("match_id", match.ID).
-			Str("base_url", request.BaseURL).
-			Msg("failed to find subscription")
-		return nil
+		return fmt.Errorf("failed to find subscription: %w", err)
 	}
 
-	if s.isSubscriberNotified(*subscription) {
+	return s.delete(ctx, *subscription, *match)
+}
+
+func (s *SubscriptionService) DeleteByID(ctx context.Context, id uint) error {
+	subscription, err := s.subscriptionRepository.Get(ctx, id)
+	if err != nil {
+		return fmt.Errorf("failed to get subscription: %w", err)
+	}
+
+	match, err := s.matchRepository.One(ctx, models.Match{ID: subscription.MatchID})
+	if err != nil {
+		return fmt.Errorf("failed to get a match: %w", err)
+	}
+
+	return s.delete(ctx, *subscription, *match)
+}
+
+// delete removes the subscription and its kickoff reminder task. The match and its result check task are removed when
+// the match has no other subscriptions, pending outbox tasks of the match are removed with it.
+// Reminder tasks of previous kickoffs and live check tasks are not deleted, they skip the deleted subscription and match.
+func (s *SubscriptionService) delete(ctx context.Context, subscription models.Subscription, match models.Match) error {
+	if s.isSubscriberNotified(subscription) {
 		return models.NewUnprocessableContentError(errors.New("not allowed to delete successfully notified subscription"))
 	}
 
-	err = s.subscriptionRepository.Delete(ctx, subscription.ID)
+	err := s.subscriptionRepository.Delete(ctx, subscription.ID)
 	if err != nil {
 		return fmt.Errorf("failed to delete subscription: %w", err)
 	}
 
 	s.logger.Debug().Uint("subscription_id", subscription.ID).Msg("subscription deleted")
 
+	if subscription.RemindBefore != nil {
+		if err := s.taskClient.DeleteKickoffReminderTask(ctx, subscription.ID, match.StartsAt); err != nil {
+			s.logger.Error().Err(err).Uint("subscription_id", subscription.ID).Msg("failed to delete kickoff reminder task")
+		}
+	}
+
 	otherSubscriptions, errList := s.subscriptionRepository.List(ctx, match.ID)
 	if errList != nil {
-		s.logger.Error().Err(err).Uint("match_id", match.ID).Msg("failed to check other subscriptions presence")
+		s.logger.Error().Err(errList).Uint("match_id", match.ID).Msg("failed to check other subscriptions presence")
 		return nil
 	}
 
@@ -141,8 +598,115 @@ func (s *SubscriptionService) Delete(ctx context.Context, request models.DeleteS
 	return nil
 }
 
-func (s *SubscriptionService) isMatchResultScheduled(match models.Match) bool {
-	return match.ResultStatus == models.Scheduled
+// RotateSecretKey replaces the secret key of all subscriptions having it. Deliveries are signed with both keys
+// during the rotation window, so subscribers can switch to the new key without rejecting deliveries.
+func (s *SubscriptionService) RotateSecretKey(ctx context.Context, request models.RotateSecretKeyRequest) error {
+	if request.SecretKey == request.NewSecretKey {
+		return models.NewUnprocessableContentError(errors.New("new secret key must differ from the current one"))
+	}
+
+	rotated, err := s.subscriptionRepository.RotateKey(ctx, request.SecretKey, request.NewSecretKey, time.Now().Add(s.config.SecretRotationWindow))
+	if err != nil {
+		return fmt.Errorf("failed to rotate secret key: %w", err)
+	}
+
+	if rotated == 0 {
+		return models.NewResourceNotFoundError(errors.New("subscriptions with the secret key are not found"))
+	}
+
+	s.logger.Info().Int64("number_of_subscriptions", rotated).Msg("subscriptions secret key rotated")
+
+	return nil
+}
+
+func (s *SubscriptionService) ListDeliveries(ctx context.Context, subscriptionID uint) ([]models.NotificationAttempt, error) {
+	if _, err := s.subscriptionRepository.Get(ctx, subscriptionID); err != nil {
+		return nil, fmt.Errorf("failed to get subscription: %w", err)
+	}
+
+	attempts, err := s.notificationAttemptRepository.ListBySubscription(ctx, subscriptionID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list notification attempts: %w", err)
+	}
+
+	return attempts, nil
+}
+
+// Redeliver re-enqueues a notification of the subscriber and returns the id of the redelivery.
+// The match result must already be received, notifications of successfully notified subscribers are repeated only when forced.
+func (s *SubscriptionService) Redeliver(ctx context.Context, request models.RedeliverRequest) (string, error) {
+	subscription, err := s.subscriptionRepository.Get(ctx, request.SubscriptionID)
+	if err != nil {
+		return "", fmt.Errorf("failed to get subscription: %w", err)
+	}
+
+	if !s.isRedeliverable(*subscription) {
+		return "", models.NewUnprocessableContentError(fmt.Errorf("subscription status %s doesn't allow redelivery", subscription.Status))
+	}
+
+	if s.isSubscriberNotified(*subscription) && !request.Force {
+		return "", models.NewUnprocessableContentError(errors.New("subscriber is already notified, use force to redeliver"))
+	}
+
+	match, err := s.matchRepository.One(ctx, models.Match{ID: subscription.MatchID})
+	if err != nil {
+		return "", fmt.Errorf("failed to get a match: %w", err)
+	}
+
+	if match.ResultStatus != models.Received {
+		return "", models.NewUnprocessableContentError(errors.New("match result is not received yet"))
+	}
+
+	if subscription.Status != models.PendingSub {
+		if err := s.subscriptionRepository.Update(ctx, subscription.ID, models.Subscription{Status: models.PendingSub}); err != nil {
+			return "", fmt.Errorf("failed to update subscription status to %s: %w", models.PendingSub, err)
+		}
+	}
+
+	redeliveryID := uuid.NewString()
+	if err := s.taskClient.ScheduleSubscriberNotification(ctx, subscription.ID, redeliveryID); err != nil {
+		errUpdate := s.subscriptionRepository.Update(ctx, subscription.ID, models.Subscription{Status: models.SchedulingErrorSub})
+		if errUpdate != nil {
+			s.logger.Error().Err(errUpdate).Uint("subscription_id", subscription.ID).Msg(fmt.Sprintf("failed to update subscription status to: %s", string(models.SchedulingErrorSub)))
+		}
+
+		return "", fmt.Errorf("failed to schedule subscriber notification: %w", err)
+	}
+
+	s.logger.Info().Uint("subscription_id", subscription.ID).Str("redelivery_id", redeliveryID).Msg("subscriber notification redelivery scheduled")
+
+	return redeliveryID, nil
+}
+
+// isRedeliverable reports whether the notification of the subscription can be repeated. A suspended subscription is
+// redelivered as well, so it can be brought back without enabling its whole host. While the host is still suspended,
+// the redelivery is suspended again.
+func (s *SubscriptionService) isRedeliverable(subscription models.Subscription) bool {
+	switch subscription.Status {
+	case models.PendingSub, models.SchedulingErrorSub, models.SubscriberErrorSub, models.DeadLetterSub, models.SuccessfulSub, models.SuspendedSub:
+		return true
+	default:
+		return false
+	}
+}
+
+// isSubscriptionAllowed reports whether the match accepts subscriptions. A not scheduled match whose result check
+// is waiting in the outbox gets scheduled by the outbox dispatch, so it accepts subscriptions as well.
+func (s *SubscriptionService) isSubscriptionAllowed(ctx context.Context, match models.Match) (bool, error) {
+	if match.ResultStatus == models.Scheduled {
+		return true, nil
+	}
+
+	if match.ResultStatus != models.NotScheduled {
+		return false, nil
+	}
+
+	pending, err := s.outboxTaskRepository.HasPending(ctx, match.ID, models.OutboxKindResultCheck)
+	if err != nil {
+		return false, fmt.Errorf("failed to check pending result check outbox task: %w", err)
+	}
+
+	return pending, nil
 }
 
 func (s *SubscriptionService) isSubscriberNotified(subscription models.Subscription) bool {
@@ -8,10 +8,14 @@ import (
 )
 
 type Handlers struct {
-	MatchHandler        *handler.MatchHandler
-	SubscriptionHandler *handler.SubscriptionHandler
-	AliasHandler        *handler.AliasHandler
-	TriggerHandler      *handler.TriggerHandler
+	MatchHandler                *handler.MatchHandler
+	SubscriptionHandler         *handler.SubscriptionHandler
+	StandingSubscriptionHandler *handler.StandingSubscriptionHandler
+	SubscriberHostHandler       *handler.SubscriberHostHandler
+	AliasHandler                *handler.AliasHandler
+	ResultHandler               *handler.ResultHandler
+	MatchUpdateHandler          *handler.MatchUpdateHandler
+	TriggerHandler              *handler.TriggerHandler
 }
 
 func NewServer(cfg config.Server, handlers Handlers) (*gin.Engine, error) {
@@ -28,15 +32,51 @@ func registerRoutes(r *gin.Engine, cfg config.Server, handlers Handlers) {
 		Use(middleware.APIKeyAuth(cfg.App.HashedAPIKeys, cfg.App.SecretKey)).
 		Use(middleware.Timeout(cfg.App.Timeout))
 
+	// pulls of the result feed are held while they wait for new events
+	longPolling := v1.Group("").
+		Use(middleware.APIKeyAuth(cfg.App.HashedAPIKeys, cfg.App.SecretKey)).
+		Use(middleware.Timeout(cfg.App.Timeout + cfg.ResultFeed.MaxWait))
+
+	// streams stay open while the client is connected, so they are not limited by timeout
+	streaming := v1.Group("").
+		Use(middleware.APIKeyAuth(cfg.App.HashedAPIKeys, cfg.App.SecretKey))
+
 	googleAuth := v1.Group("").
 		Use(middleware.ValidateGoogleAuth(cfg.GoogleCloud.TargetURL)).
 		Use(middleware.Timeout(cfg.App.TriggersTimeout))
 
 	apiKey.POST("/matches", handlers.MatchHandler.Create)
+	apiKey.GET("/matches/:id", handlers.MatchHandler.Get)
+	apiKey.GET("/matches/:id/result_check_attempts", handlers.MatchHandler.ListResultCheckAttempts)
 	apiKey.POST("/subscriptions", handlers.SubscriptionHandler.Create)
+	apiKey.GET("/subscriptions", handlers.SubscriptionHandler.List)
+	apiKey.GET("/subscriptions/:id", handlers.SubscriptionHandler.Get)
 	apiKey.DELETE("/subscriptions", handlers.SubscriptionHandler.Delete)
+	apiKey.DELETE("/subscriptions/:id", handlers.SubscriptionHandler.DeleteByID)
+	apiKey.PUT("/subscriptions/secret_key", handlers.SubscriptionHandler.RotateSecretKey)
+	apiKey.GET("/subscriptions/:id/deliveries", handlers.SubscriptionHandler.ListDeliveries)
+	apiKey.POST("/subscriptions/:id/redeliver", handlers.SubscriptionHandler.Redeliver)
+	apiKey.POST("/subscriptions/:id/verify", handlers.SubscriptionHandler.Verify)
+	apiKey.POST("/subscriptions/:id/test", handlers.SubscriptionHandler.Test)
+	apiKey.POST("/subscriptions/test", handlers.SubscriptionHandler.TestURL)
+	apiKey.GET("/subscriber_hosts", handlers.SubscriberHostHandler.List)
+	apiKey.POST("/subscriber_hosts/:host/enable", handlers.SubscriberHostHandler.Enable)
+	apiKey.POST("/standing_subscriptions", handlers.StandingSubscriptionHandler.Create)
+	apiKey.GET("/standing_subscriptions", handlers.StandingSubscriptionHandler.List)
+	apiKey.DELETE("/standing_subscriptions/:id", handlers.StandingSubscriptionHandler.Delete)
 	apiKey.GET("/aliases", handlers.AliasHandler.Search)
 
+	longPolling.GET("/results", handlers.ResultHandler.List)
+
+	streaming.GET("/match_updates/stream", handlers.MatchUpdateHandler.Stream)
+
 	googleAuth.POST("/triggers/result_check", handlers.TriggerHandler.CheckResult)
+	googleAuth.POST("/triggers/live_check", handlers.TriggerHandler.CheckLive)
 	googleAuth.POST("/triggers/subscriber_notification", handlers.TriggerHandler.NotifySubscriber)
+	googleAuth.POST("/triggers/event_delivery", handlers.TriggerHandler.NotifyEvent)
+	googleAuth.POST("/triggers/notification_batch", handlers.TriggerHandler.NotifyBatch)
+	googleAuth.POST("/triggers/kickoff_reminder", handlers.TriggerHandler.RemindKickoff)
+	googleAuth.POST("/triggers/reconciliation", handlers.TriggerHandler.Reconcile)
+	googleAuth.POST("/triggers/outbox_dispatch", handlers.TriggerHandler.DispatchOutbox)
+	googleAuth.POST("/triggers/fixture_planning", handlers.TriggerHandler.PlanFixtures)
 }
@@ -0,0 +1,248 @@
+// Package secrets encrypts subscriber secrets at rest with envelope encryption.
+//
+// Every value is encrypted with its own random data key (AES-256-GCM), the data key is encrypted (wrapped)
+// with a key encryption key of the keyring. An encrypted value has the format:
+//
+//	enc:v1:<key_id>:<wrapped_data_key>:<ciphertext>
+//
+// where the key id names the key encryption key and the binary parts are base64url encoded.
+// Rotation of the key encryption key re-wraps only the data key, so the ciphertext stays the same.
+//
+// Encryption is not deterministic, so secrets are looked up by a keyed hash (HMAC-SHA256) of the plaintext.
+package secrets
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+const (
+	prefix    = "enc:v1:"
+	keyLength = 32
+)
+
+var (
+	ErrNotEncrypted = errors.New("value is not encrypted")
+	ErrUnknownKey   = errors.New("encryption key is unknown")
+
+	keyIDPattern = regexp.MustCompile(`^[A-Za-z0-9-]+$`)
+	encoding     = base64.RawURLEncoding
+)
+
+// Keyring holds key encryption keys by their ids. New values are encrypted with the current key,
+// other keys are kept to decrypt values encrypted before a rotation.
+type Keyring struct {
+	currentKeyID string
+	keys         map[string][]byte
+	hashKey      []byte
+}
+
+// NewKeyring creates a keyring from base64 encoded 32-byte keys.
+func NewKeyring(currentKeyID string, keys map[string]string, hashKey string) (*Keyring, error) {
+	decoded := make(map[string][]byte, len(keys))
+	for id, key := range keys {
+		if !keyIDPattern.MatchString(id) {
+			return nil, fmt.Errorf("encryption key id %q must contain only letters, digits and hyphens", id)
+		}
+
+		raw, err := decodeKey(key)
+		if err != nil {
+			return nil, fmt.Errorf("encryption key %s is invalid: %w", id, err)
+		}
+
+		decoded[id] = raw
+	}
+
+	if _, ok := decoded[currentKeyID]; !ok {
+		return nil, fmt.Errorf("current encryption key %q: %w", currentKeyID, ErrUnknownKey)
+	}
+
+	rawHashKey, err := decodeKey(hashKey)
+	if err != nil {
+		return nil, fmt.Errorf("hash key is invalid: %w", err)
+	}
+
+	return &Keyring{currentKeyID: currentKeyID, keys: decoded, hashKey: rawHashKey}, nil
+}
+
+// Encrypt encrypts the plaintext with a new data key wrapped with the current key.
+func (k *Keyring) Encrypt(plaintext string) (string, error) {
+	dataKey := make([]byte, keyLength)
+	if _, err := rand.Read(dataKey); err != nil {
+		return "", fmt.Errorf("failed to generate data key: %w", err)
+	}
+
+	ciphertext, err := seal(dataKey, []byte(plaintext))
+	if err != nil {
+		return "", fmt.Errorf("failed to encrypt value: %w", err)
+	}
+
+	wrapped, err := seal(k.keys[k.currentKeyID], dataKey)
+	if err != nil {
+		return "", fmt.Errorf("failed to wrap data key: %w", err)
+	}
+
+	return format(k.currentKeyID, wrapped, ciphertext), nil
+}
+
+// Decrypt returns the plaintext of the encrypted value.
+func (k *Keyring) Decrypt(value string) (string, error) {
+	keyID, wrapped, ciphertext, err := parse(value)
+	if err != nil {
+		return "", err
+	}
+
+	dataKey, err := k.unwrap(keyID, wrapped)
+	if err != nil {
+		return "", err
+	}
+
+	plaintext, err := open(dataKey, ciphertext)
+	if err != nil {
+		return "", fmt.Errorf("failed to decrypt value: %w", err)
+	}
+
+	return string(plaintext), nil
+}
+
+// Reencrypt re-wraps the data key of the encrypted value with the current key. A value which is not encrypted yet is encrypted.
+func (k *Keyring) Reencrypt(value string) (string, error) {
+	if !k.IsEncrypted(value) {
+		return k.Encrypt(value)
+	}
+
+	keyID, wrapped, ciphertext, err := parse(value)
+	if err != nil {
+		return "", err
+	}
+
+	if keyID == k.currentKeyID {
+		return value, nil
+	}
+
+	dataKey, err := k.unwrap(keyID, wrapped)
+	if err != nil {
+		return "", err
+	}
+
+	rewrapped, err := seal(k.keys[k.currentKeyID], dataKey)
+	if err != nil {
+		return "", fmt.Errorf("failed to wrap data key: %w", err)
+	}
+
+	return format(k.currentKeyID, rewrapped, ciphertext), nil
+}
+
+// IsEncrypted reports whether the value has the format of an encrypted value.
+func (k *Keyring) IsEncrypted(value string) bool {
+	return strings.HasPrefix(value, prefix)
+}
+
+// CurrentPrefix returns the prefix of values encrypted with the current key.
+func (k *Keyring) CurrentPrefix() string {
+	return prefix + k.currentKeyID + ":"
+}
+
+// Hash returns hex encoded keyed hash of the plaintext.
+func (k *Keyring) Hash(plaintext string) string {
+	mac := hmac.New(sha256.New, k.hashKey)
+	mac.Write([]byte(plaintext))
+
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+func (k *Keyring) unwrap(keyID string, wrapped []byte) ([]byte, error) {
+	key, ok := k.keys[keyID]
+	if !ok {
+		return nil, fmt.Errorf("key %q: %w", keyID, ErrUnknownKey)
+	}
+
+	dataKey, err := open(key, wrapped)
+	if err != nil {
+		return nil, fmt.Errorf("failed to unwrap data key: %w", err)
+	}
+
+	return dataKey, nil
+}
+
+func format(keyID string, wrapped []byte, ciphertext []byte) string {
+	return prefix + keyID + ":" + encoding.EncodeToString(wrapped) + ":" + encoding.EncodeToString(ciphertext)
+}
+
+func parse(value string) (string, []byte, []byte, error) {
+	parts := strings.Split(strings.TrimPrefix(value, prefix), ":")
+	if !strings.HasPrefix(value, prefix) || len(parts) != 3 {
+		return "", nil, nil, ErrNotEncrypted
+	}
+
+	wrapped, err := encoding.DecodeString(parts[1])
+	if err != nil {
+		return "", nil, nil, fmt.Errorf("failed to decode data key: %w", err)
+	}
+
+	ciphertext, err := encoding.DecodeString(parts[2])
+	if err != nil {
+		return "", nil, nil, fmt.Errorf("failed to decode ciphertext: %w", err)
+	}
+
+	return parts[0], wrapped, ciphertext, nil
+}
+
+// seal encrypts the plaintext with AES-256-GCM and prepends the random nonce.
+func seal(key []byte, plaintext []byte) ([]byte, error) {
+	gcm, err := newGCM(key)
+	if err != nil {
+		return nil, err
+	}
+
+	nonce := make([]byte, gcm.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, err
+	}
+
+	return gcm.Seal(nonce, nonce, plaintext, nil), nil
+}
+
+func open(key []byte, sealed []byte) ([]byte, error) {
+	gcm, err := newGCM(key)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(sealed) < gcm.NonceSize() {
+		return nil, errors.New("ciphertext is too short")
+	}
+
+	return gcm.Open(nil, sealed[:gcm.NonceSize()], sealed[gcm.NonceSize():], nil)
+}
+
+func newGCM(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+
+	return cipher.NewGCM(block)
+}
+
+func decodeKey(key string) ([]byte, error) {
+	raw, err := base64.StdEncoding.DecodeString(key)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode base64: %w", err)
+	}
+
+	if len(raw) != keyLength {
+		return nil, fmt.Errorf("key must be %d bytes long", keyLength)
+	}
+
+	return raw, nil
+}
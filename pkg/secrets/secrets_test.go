@@ -0,0 +1,148 @@
+package secrets_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/andrewshostak/result-service/pkg/secrets"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	oldKey  = "MDEyMzQ1Njc4OWFiY2RlZjAxMjM0NTY3ODlhYmNkZWY="
+	newKey  = "ZmVkY2JhOTg3NjU0MzIxMGZlZGNiYTk4NzY1NDMyMTA="
+	hashKey = "aGFzaC1rZXktaGFzaC1rZXktaGFzaC1rZXktaGFzaCE="
+)
+
+func TestNewKeyring(t *testing.T) {
+	tests := []struct {
+		name         string
+		currentKeyID string
+		keys         map[string]string
+		hashKey      string
+		expectedErr  string
+	}{
+		{
+			name:         "success - it creates a keyring",
+			currentKeyID: "k1",
+			keys:         map[string]string{"k1": oldKey},
+			hashKey:      hashKey,
+		},
+		{
+			name:         "it returns an error when the current key is not in the keyring",
+			currentKeyID: "k2",
+			keys:         map[string]string{"k1": oldKey},
+			hashKey:      hashKey,
+			expectedErr:  `current encryption key "k2": encryption key is unknown`,
+		},
+		{
+			name:         "it returns an error when a key is not 32 bytes long",
+			currentKeyID: "k1",
+			keys:         map[string]string{"k1": "c2hvcnQ="},
+			hashKey:      hashKey,
+			expectedErr:  "encryption key k1 is invalid: key must be 32 bytes long",
+		},
+		{
+			name:         "it returns an error when a key id has a separator",
+			currentKeyID: "k:1",
+			keys:         map[string]string{"k:1": oldKey},
+			hashKey:      hashKey,
+			expectedErr:  `encryption key id "k:1" must contain only letters, digits and hyphens`,
+		},
+		{
+			name:         "it returns an error when hash key is not valid",
+			currentKeyID: "k1",
+			keys:         map[string]string{"k1": oldKey},
+			hashKey:      "not base64",
+			expectedErr:  "hash key is invalid: failed to decode base64: illegal base64 data at input byte 3",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := secrets.NewKeyring(tt.currentKeyID, tt.keys, tt.hashKey)
+			if tt.expectedErr != "" {
+				assert.EqualError(t, err, tt.expectedErr)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestKeyring_EncryptDecrypt(t *testing.T) {
+	keyring, err := secrets.NewKeyring("k1", map[string]string{"k1": oldKey}, hashKey)
+	require.NoError(t, err)
+
+	encrypted, err := keyring.Encrypt("subscriber-secret")
+	require.NoError(t, err)
+
+	assert.True(t, strings.HasPrefix(encrypted, "enc:v1:k1:"))
+	assert.NotContains(t, encrypted, "subscriber-secret")
+	assert.True(t, keyring.IsEncrypted(encrypted))
+	assert.False(t, keyring.IsEncrypted("subscriber-secret"))
+
+	again, err := keyring.Encrypt("subscriber-secret")
+	require.NoError(t, err)
+	assert.NotEqual(t, encrypted, again)
+
+	decrypted, err := keyring.Decrypt(encrypted)
+	require.NoError(t, err)
+	assert.Equal(t, "subscriber-secret", decrypted)
+
+	_, err = keyring.Decrypt("subscriber-secret")
+	assert.ErrorIs(t, err, secrets.ErrNotEncrypted)
+
+	// data key of one value can't decrypt the ciphertext of another one
+	mixed := encrypted[:strings.LastIndex(encrypted, ":")] + again[strings.LastIndex(again, ":"):]
+	_, err = keyring.Decrypt(mixed)
+	assert.ErrorContains(t, err, "failed to decrypt value")
+}
+
+func TestKeyring_Reencrypt(t *testing.T) {
+	before, err := secrets.NewKeyring("k1", map[string]string{"k1": oldKey}, hashKey)
+	require.NoError(t, err)
+
+	encrypted, err := before.Encrypt("subscriber-secret")
+	require.NoError(t, err)
+
+	after, err := secrets.NewKeyring("k2", map[string]string{"k1": oldKey, "k2": newKey}, hashKey)
+	require.NoError(t, err)
+
+	rotated, err := after.Reencrypt(encrypted)
+	require.NoError(t, err)
+	assert.True(t, strings.HasPrefix(rotated, after.CurrentPrefix()))
+	// only the data key is re-wrapped, so the ciphertext stays the same
+	assert.Equal(t, encrypted[strings.LastIndex(encrypted, ":"):], rotated[strings.LastIndex(rotated, ":"):])
+
+	decrypted, err := after.Decrypt(rotated)
+	require.NoError(t, err)
+	assert.Equal(t, "subscriber-secret", decrypted)
+
+	unchanged, err := after.Reencrypt(rotated)
+	require.NoError(t, err)
+	assert.Equal(t, rotated, unchanged)
+
+	_, err = before.Decrypt(rotated)
+	assert.ErrorIs(t, err, secrets.ErrUnknownKey)
+
+	plaintext, err := after.Reencrypt("legacy-secret")
+	require.NoError(t, err)
+	decrypted, err = after.Decrypt(plaintext)
+	require.NoError(t, err)
+	assert.Equal(t, "legacy-secret", decrypted)
+}
+
+func TestKeyring_Hash(t *testing.T) {
+	keyring, err := secrets.NewKeyring("k1", map[string]string{"k1": oldKey}, hashKey)
+	require.NoError(t, err)
+
+	other, err := secrets.NewKeyring("k1", map[string]string{"k1": oldKey}, newKey)
+	require.NoError(t, err)
+
+	assert.Len(t, keyring.Hash("subscriber-secret"), 64)
+	assert.Equal(t, keyring.Hash("subscriber-secret"), keyring.Hash("subscriber-secret"))
+	assert.NotEqual(t, keyring.Hash("subscriber-secret"), keyring.Hash("other-secret"))
+	assert.NotEqual(t, keyring.Hash("subscriber-secret"), other.Hash("subscriber-secret"))
+}
@@ -0,0 +1,144 @@
+// Package webhook signs result-service webhook deliveries and verifies them on the subscriber side.
+//
+// Every delivery carries three headers:
+//   - X-Result-Delivery-Id: unique id of the delivery, retries carry the same id, subscribers should ignore ids they have already processed
+//   - X-Result-Timestamp: unix time (seconds) of the delivery
+//   - X-Result-Signature: comma separated list of "v1=<hex>" signatures, where each signature is
+//     HMAC-SHA256 of "<timestamp>.<body>" computed with one of the subscription secrets
+//
+// Sample deliveries, sent on request of the integrator to try the handler out, carry X-Result-Test: true header as well.
+//
+// During a secret rotation a delivery is signed with both the new and the previous secret,
+// so a subscriber that still uses the previous secret keeps accepting deliveries.
+package webhook
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	HeaderDeliveryID = "X-Result-Delivery-Id"
+	HeaderTimestamp  = "X-Result-Timestamp"
+	HeaderSignature  = "X-Result-Signature"
+	HeaderTest       = "X-Result-Test"
+
+	// DefaultTolerance is the maximum accepted difference between the delivery timestamp and the current time.
+	DefaultTolerance = 5 * time.Minute
+
+	signatureVersion = "v1"
+)
+
+var (
+	ErrMissingHeader     = errors.New("webhook header is missing")
+	ErrInvalidTimestamp  = errors.New("webhook timestamp is invalid")
+	ErrTimestampExpired  = errors.New("webhook timestamp is outside of the tolerance")
+	ErrSignatureMismatch = errors.New("webhook signature doesn't match")
+)
+
+// Delivery is the verified metadata of a webhook delivery.
+type Delivery struct {
+	ID        string
+	Timestamp time.Time
+}
+
+// Sign returns the signature header value of the body sent at the timestamp, with one signature per secret.
+func Sign(secrets []string, timestamp time.Time, body []byte) string {
+	signatures := make([]string, 0, len(secrets))
+	for _, secret := range secrets {
+		signatures = append(signatures, fmt.Sprintf("%s=%s", signatureVersion, hex.EncodeToString(computeSignature(secret, timestamp.Unix(), body))))
+	}
+
+	return strings.Join(signatures, ",")
+}
+
+// Verifier checks signatures and timestamps of webhook deliveries.
+type Verifier struct {
+	secrets   []string
+	tolerance time.Duration
+	now       func() time.Time
+}
+
+// NewVerifier creates a verifier that accepts deliveries signed with any of the secrets.
+// Pass both the new and the previous secret while rotating it.
+func NewVerifier(tolerance time.Duration, secrets ...string) *Verifier {
+	return &Verifier{secrets: secrets, tolerance: tolerance, now: time.Now}
+}
+
+// Verify checks that the delivery is signed with one of the verifier secrets and is not older than the tolerance.
+// Replayed deliveries within the tolerance have the same delivery id, so subscribers should deduplicate by it.
+func (v *Verifier) Verify(header http.Header, body []byte) (*Delivery, error) {
+	deliveryID := header.Get(HeaderDeliveryID)
+	if deliveryID == "" {
+		return nil, fmt.Errorf("%w: %s", ErrMissingHeader, HeaderDeliveryID)
+	}
+
+	rawTimestamp := header.Get(HeaderTimestamp)
+	if rawTimestamp == "" {
+		return nil, fmt.Errorf("%w: %s", ErrMissingHeader, HeaderTimestamp)
+	}
+
+	unix, err := strconv.ParseInt(rawTimestamp, 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrInvalidTimestamp, err.Error())
+	}
+
+	timestamp := time.Unix(unix, 0)
+	if diff := v.now().Sub(timestamp); diff > v.tolerance || diff < -v.tolerance {
+		return nil, ErrTimestampExpired
+	}
+
+	rawSignatures := header.Get(HeaderSignature)
+	if rawSignatures == "" {
+		return nil, fmt.Errorf("%w: %s", ErrMissingHeader, HeaderSignature)
+	}
+
+	for _, secret := range v.secrets {
+		expected := computeSignature(secret, unix, body)
+
+		for _, signature := range parseSignatures(rawSignatures) {
+			if hmac.Equal(expected, signature) {
+				return &Delivery{ID: deliveryID, Timestamp: timestamp}, nil
+			}
+		}
+	}
+
+	return nil, ErrSignatureMismatch
+}
+
+func computeSignature(secret string, timestamp int64, body []byte) []byte {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(strconv.FormatInt(timestamp, 10)))
+	mac.Write([]byte("."))
+	mac.Write(body)
+
+	return mac.Sum(nil)
+}
+
+// parseSignatures returns decoded signatures of the supported version, skipping the malformed ones.
+func parseSignatures(header string) [][]byte {
+	var signatures [][]byte
+
+	for _, part := range strings.Split(header, ",") {
+		version, value, found := strings.Cut(strings.TrimSpace(part), "=")
+		if !found || version != signatureVersion {
+			continue
+		}
+
+		signature, err := hex.DecodeString(value)
+		if err != nil {
+			continue
+		}
+
+		signatures = append(signatures, signature)
+	}
+
+	return signatures
+}
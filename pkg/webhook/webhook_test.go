@@ -0,0 +1,115 @@
+package webhook_test
+
+import (
+	"net/http"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/andrewshostak/result-service/pkg/webhook"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestVerifier_Verify(t *testing.T) {
+	body := []byte(`{"home":2,"away":1}`)
+	deliveryID := "6f1c3f0e-5d1f-4b8e-9a3c-1f2e3d4c5b6a"
+	secret, previousSecret := "new-secret", "previous-secret"
+	now := time.Now()
+
+	header := func(timestamp time.Time, signature string) http.Header {
+		h := http.Header{}
+		h.Set(webhook.HeaderDeliveryID, deliveryID)
+		h.Set(webhook.HeaderTimestamp, strconv.FormatInt(timestamp.Unix(), 10))
+		h.Set(webhook.HeaderSignature, signature)
+		return h
+	}
+
+	tests := []struct {
+		name        string
+		secrets     []string
+		header      http.Header
+		body        []byte
+		expectedErr error
+	}{
+		{
+			name:    "success - it accepts a delivery signed with the secret",
+			secrets: []string{secret},
+			header:  header(now, webhook.Sign([]string{secret}, now, body)),
+			body:    body,
+		},
+		{
+			name:    "success - it accepts a dual-signed delivery with the previous secret",
+			secrets: []string{previousSecret},
+			header:  header(now, webhook.Sign([]string{secret, previousSecret}, now, body)),
+			body:    body,
+		},
+		{
+			name:    "success - it accepts a delivery signed with the new secret when verifier has both secrets",
+			secrets: []string{previousSecret, secret},
+			header:  header(now, webhook.Sign([]string{secret}, now, body)),
+			body:    body,
+		},
+		{
+			name:        "it returns an error when delivery id header is missing",
+			secrets:     []string{secret},
+			header:      http.Header{},
+			body:        body,
+			expectedErr: webhook.ErrMissingHeader,
+		},
+		{
+			name:    "it returns an error when timestamp is not a number",
+			secrets: []string{secret},
+			header: func() http.Header {
+				h := header(now, webhook.Sign([]string{secret}, now, body))
+				h.Set(webhook.HeaderTimestamp, "yesterday")
+				return h
+			}(),
+			body:        body,
+			expectedErr: webhook.ErrInvalidTimestamp,
+		},
+		{
+			name:        "it returns an error when timestamp is outside of the tolerance",
+			secrets:     []string{secret},
+			header:      header(now.Add(-time.Hour), webhook.Sign([]string{secret}, now.Add(-time.Hour), body)),
+			body:        body,
+			expectedErr: webhook.ErrTimestampExpired,
+		},
+		{
+			name:        "it returns an error when body is modified",
+			secrets:     []string{secret},
+			header:      header(now, webhook.Sign([]string{secret}, now, body)),
+			body:        []byte(`{"home":0,"away":3}`),
+			expectedErr: webhook.ErrSignatureMismatch,
+		},
+		{
+			name:        "it returns an error when timestamp is modified",
+			secrets:     []string{secret},
+			header:      header(now.Add(time.Second), webhook.Sign([]string{secret}, now, body)),
+			body:        body,
+			expectedErr: webhook.ErrSignatureMismatch,
+		},
+		{
+			name:        "it returns an error when delivery is signed with unknown secret",
+			secrets:     []string{secret},
+			header:      header(now, webhook.Sign([]string{previousSecret}, now, body)),
+			body:        body,
+			expectedErr: webhook.ErrSignatureMismatch,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			v := webhook.NewVerifier(webhook.DefaultTolerance, tt.secrets...)
+
+			delivery, err := v.Verify(tt.header, tt.body)
+			if tt.expectedErr != nil {
+				assert.ErrorIs(t, err, tt.expectedErr)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, deliveryID, delivery.ID)
+			assert.Equal(t, now.Unix(), delivery.Timestamp.Unix())
+		})
+	}
+}
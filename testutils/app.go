@@ -134,12 +134,30 @@ func FakeSubscription(options ...Option[models.Subscription]) models.Subscriptio
 	notifiedAt := gofakeit.Date()
 
 	sub := models.Subscription{
-		ID:         uint(gofakeit.Uint8()),
-		Url:        gofakeit.URL(),
-		MatchID:    uint(gofakeit.Uint8()),
-		Key:        gofakeit.Password(true, true, true, false, false, 10),
-		Status:     statuses[gofakeit.IntRange(0, len(statuses)-1)],
-		NotifiedAt: &notifiedAt,
+		ID:             uint(gofakeit.Uint8()),
+		Url:            gofakeit.URL(),
+		MatchID:        uint(gofakeit.Uint8()),
+		Key:            gofakeit.Password(true, true, true, false, false, 10),
+		Status:         statuses[gofakeit.IntRange(0, len(statuses)-1)],
+		NotifiedAt:     &notifiedAt,
+		PayloadVersion: models.PayloadV1,
+		DeliveryFormat: models.FormatWebhook,
+		DeliveryID:     gofakeit.UUID(),
+	}
+
+	applyOptions(&sub, options...)
+
+	return sub
+}
+
+func FakeStandingSubscription(options ...Option[models.StandingSubscription]) models.StandingSubscription {
+	sub := models.StandingSubscription{
+		ID:             uint(gofakeit.Uint8()),
+		URLTemplate:    gofakeit.URL() + "/{match_id}",
+		Key:            gofakeit.Password(true, true, true, false, false, 10),
+		PayloadVersion: models.PayloadV1,
+		DeliveryFormat: models.FormatWebhook,
+		CreatedAt:      gofakeit.Date(),
 	}
 
 	applyOptions(&sub, options...)
@@ -96,10 +96,63 @@ func CreateMatch(t *testing.T, db *sqlx.DB, match repository.Match) repository.M
 func CreateSubscription(t *testing.T, db *sqlx.DB, subscription repository.Subscription) repository.Subscription {
 	t.Helper()
 
+	keyring := Keyring(t)
+
+	key, err := keyring.Encrypt(subscription.Key)
+	require.NoError(t, err)
+
 	var created repository.Subscription
-	query := "INSERT INTO subscriptions (match_id, url, key, status) VALUES ($1, $2, $3, $4) RETURNING *"
+	query := "INSERT INTO subscriptions (match_id, url, key, key_hash, status, batch_url, notification_batch_id, remind_before) VALUES ($1, $2, $3, $4, $5, $6, $7, $8) RETURNING *"
+
+	err = db.Get(&created, query, subscription.MatchID, subscription.Url, key, keyring.Hash(subscription.Key), subscription.Status, subscription.BatchURL, subscription.NotificationBatchID, subscription.RemindBefore)
+	require.NoError(t, err)
+
+	return created
+}
+
+func CreateNotificationBatch(t *testing.T, db *sqlx.DB, batch repository.NotificationBatch) repository.NotificationBatch {
+	t.Helper()
+
+	var created repository.NotificationBatch
+	query := "INSERT INTO notification_batches (batch_url, key_hash, deliver_at) VALUES ($1, $2, $3) RETURNING *"
+
+	err := db.Get(&created, query, batch.BatchURL, batch.KeyHash, batch.DeliverAt)
+	require.NoError(t, err)
+
+	return created
+}
+
+func CreateResultEvent(t *testing.T, db *sqlx.DB, event repository.ResultEvent) repository.ResultEvent {
+	t.Helper()
+
+	var created repository.ResultEvent
+	query := "INSERT INTO result_events (match_id, event_type, home_team_id, away_team_id, starts_at, home_score, away_score, finish_type) VALUES ($1, $2, $3, $4, $5, $6, $7, $8) RETURNING *"
+
+	err := db.Get(&created, query, event.MatchID, event.EventType, event.HomeTeamID, event.AwayTeamID, event.StartsAt, event.HomeScore, event.AwayScore, event.FinishType)
+	require.NoError(t, err)
+
+	return created
+}
+
+func CreateMatchUpdate(t *testing.T, db *sqlx.DB, update repository.MatchUpdate) repository.MatchUpdate {
+	t.Helper()
+
+	var created repository.MatchUpdate
+	query := "INSERT INTO match_updates (match_id, status, home_score, away_score) VALUES ($1, $2, $3, $4) RETURNING *"
+
+	err := db.Get(&created, query, update.MatchID, update.Status, update.HomeScore, update.AwayScore)
+	require.NoError(t, err)
+
+	return created
+}
+
+func CreateSubscriberHost(t *testing.T, db *sqlx.DB, host repository.SubscriberHost) repository.SubscriberHost {
+	t.Helper()
+
+	var created repository.SubscriberHost
+	query := "INSERT INTO subscriber_hosts (host, consecutive_failures, last_error, last_failure_at, suspended_at) VALUES ($1, $2, $3, $4, $5) RETURNING *"
 
-	err := db.Get(&created, query, subscription.MatchID, subscription.Url, subscription.Key, subscription.Status)
+	err := db.Get(&created, query, host.Host, host.ConsecutiveFailures, host.LastError, host.LastFailureAt, host.SuspendedAt)
 	require.NoError(t, err)
 
 	return created
@@ -128,6 +181,28 @@ func ListCheckResultTasks(t *testing.T, db *sqlx.DB) []repository.CheckResultTas
 	return checkResultTasks
 }
 
+func ListOutboxTasks(t *testing.T, db *sqlx.DB) []repository.OutboxTask {
+	t.Helper()
+
+	var outboxTasks []repository.OutboxTask
+
+	err := db.Select(&outboxTasks, "SELECT * FROM outbox_tasks")
+	require.NoError(t, err)
+
+	return outboxTasks
+}
+
+func ListNotificationAttempts(t *testing.T, db *sqlx.DB, subscriptionID uint) []repository.NotificationAttempt {
+	t.Helper()
+
+	var attempts []repository.NotificationAttempt
+
+	err := db.Select(&attempts, "SELECT * FROM notification_attempts WHERE subscription_id = $1 ORDER BY id", subscriptionID)
+	require.NoError(t, err)
+
+	return attempts
+}
+
 func ListExternalMatches(t *testing.T, db *sqlx.DB) []repository.ExternalMatch {
 	t.Helper()
 
@@ -161,6 +236,17 @@ func ListSubscriptionsByMatch(t *testing.T, db *sqlx.DB, matchID uint) []reposit
 	return subs
 }
 
+func GetSubscriberHost(t *testing.T, db *sqlx.DB, host string) repository.SubscriberHost {
+	t.Helper()
+
+	var subscriberHost repository.SubscriberHost
+
+	err := db.Get(&subscriberHost, "SELECT * FROM subscriber_hosts WHERE host = $1", host)
+	require.NoError(t, err)
+
+	return subscriberHost
+}
+
 type TeamSeed struct {
 	TeamID         uint
 	ExternalTeamID uint
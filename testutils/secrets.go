@@ -0,0 +1,24 @@
+package testutils
+
+import (
+	"testing"
+
+	"github.com/andrewshostak/result-service/pkg/secrets"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	EncryptionKeyID = "test"
+	EncryptionKey   = "MDEyMzQ1Njc4OWFiY2RlZjAxMjM0NTY3ODlhYmNkZWY="
+	HashKey         = "aGFzaC1rZXktaGFzaC1rZXktaGFzaC1rZXktaGFzaCE="
+)
+
+// Keyring returns a keyring with the same keys as the application under test.
+func Keyring(t *testing.T) *secrets.Keyring {
+	t.Helper()
+
+	keyring, err := secrets.NewKeyring(EncryptionKeyID, map[string]string{EncryptionKeyID: EncryptionKey}, HashKey)
+	require.NoError(t, err)
+
+	return keyring
+}
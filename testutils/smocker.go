@@ -2,6 +2,7 @@ package testutils
 
 import (
 	"bytes"
+	"fmt"
 	"net/http"
 	"net/url"
 	"testing"
@@ -17,6 +18,8 @@ type mockSettings struct {
 	queryParams  url.Values
 	statusCode   int
 	responseBody any
+	echoField    string
+	acknowledge  bool
 }
 
 type MockOption func(*mockSettings)
@@ -45,6 +48,20 @@ func WithQueryParams(queryParams url.Values) MockOption {
 	}
 }
 
+// WithEchoedBodyField responds with the value of the json request body field, as an endpoint answering a verification challenge.
+func WithEchoedBodyField(field string) MockOption {
+	return func(s *mockSettings) {
+		s.echoField = field
+	}
+}
+
+// WithAcknowledgedBatchItems responds with delivery ids of all items of the batch request, as a batch url processing every item.
+func WithAcknowledgedBatchItems() MockOption {
+	return func(s *mockSettings) {
+		s.acknowledge = true
+	}
+}
+
 func MockHTTPRequest(t *testing.T, baseUrl, path string, opts ...MockOption) {
 	t.Helper()
 
@@ -65,7 +82,7 @@ func MockHTTPRequest(t *testing.T, baseUrl, path string, opts ...MockOption) {
 			Method:      settings.method,
 			QueryParams: map[string][]string{},
 		},
-		Response: mockResponse{
+		Response: &mockResponse{
 			Status: settings.statusCode,
 		},
 	}
@@ -78,6 +95,25 @@ func MockHTTPRequest(t *testing.T, baseUrl, path string, opts ...MockOption) {
 		payload.Response.Body = v
 	}
 
+	if settings.echoField != "" {
+		payload.Response = nil
+		payload.DynamicResponse = &mockDynamicResponse{
+			Engine: "go_template_yaml",
+			Script: fmt.Sprintf("status: %d\nbody: '{{ .Request.Body.%s }}'\n", settings.statusCode, settings.echoField),
+		}
+	}
+
+	if settings.acknowledge {
+		payload.Response = nil
+		payload.DynamicResponse = &mockDynamicResponse{
+			Engine: "go_template_yaml",
+			Script: fmt.Sprintf(
+				"status: %d\nbody: '{\"acknowledged\":[{{ range $i, $item := .Request.Body.items }}{{ if $i }},{{ end }}\"{{ $item.delivery_id }}\"{{ end }}]}'\n",
+				settings.statusCode,
+			),
+		}
+	}
+
 	var buf bytes.Buffer
 	err := yaml.NewEncoder(&buf).Encode([]smockerExpectation{payload})
 	require.NoError(t, err)
@@ -88,8 +124,9 @@ func MockHTTPRequest(t *testing.T, baseUrl, path string, opts ...MockOption) {
 }
 
 type smockerExpectation struct {
-	Request  mockRequest  `yaml:"request"`
-	Response mockResponse `yaml:"response"`
+	Request         mockRequest          `yaml:"request"`
+	Response        *mockResponse        `yaml:"response,omitempty"`
+	DynamicResponse *mockDynamicResponse `yaml:"dynamic_response,omitempty"`
 }
 
 type mockRequest struct {
@@ -103,3 +140,8 @@ type mockResponse struct {
 	Status int    `yaml:"status"`
 	Body   string `yaml:"body,omitempty"`
 }
+
+type mockDynamicResponse struct {
+	Engine string `yaml:"engine"`
+	Script string `yaml:"script"`
+}